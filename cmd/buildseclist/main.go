@@ -0,0 +1,77 @@
+// Command buildseclist builds a FOCAL secure blacklist from a release-json
+// blacklist. It is the Go port of web/buildSecBlackList.js, takes the same
+// flags and writes the same {"s": [...], "m": [...]} output.
+//
+// Run it from the web directory, or point -c at the server config:
+//
+//	buildseclist -p ../testData/release-json/phishtank.withoutmeta.json -m -1 -f ec
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"time"
+
+	"../../lib/oprf"
+)
+
+func main() {
+	path := flag.String("p", "../testData/phishtank.json", "release-json blacklist, [{\"u\": ..., \"m\": ...}]")
+	maxnum := flag.Int("m", 10, "number of records to build, -1 for all")
+	source := flag.String("s", "PhishTank", "name of the blacklist source")
+	out := flag.String("o", "out.json", "output path")
+	verbose := flag.Int("l", 0, "log level; 2 logs the tokens of every record")
+	kind := flag.String("f", "rsa", "OPRF type: rsa or ec")
+	configPath := flag.String("c", "./config/default.json", "server config with the OPRF keys")
+	flag.Parse()
+
+	fmt.Printf("BuildSecBlackList with Path = %s, MaxNum = %d, DefaultSource = %s, OPRF = %s\n", *path, *maxnum, *source, *kind)
+
+	key, err := oprf.LoadKey(*configPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	entries, err := oprf.ReadEntries(*path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *maxnum >= 0 && *maxnum < len(entries) {
+		entries = entries[:*maxnum]
+	}
+	if len(entries) > 0 {
+		fmt.Printf("With Meta = %v\n", entries[0].M != nil)
+	}
+
+	start := time.Now()
+	bl, err := oprf.Build(entries, key, oprf.Kind(*kind))
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("Finish building index within %d ms.\n", time.Since(start).Nanoseconds()/1e6)
+
+	if *verbose == 2 {
+		for i, e := range entries {
+			fmt.Println("========================================================")
+			fmt.Println(e.U)
+			fmt.Printf("firUint = %d\n", bl.S[i])
+			fmt.Printf("T1 = %s\n", bl.M[i])
+			if bl.WithMeta() {
+				fmt.Printf("Meta = %q\n", bl.Meta[i])
+			}
+			fmt.Println("========================================================")
+		}
+	}
+
+	// Not json.Marshal, which would escape the <, > and & of the encrypted
+	// metadata that buildSecBlackList.js writes raw.
+	data, err := bl.MarshalJSON()
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(*out, data, 0644); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println("Finish write into the file. ")
+}
@@ -0,0 +1,116 @@
+// Command canonvectors writes and checks the URL processing test vectors that
+// keep the FOCAL implementations in step (see lib/vectors).
+//
+// It writes the vectors of the Go implementation for a corpus, one input URL
+// per line:
+//
+//	canonvectors -corpus ../../testData/conformance/corpus.txt -o vectors.json
+//
+// or verifies the vectors another implementation wrote for a corpus, such as
+// the ones of testData/conformance/vectors.js, and reports the divergences:
+//
+//	canonvectors -verify js.json -report divergences.json
+//
+// It exits with status 1 if there are divergences.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+
+	"../../lib/lines"
+	"../../lib/vectors"
+)
+
+func main() {
+	corpusPath := flag.String("corpus", "", "corpus to write the vectors of, one input URL per line")
+	outPath := flag.String("o", "vectors.json", "output path of the vectors (-corpus)")
+	verifyPath := flag.String("verify", "", "vectors of another implementation to verify")
+	reportPath := flag.String("report", "", "output path of the divergences as JSON (-verify)")
+	flag.Parse()
+
+	switch {
+	case *corpusPath != "":
+		inputs, err := readCorpus(*corpusPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		f := vectors.GenerateFile(inputs)
+		if err := f.Write(*outPath); err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("Wrote the %s vectors of %d inputs to %s\n", f.Implementation, len(f.Vectors), *outPath)
+
+	case *verifyPath != "":
+		f, err := vectors.Read(*verifyPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		ds := vectors.Verify(f)
+		for _, d := range ds {
+			fmt.Println(d)
+		}
+		report(f, ds)
+		if *reportPath != "" {
+			data, err := json.MarshalIndent(ds, "", "    ")
+			if err != nil {
+				log.Fatal(err)
+			}
+			if err := ioutil.WriteFile(*reportPath, data, 0644); err != nil {
+				log.Fatal(err)
+			}
+		}
+		if len(ds) > 0 {
+			os.Exit(1)
+		}
+
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+}
+
+// readCorpus reads the input URLs of a corpus, one per line. Lines are kept
+// as they are, surrounding spaces and control characters included; empty
+// lines are skipped.
+func readCorpus(path string) ([]string, error) {
+	file, err := lines.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var inputs []string
+	r := lines.NewReader(file)
+	for r.Scan() {
+		if line := r.Text(); line != "" {
+			inputs = append(inputs, line)
+		}
+	}
+	return inputs, r.Err()
+}
+
+// report prints how many vectors of f diverge, and the number of divergences
+// of each kind.
+func report(f *vectors.File, ds []vectors.Divergence) {
+	inputs := make(map[string]bool)
+	kinds := make(map[string]int)
+	for _, d := range ds {
+		inputs[d.Input] = true
+		kinds[d.Kind]++
+	}
+	fmt.Printf("\n%s: %d of %d vectors diverge from %s\n", f.Implementation, len(inputs), len(f.Vectors), vectors.Implementation)
+	var names []string
+	for kind := range kinds {
+		names = append(names, kind)
+	}
+	sort.Strings(names)
+	for _, kind := range names {
+		fmt.Printf("    %-10s %d\n", kind, kinds[kind])
+	}
+}
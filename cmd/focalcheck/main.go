@@ -0,0 +1,106 @@
+// Command focalcheck checks URLs against published FOCAL blacklists, the way
+// the Chrome extension does: a URL is decomposed into its patterns and, for
+// the patterns whose 32-bit prefix is in a blacklist, the blinded OPRF is run
+// with the server of the blacklist.
+//
+// The blacklists are downloaded from FOCAL servers, or read from a manifest
+// and a published blacklist on disk:
+//
+//	focalcheck -server https://focal.example.com http://some.url/path
+//	focalcheck -meta meta.json -data out.json -oprf http://localhost:8080 < urls.txt
+//
+// Every URL is reported as a JSON line with the hit, or a null hit.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+
+	"../../lib/lines"
+	"../../lib/oprf"
+)
+
+func main() {
+	servers := flag.String("server", "", "comma-separated FOCAL servers to subscribe to")
+	metaPath := flag.String("meta", "", "blacklist manifest, as served at /api/meta")
+	dataPath := flag.String("data", "", "published blacklist, as built by buildseclist")
+	oprfURL := flag.String("oprf", "", "OPRF server for -meta/-data, overriding the url of the manifest")
+	types := flag.String("types", strings.Join(oprf.DefaultTypes, ","), "comma-separated names of the metadata types")
+	flag.Parse()
+
+	c := &oprf.Client{Types: strings.Split(*types, ",")}
+
+	for _, s := range strings.Split(*servers, ",") {
+		if s == "" {
+			continue
+		}
+		meta, err := c.Subscribe(s)
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("Subscribed to %s version %s (%d records)", meta.Source, meta.Version, meta.Num)
+	}
+
+	if *metaPath != "" || *dataPath != "" {
+		var meta oprf.Meta
+		var bl oprf.Blacklist
+		if err := readJSON(*metaPath, &meta); err != nil {
+			log.Fatal(err)
+		}
+		if err := readJSON(*dataPath, &bl); err != nil {
+			log.Fatal(err)
+		}
+		if *oprfURL != "" {
+			meta.URL = strings.TrimSuffix(*oprfURL, "/")
+		}
+		if err := c.AddSource(meta, &bl); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	check := func(u string) {
+		res, err := c.CheckURL(u)
+		out := struct {
+			Input  string       `json:"input"`
+			Result *oprf.Result `json:"result"`
+			Error  string       `json:"error,omitempty"`
+		}{Input: u, Result: res}
+		if err != nil {
+			out.Error = err.Error()
+		}
+		enc.Encode(out)
+	}
+
+	if flag.NArg() > 0 {
+		for _, u := range flag.Args() {
+			check(u)
+		}
+		return
+	}
+	r := lines.NewReader(os.Stdin)
+	for r.Scan() {
+		if u := strings.TrimSpace(r.Text()); u != "" {
+			check(u)
+		}
+	}
+	if err := r.Err(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func readJSON(path string, v interface{}) error {
+	if path == "" {
+		return fmt.Errorf("both -meta and -data are required")
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
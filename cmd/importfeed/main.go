@@ -0,0 +1,89 @@
+// Command importfeed converts a threat feed into a FOCAL release-json
+// blacklist, [{"u": ..., "m": ...}], ready for buildseclist. It is the Go
+// counterpart of testData/scripts-converting/withmeta.js and withoutmeta.js,
+// for more feed formats:
+//
+//	importfeed -f phishtank-json -i online-valid.json.bz2 -o phishtank.withmeta.json
+//	importfeed -f malwaredomains -i domains.txt -o malwaredomains.withmeta.json
+//	curl -s https://openphish.com/feed.txt | importfeed -f openphish -o openphish.json
+//
+// The input may be compressed with gzip or bzip2. The import statistics are
+// printed, and written as JSON with -stats.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"../../lib/feeds"
+	"../../lib/lines"
+	"../../lib/oprf"
+)
+
+func main() {
+	var formats []string
+	for _, f := range feeds.Formats() {
+		formats = append(formats, string(f))
+	}
+	format := flag.String("f", string(feeds.Plain), "feed format: "+strings.Join(formats, ", "))
+	input := flag.String("i", lines.Stdin, "feed path, - for the standard input")
+	out := flag.String("o", "out.json", "output path")
+	compat := flag.Bool("compat", true, "skip the URLs with a ':' the JS converters skip")
+	statsPath := flag.String("stats", "", "path to write the import statistics to as JSON")
+	flag.Parse()
+
+	f, err := feeds.ParseFormat(*format)
+	if err != nil {
+		log.Fatal(err)
+	}
+	rc, err := lines.Open(*input)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer rc.Close()
+
+	start := time.Now()
+	entries, stats, err := feeds.Import(rc, f, feeds.Options{Compat: *compat})
+	if err != nil {
+		log.Fatalf("%s: %v", *input, err)
+	}
+	if err := ioutil.WriteFile(*out, oprf.MarshalEntries(entries), 0644); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("Imported %s within %d ms.\n", *input, time.Since(start).Nanoseconds()/1e6)
+	printStats(stats)
+
+	if *statsPath != "" {
+		data, err := json.MarshalIndent(stats, "", "  ")
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := ioutil.WriteFile(*statsPath, data, 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+func printStats(s *feeds.Stats) {
+	fmt.Printf("    %d URLs, %d skipped, %d canonicalized, %d unique\n", s.URLs, s.Skipped, s.Canonical, s.Unique)
+	var reasons []string
+	for reason := range s.Rejected {
+		reasons = append(reasons, reason)
+	}
+	sort.Strings(reasons)
+	for _, reason := range reasons {
+		fmt.Printf("    rejected %-16s %d\n", reason, s.Rejected[reason])
+	}
+	for _, c := range []feeds.Code{feeds.Others, feeds.Phishing, feeds.Malware} {
+		if n := s.Codes[c]; n > 0 {
+			fmt.Printf("    m=%d %d\n", c, n)
+		}
+	}
+	fmt.Println(s)
+}
@@ -0,0 +1,35 @@
+// Command oprfserver serves the FOCAL OPRF endpoints, /oprf/rsa and /oprf/ec,
+// with the keys of a FOCAL server config. It is wire-compatible with
+// web/routes/oprf.js, so extensions can use it in place of the Node server,
+// and evaluates requests on all CPUs.
+//
+//	oprfserver -c ../web/config/default.json -addr :8080
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+
+	"../../lib/oprf"
+)
+
+func main() {
+	configPath := flag.String("c", "./config/default.json", "server config with the OPRF keys")
+	addr := flag.String("addr", ":8080", "listen address")
+	certFile := flag.String("cert", "", "TLS certificate (e.g. /data/ca/web.pem); serves HTTPS if set")
+	keyFile := flag.String("key", "", "TLS key (e.g. /data/ca/web.key)")
+	flag.Parse()
+
+	key, err := oprf.LoadKey(*configPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	handler := oprf.NewHandler(key)
+	log.Printf("Serving %s and %s on %s", oprf.PathRSA, oprf.PathEC, *addr)
+	if *certFile != "" {
+		log.Fatal(http.ListenAndServeTLS(*addr, *certFile, *keyFile, handler))
+	}
+	log.Fatal(http.ListenAndServe(*addr, handler))
+}
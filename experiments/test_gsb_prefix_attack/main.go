@@ -1,22 +1,25 @@
 package main
 
 import (
-	"bufio"
 	"flag"
 	"fmt"
-	"io"
 	"log"
-	"os"
+	"sort"
+
+	"../../lib/focal"
+	"../../lib/lines"
+	"../../lib/reident"
 )
 
-// This function is used for reading original URLs from a text file.
+// This function is used for reading original URLs from a text file, a
+// compressed one or the standard input ("-"). Malformed lines are skipped.
 func readURLFromFile(filePath string, numOfURLs uint) ([]string, error) {
 
 	fmt.Printf(">>> Reading URL list %s ...\n\n", filePath)
 
 	oriURLs := []string{}
 
-	fi, err := os.Open(filePath)
+	fi, err := lines.Open(filePath)
 
 	if err != nil {
 
@@ -25,26 +28,27 @@ func readURLFromFile(filePath string, numOfURLs uint) ([]string, error) {
 	}
 	defer fi.Close()
 
-	br := bufio.NewReader(fi)
-
-	var i uint
-	for i = 0; ; i++ {
+	r := lines.NewReader(fi)
+	malformed := 0
+	for uint(r.Line()) < numOfURLs && r.Scan() {
 
-		a, _, c := br.ReadLine()
-		if c == io.EOF || i == numOfURLs {
+		if r.Text() == "" {
 
-			break
+			continue
 		}
-
-		if string(a) == "" {
-
+		if err := r.Malformed(); err != nil {
+			fmt.Printf("    %s: %v\n", filePath, err)
+			malformed++
 			continue
 		}
 
-		oriURLs = append(oriURLs, string(a))
+		oriURLs = append(oriURLs, r.Text())
+	}
+	if err := r.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %v", filePath, err)
 	}
 
-	fmt.Printf("    %d URLs are loaded!\n\n", len(oriURLs))
+	fmt.Printf("    %d URLs are loaded, %d malformed lines are skipped!\n\n", len(oriURLs), malformed)
 
 	return oriURLs, nil
 }
@@ -63,7 +67,7 @@ func getAllUniquePatterns(oriURLs []string) []string {
 
 		curOriURL := oriURLs[i]
 
-		patterns, err := generatePatterns(curOriURL)
+		patterns, err := focal.GeneratePatterns(curOriURL)
 
 		// simply skip a url that cannot obtain valid patterns via GSB api
 		if err != nil {
@@ -97,9 +101,9 @@ func buildShortHashIndex(uniquePatterns []string) map[string][]string {
 
 	for ctr, up := range uniquePatterns {
 
-		hash := hashFromPattern(up)
+		hash := focal.HashFromPattern(up)
 
-		sh := fmt.Sprintf("%x", ([]byte(hash))[0:4])
+		sh := focal.HexPrefix.Encode(hash.Short())
 		//fmt.Printf("256-bit hash:  %x\n32-bit prefix: %s, value: %s\n", hash, sh, up)
 
 		urls, ok := shortHashIndex[sh]
@@ -142,7 +146,7 @@ func testCollisionByURL(index map[string][]string) {
 
 		fmt.Println("\nRe-identified URLs:")
 
-		hashes, err := generateHashes(qURL)
+		hashes, err := focal.GenerateHashes(qURL)
 		if err != nil {
 
 			log.Fatal("Come with fatal,exit with 1 \n")
@@ -152,7 +156,7 @@ func testCollisionByURL(index map[string][]string) {
 
 		for k := range hashes {
 
-			sh := fmt.Sprintf("%x", ([]byte(k))[0:4])
+			sh := focal.HexPrefix.Encode(k.Short())
 
 			// output the matched URLs (decompositions)
 			urls, ok := index[sh]
@@ -171,29 +175,85 @@ func testCollisionByURL(index map[string][]string) {
 	}
 }
 
-func analyzeShortHashIndex(index map[string][]string) {
+// This function is used for re-identifying the URL behind co-occurring hash
+// prefixes (hex, one per line), i.e., prefixes sent together for the
+// decompositions of one URL: the decompositions of the index are ranked by
+// how many of them they explain.
+func testCollisionByPrefixes(index map[string][]string, filePath string) error {
 
-	fmt.Printf(">>> Analyzing prefix index ...\n")
+	prefixes, err := readURLFromFile(filePath, ^uint(0))
+	if err != nil {
+		return err
+	}
 
-	numOfMatchesMap := make(map[int]int)
+	observed := []focal.HashPrefix{}
+	for _, p := range prefixes {
 
-	for k := range index {
+		h, err := focal.HexPrefix.Decode(p)
+		if err != nil || len(h) < focal.MinHashPrefixLength {
+			return fmt.Errorf("%s: invalid 32-bit hex prefix %q", filePath, p)
+		}
+		observed = append(observed, h)
+	}
 
-		numOfMatches := len(index[k])
+	fmt.Printf(">>> Re-identifying %d co-occurring prefixes ...\n\n", len(observed))
 
-		ctr, ok := numOfMatchesMap[numOfMatches]
-		if ok {
-			ctr++
-			numOfMatchesMap[numOfMatches] = ctr
-		} else {
+	r := reident.New(prefixIndex(index), 32).Reidentify(observed)
+	for _, h := range r.Unmatched {
+		fmt.Printf("    No decomposition for %s!\n", h.Hex())
+	}
+	for i, c := range r.Candidates {
 
-			numOfMatchesMap[numOfMatches] = 1
+		if i == 10 {
+			fmt.Printf("    ... %d more candidates\n", len(r.Candidates)-i)
+			break
 		}
+		fmt.Printf("    %s explains %d of %d prefixes\n", c.URL, len(c.Explained), len(r.Observed))
+	}
+
+	fmt.Printf("\n    Residual ambiguity: %d candidates ranked first (%.2f bits)\n\n", r.Ambiguity, r.Bits())
+
+	return nil
+}
+
+// prefixIndex returns index keyed by the prefixes rather than their hex form.
+func prefixIndex(index map[string][]string) map[focal.HashPrefix][]string {
+
+	shortHashIndex := make(map[focal.HashPrefix][]string, len(index))
+	for k, v := range index {
+
+		h, _ := focal.HexPrefix.Decode(k)
+		shortHashIndex[h] = v
+	}
+	return shortHashIndex
+}
+
+// This function is used for measuring how well the prefixes hide the URL
+// patterns: the anonymity set of a pattern is the patterns sharing its prefix.
+func analyzeShortHashIndex(index map[string][]string) {
+
+	fmt.Printf(">>> Analyzing prefix index ...\n\n")
+
+	stats := reident.Anonymity(prefixIndex(index), 32, nil)
+
+	sizes := []int{}
+	for k := range stats.SetSizes {
+		sizes = append(sizes, k)
+	}
+	sort.Ints(sizes)
+
+	fmt.Println("    set size - #prefixs")
+	for _, k := range sizes {
+		fmt.Printf("    %d - %d\n", k, stats.SetSizes[k])
 	}
 
-	fmt.Println("\n    Done! key - #matches, value - #prefixs")
-	fmt.Println("   ", numOfMatchesMap)
-	fmt.Println()
+	fmt.Printf("\n    mean set size of a prefix: %.6f\n", stats.MeanSetSize)
+	fmt.Printf("    expected set size of a pattern: %.6f\n", stats.ExpectedSetSize)
+	for _, p := range stats.Percentiles {
+		fmt.Printf("    %g%% of the patterns are in sets of at most %d\n", p.P, p.SetSize)
+	}
+	fmt.Printf("    uniquely re-identifiable: %.2f%% of the prefixes, %.2f%% of the patterns\n", 100*stats.UniquePrefixes, 100*stats.UniquePatterns)
+	fmt.Printf("    entropy of a pattern given its prefix: %.6f bits (Shannon), %.6f bits (min)\n\n", stats.ShannonEntropy, stats.MinEntropy)
 }
 
 // Main function
@@ -203,6 +263,7 @@ func main() {
 
 	filePath := flag.String("p", "urlList.txt", "input file path")
 	numOfURLs := flag.Uint("n", UintMax, "number of URLs")
+	cooccurPath := flag.String("c", "", "co-occurring hash prefixes to re-identify (hex, one per line) instead of testing URLs")
 
 	flag.Parse()
 
@@ -220,5 +281,14 @@ func main() {
 
 	analyzeShortHashIndex(shortHashIndex)
 
+	if *cooccurPath != "" {
+
+		if err := testCollisionByPrefixes(shortHashIndex, *cooccurPath); err != nil {
+
+			fmt.Printf("Error: %s\n", err)
+		}
+		return
+	}
+
 	testCollisionByURL(shortHashIndex)
 }
@@ -0,0 +1,218 @@
+// Package audit audits a published prefix list, such as the GSB database or
+// the "s" array of a FOCAL blacklist, for prefixes that look planted to track
+// users rather than to protect them.
+//
+// A prefix matching the decompositions of benign URLs, such as the Alexa top
+// sites or a browsing history, without a known malicious pre-image makes
+// clients send their visits to those URLs; several such prefixes on a single
+// domain tell the visits to its pages apart (Gerbet et al., "A privacy
+// analysis of Google and Yandex Safe Browsing", DSN 2016).
+package audit
+
+import (
+	"sort"
+	"strings"
+
+	"../focal"
+	"../publicsuffix"
+)
+
+// Match is a published prefix matching decompositions of benign URLs.
+type Match struct {
+	Prefix focal.HashPrefix
+	// Patterns are the benign decompositions of the prefix, sorted.
+	Patterns []string
+	// Corpora are the names of the corpora of the decompositions, in the
+	// order they were scanned.
+	Corpora []string
+}
+
+// Domain is a registrable domain whose URLs match published prefixes.
+type Domain struct {
+	Domain string
+	// Prefixes are the published prefixes the URLs of the domain match,
+	// sorted, Unexplained those of them without a malicious pre-image.
+	Prefixes    []focal.HashPrefix
+	Unexplained []focal.HashPrefix
+}
+
+// Corpus counts the URLs of a scanned corpus.
+type Corpus struct {
+	Name     string `json:"name"`
+	URLs     int    `json:"urls"`
+	Rejected int    `json:"rejected"` // URLs without decompositions
+	Hits     int    `json:"hits"`     // URLs matching at least a published prefix
+}
+
+// Auditor matches benign corpora against a published prefix list.
+type Auditor struct {
+	published *focal.HashSet
+	// malicious are the 32-bit prefixes of the known malicious patterns.
+	malicious map[focal.HashPrefix]bool
+	matches   map[focal.HashPrefix]*Match
+	patterns  map[focal.HashPrefix]map[string]bool
+	domains   map[string]map[focal.HashPrefix]bool
+	corpora   []Corpus
+}
+
+// New returns an auditor of the published prefixes.
+func New(published *focal.HashSet) *Auditor {
+	return &Auditor{
+		published: published,
+		malicious: make(map[focal.HashPrefix]bool),
+		matches:   make(map[focal.HashPrefix]*Match),
+		patterns:  make(map[focal.HashPrefix]map[string]bool),
+		domains:   make(map[string]map[focal.HashPrefix]bool),
+	}
+}
+
+// AddMalicious adds the decompositions of the malicious URLs urls, such as
+// the entries of a blacklist, to the known pre-images, and returns the number
+// of URLs without decompositions. A published prefix is explained by the
+// malicious patterns with the same first 32 bits.
+func (a *Auditor) AddMalicious(urls []string) int {
+	rejected := 0
+	for _, u := range urls {
+		hashes, err := focal.GenerateHashes(u)
+		if err != nil {
+			rejected++
+			continue
+		}
+		for h := range hashes {
+			a.malicious[h.Short()] = true
+		}
+	}
+	return rejected
+}
+
+// AddMaliciousPrefix adds the prefix h of a malicious pattern, such as a key
+// of the eCrimeX index, to the known pre-images. h must be at least 32 bits.
+func (a *Auditor) AddMaliciousPrefix(h focal.HashPrefix) {
+	a.malicious[h.Short()] = true
+}
+
+// Scan matches the decompositions of the benign URLs urls, the corpus name,
+// against the published prefixes.
+func (a *Auditor) Scan(name string, urls []string) Corpus {
+	c := Corpus{Name: name, URLs: len(urls)}
+	for _, u := range urls {
+		patterns, err := focal.GeneratePatterns(u)
+		if err != nil {
+			c.Rejected++
+			continue
+		}
+		var domain map[focal.HashPrefix]bool
+		for _, pattern := range patterns {
+			h := focal.HashFromPattern(pattern)
+			n := a.published.Lookup(h)
+			if n == 0 {
+				continue
+			}
+			if domain == nil {
+				domain = a.domain(patterns[0])
+			}
+			a.match(h[:n], pattern, name)
+			domain[h[:n]] = true
+		}
+		if domain != nil {
+			c.Hits++
+		}
+	}
+	a.corpora = append(a.corpora, c)
+	return c
+}
+
+func (a *Auditor) match(prefix focal.HashPrefix, pattern, corpus string) {
+	m := a.matches[prefix]
+	if m == nil {
+		m = &Match{Prefix: prefix}
+		a.matches[prefix] = m
+		a.patterns[prefix] = make(map[string]bool)
+	}
+	if !a.patterns[prefix][pattern] {
+		a.patterns[prefix][pattern] = true
+		m.Patterns = append(m.Patterns, pattern)
+	}
+	if len(m.Corpora) == 0 || m.Corpora[len(m.Corpora)-1] != corpus {
+		m.Corpora = append(m.Corpora, corpus)
+	}
+}
+
+// domain returns the prefixes matched on the registrable domain of the host
+// of pattern, the first decomposition of a URL, or on the host if it has
+// none, such as an IP address.
+func (a *Auditor) domain(pattern string) map[focal.HashPrefix]bool {
+	host := pattern[:strings.IndexByte(pattern, '/')]
+	d := publicsuffix.Default.RegistrableDomain(host)
+	if d == "" {
+		d = host
+	}
+	prefixes := a.domains[d]
+	if prefixes == nil {
+		prefixes = make(map[focal.HashPrefix]bool)
+		a.domains[d] = prefixes
+	}
+	return prefixes
+}
+
+// Report is the audit of the published prefixes against the scanned corpora.
+type Report struct {
+	Published int
+	Corpora   []Corpus
+	// Matched is the number of published prefixes matching benign
+	// decompositions, Explained the number of them with a known malicious
+	// pre-image.
+	Matched   int
+	Explained int
+	// Suspicious are the matching prefixes without a known malicious
+	// pre-image, sorted by prefix.
+	Suspicious []Match
+	// Domains are the domains matching several published prefixes, one of
+	// them at least without a malicious pre-image, by decreasing number of
+	// such prefixes.
+	Domains []Domain
+}
+
+// Report returns the audit of the corpora scanned so far.
+func (a *Auditor) Report() *Report {
+	r := &Report{Published: a.published.Len(), Corpora: a.corpora, Matched: len(a.matches)}
+	for h, m := range a.matches {
+		if a.malicious[h.Short()] {
+			r.Explained++
+			continue
+		}
+		sort.Strings(m.Patterns)
+		r.Suspicious = append(r.Suspicious, *m)
+	}
+	sort.Slice(r.Suspicious, func(i, j int) bool { return r.Suspicious[i].Prefix < r.Suspicious[j].Prefix })
+
+	for name, prefixes := range a.domains {
+		if len(prefixes) < 2 {
+			continue
+		}
+		d := Domain{Domain: name}
+		for h := range prefixes {
+			d.Prefixes = append(d.Prefixes, h)
+			if !a.malicious[h.Short()] {
+				d.Unexplained = append(d.Unexplained, h)
+			}
+		}
+		if len(d.Unexplained) == 0 {
+			continue
+		}
+		focal.HashPrefixes(d.Prefixes).Sort()
+		focal.HashPrefixes(d.Unexplained).Sort()
+		r.Domains = append(r.Domains, d)
+	}
+	sort.Slice(r.Domains, func(i, j int) bool {
+		a, b := &r.Domains[i], &r.Domains[j]
+		if len(a.Unexplained) != len(b.Unexplained) {
+			return len(a.Unexplained) > len(b.Unexplained)
+		}
+		if len(a.Prefixes) != len(b.Prefixes) {
+			return len(a.Prefixes) > len(b.Prefixes)
+		}
+		return a.Domain < b.Domain
+	})
+	return r
+}
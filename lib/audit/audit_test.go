@@ -0,0 +1,59 @@
+package audit
+
+import (
+	"reflect"
+	"testing"
+
+	"../focal"
+)
+
+func prefix(pattern string) focal.HashPrefix {
+	return focal.HashFromPattern(pattern).Short()
+}
+
+func TestAudit(t *testing.T) {
+	phs := focal.HashPrefixes{prefix("example.com/"), prefix("example.com/a.html"), prefix("news.org/"), prefix("evil.net/phish.html")}
+	phs.Sort()
+	a := New(focal.NewHashSet(phs))
+	if n := a.AddMalicious([]string{"evil.net/phish.html", "http://"}); n != 1 {
+		t.Errorf("%d malicious URLs rejected, want 1", n)
+	}
+	a.AddMaliciousPrefix(focal.HashFromPattern("news.org/"))
+
+	c := a.Scan("alexa", []string{"http://example.com/a.html", "http://www.example.com/b.html", "http://news.org/today", "http://evil.net/", "http://"})
+	if want := (Corpus{Name: "alexa", URLs: 5, Rejected: 1, Hits: 3}); c != want {
+		t.Errorf("scanned %+v, want %+v", c, want)
+	}
+	a.Scan("history", []string{"http://example.com/a.html?x=1"})
+
+	r := a.Report()
+	if r.Published != 4 || r.Matched != 3 || r.Explained != 1 || len(r.Corpora) != 2 {
+		t.Fatalf("got %d published, %d matched, %d explained, %d corpora, want 4, 3, 1 and 2", r.Published, r.Matched, r.Explained, len(r.Corpora))
+	}
+	want := map[focal.HashPrefix]Match{
+		prefix("example.com/"): {
+			Prefix:   prefix("example.com/"),
+			Patterns: []string{"example.com/"},
+			Corpora:  []string{"alexa", "history"},
+		},
+		prefix("example.com/a.html"): {
+			Prefix:   prefix("example.com/a.html"),
+			Patterns: []string{"example.com/a.html"},
+			Corpora:  []string{"alexa", "history"},
+		},
+	}
+	if len(r.Suspicious) != len(want) {
+		t.Fatalf("got %d suspicious prefixes, want %d", len(r.Suspicious), len(want))
+	}
+	for _, m := range r.Suspicious {
+		if !reflect.DeepEqual(m, want[m.Prefix]) {
+			t.Errorf("got %+v, want %+v", m, want[m.Prefix])
+		}
+	}
+
+	// www.example.com/b.html counts for example.com; news.org matches a
+	// single, explained prefix.
+	if len(r.Domains) != 1 || r.Domains[0].Domain != "example.com" || len(r.Domains[0].Prefixes) != 2 || len(r.Domains[0].Unexplained) != 2 {
+		t.Errorf("got domains %+v, want example.com with 2 unexplained prefixes", r.Domains)
+	}
+}
@@ -0,0 +1,285 @@
+// Package feeds imports threat feeds as FOCAL release-json blacklists (see
+// testData/release-json), as the converters of testData/scripts-converting
+// do for text lists and the malwaredomains TSV.
+//
+// The URLs of a feed are canonicalized with focal.CanonicalURL, keeping their
+// query as the converters do, and deduplicated, the first occurrence of a URL
+// being kept. The category of each URL is mapped to the metadata code of the
+// blacklist.
+package feeds
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"../focal"
+	"../lines"
+	"../oprf"
+)
+
+// Code is the metadata code of a URL in a blacklist with metadata.
+type Code int
+
+const (
+	// NoMeta is the code of the URLs of feeds without categories: their
+	// entries have no "m".
+	NoMeta Code = iota - 1
+	Others
+	Phishing
+	Malware
+)
+
+// Category returns the code of a feed category, e.g. "phishing" in the
+// malwaredomains TSV or "malware_download" in URLhaus.
+func Category(s string) Code {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "phishing":
+		return Phishing
+	case "malware", "malware_download":
+		return Malware
+	}
+	return Others
+}
+
+// Format is the format of a feed.
+type Format string
+
+const (
+	// Plain is a URL or domain per line, without categories.
+	Plain Format = "plain"
+	// OpenPhish is the OpenPhish text feed, a phishing URL per line.
+	OpenPhish Format = "openphish"
+	// PhishTankJSON is the PhishTank online-valid.json dump.
+	PhishTankJSON Format = "phishtank-json"
+	// PhishTankCSV is the PhishTank online-valid.csv dump.
+	PhishTankCSV Format = "phishtank-csv"
+	// URLhausCSV is the URLhaus CSV dump, whose header is a comment.
+	URLhausCSV Format = "urlhaus-csv"
+	// MalwareDomains is the malwaredomains TSV, a domain and its category
+	// per line.
+	MalwareDomains Format = "malwaredomains"
+)
+
+// Formats returns the formats of the feeds that can be imported.
+func Formats() []Format {
+	return []Format{Plain, OpenPhish, PhishTankJSON, PhishTankCSV, URLhausCSV, MalwareDomains}
+}
+
+// ParseFormat returns the format named s.
+func ParseFormat(s string) (Format, error) {
+	for _, f := range Formats() {
+		if string(f) == s {
+			return f, nil
+		}
+	}
+	return "", fmt.Errorf("unknown feed format %q", s)
+}
+
+// Record is a URL of a feed.
+type Record struct {
+	Line int    // line of the URL, or its index from 1 in a JSON feed
+	URL  string // as in the feed
+	M    Code
+	Err  error // why the line holds no URL: a *lines.MalformedError or a CSV error
+}
+
+// columns are the columns of the URL and of its category in a CSV feed,
+// -1 for none.
+type columns struct{ url, category int }
+
+// defaultColumns are the columns of the CSV feeds without a header line.
+var defaultColumns = map[Format]columns{
+	PhishTankCSV: {url: 1, category: -1},
+	URLhausCSV:   {url: 2, category: 5},
+}
+
+// Read reads the feed r in format f, and calls fn with each of its URLs, in
+// order. Empty lines and comments are skipped. Read stops at the first error
+// of fn or of r, and returns it.
+func Read(r io.Reader, f Format, fn func(Record) error) error {
+	switch f {
+	case Plain, OpenPhish, MalwareDomains, PhishTankCSV, URLhausCSV:
+	case PhishTankJSON:
+		return readPhishTankJSON(r, fn)
+	default:
+		return fmt.Errorf("unknown feed format %q", f)
+	}
+
+	cols, header := defaultColumns[f], f == PhishTankCSV || f == URLhausCSV
+	lr := lines.NewReader(r)
+	for lr.Scan() {
+		line := strings.TrimSpace(lr.Text())
+		if f == URLhausCSV && strings.HasPrefix(line, "# id,") {
+			// The header of URLhaus is the last comment before the URLs.
+			line = strings.TrimPrefix(line, "# ")
+		} else if line == "" || line[0] == '#' {
+			continue
+		}
+		rec := Record{Line: lr.Line(), URL: line, M: NoMeta, Err: lr.Malformed()}
+		switch f {
+		case OpenPhish:
+			rec.M = Phishing
+		case MalwareDomains:
+			fields := strings.Split(line, "\t")
+			rec.URL, rec.M = fields[0], Others
+			if len(fields) > 1 {
+				rec.M = Category(fields[1])
+			}
+		case PhishTankCSV, URLhausCSV:
+			if rec.Err != nil {
+				break
+			}
+			cr := csv.NewReader(strings.NewReader(line))
+			cr.FieldsPerRecord, cr.LazyQuotes = -1, true
+			fields, err := cr.Read()
+			if err != nil {
+				rec.Err = fmt.Errorf("line %d: %v", lr.Line(), err)
+				break
+			}
+			if header {
+				header = false
+				if c, ok := headerColumns(fields); ok {
+					cols = c
+					continue
+				}
+			}
+			if cols.url >= len(fields) {
+				rec.Err = fmt.Errorf("line %d: no column %d", lr.Line(), cols.url+1)
+				break
+			}
+			rec.URL = strings.TrimSpace(fields[cols.url])
+			rec.M = Phishing
+			if f == URLhausCSV {
+				rec.M = Malware
+				if cols.category >= 0 && cols.category < len(fields) {
+					rec.M = Category(fields[cols.category])
+				}
+			}
+		}
+		if err := fn(rec); err != nil {
+			return err
+		}
+	}
+	return lr.Err()
+}
+
+// headerColumns returns the columns of a CSV header line, if fields is one.
+func headerColumns(fields []string) (columns, bool) {
+	cols := columns{url: -1, category: -1}
+	for i, name := range fields {
+		switch strings.TrimSpace(name) {
+		case "url":
+			cols.url = i
+		case "threat":
+			cols.category = i
+		}
+	}
+	return cols, cols.url >= 0
+}
+
+// readPhishTankJSON reads a PhishTank JSON dump, [{"url": ...}, ...], one
+// entry at a time.
+func readPhishTankJSON(r io.Reader, fn func(Record) error) error {
+	dec := json.NewDecoder(r)
+	if tok, err := dec.Token(); err != nil {
+		return err
+	} else if tok != json.Delim('[') {
+		return fmt.Errorf("phishtank-json: got %v, want an array", tok)
+	}
+	for i := 1; dec.More(); i++ {
+		var entry struct {
+			URL string `json:"url"`
+		}
+		if err := dec.Decode(&entry); err != nil {
+			return fmt.Errorf("phishtank-json: entry %d: %v", i, err)
+		}
+		if err := fn(Record{Line: i, URL: entry.URL, M: Phishing}); err != nil {
+			return err
+		}
+	}
+	_, err := dec.Token()
+	return err
+}
+
+// ReasonMalformed is the reject reason of the lines that hold no URL.
+const ReasonMalformed = "malformed-line"
+
+// Options configures an import.
+type Options struct {
+	// Compat skips the URLs the converters of testData/scripts-converting
+	// skip: those with an escaped ':' and those with a ':' after a '.',
+	// i.e. with a port or a ':' in their path.
+	Compat bool
+}
+
+var (
+	escapedColon  = regexp.MustCompile(`(?i)%3A|%253A`)
+	colonAfterDot = regexp.MustCompile(`\..+:`)
+)
+
+var canonical = focal.Options{KeepQuery: true}
+
+// skipped reports whether the converters skip u.
+func skipped(u string) bool {
+	return escapedColon.MatchString(u) || strings.Contains(u, ":") && colonAfterDot.MatchString(u)
+}
+
+// Stats counts the URLs of an import. Canonical and Unique are the
+// "original canonicalable URL -> deduplicated after canonicalization"
+// numbers of testData/scripts-converting/README.md.
+type Stats struct {
+	Format    Format         `json:"format"`
+	URLs      int            `json:"urls"`      // URLs of the feed
+	Skipped   int            `json:"skipped"`   // skipped by Options.Compat
+	Rejected  map[string]int `json:"rejected"`  // rejected URLs by reason, see focal.RejectReason
+	Canonical int            `json:"canonical"` // canonicalized URLs
+	Unique    int            `json:"unique"`    // canonical URLs once deduplicated
+	Codes     map[Code]int   `json:"codes"`     // unique URLs by metadata code
+}
+
+func (s *Stats) String() string {
+	return fmt.Sprintf("%s: %d -> %d", s.Format, s.Canonical, s.Unique)
+}
+
+// Import reads the feed r in format f and returns its release-json entries,
+// in the order of the feed.
+func Import(r io.Reader, f Format, opts Options) ([]oprf.Entry, *Stats, error) {
+	stats := &Stats{Format: f, Rejected: make(map[string]int), Codes: make(map[Code]int)}
+	var entries []oprf.Entry
+	seen := make(map[string]bool)
+	err := Read(r, f, func(rec Record) error {
+		stats.URLs++
+		if rec.Err != nil {
+			stats.Rejected[ReasonMalformed]++
+			return nil
+		}
+		if opts.Compat && skipped(rec.URL) {
+			stats.Skipped++
+			return nil
+		}
+		canon, err := canonical.CanonicalURL(rec.URL)
+		if err != nil {
+			stats.Rejected[focal.RejectReason(err).String()]++
+			return nil
+		}
+		stats.Canonical++
+		if seen[canon] {
+			return nil
+		}
+		seen[canon] = true
+		e := oprf.Entry{U: canon}
+		if rec.M != NoMeta {
+			e.M = json.RawMessage(strconv.Itoa(int(rec.M)))
+		}
+		entries = append(entries, e)
+		stats.Codes[rec.M]++
+		return nil
+	})
+	stats.Unique = len(entries)
+	return entries, stats, err
+}
@@ -0,0 +1,200 @@
+package feeds
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"../oprf"
+)
+
+func TestImport(t *testing.T) {
+	tests := []struct {
+		format Format
+		path   string
+		want   string
+		stats  Stats
+	}{{
+		PhishTankJSON, "phishtank.json",
+		`[{"u":"amazon.co.uk.security-check.ga\/","m":1},{"u":"paxful.co.in\/","m":1}]`,
+		Stats{URLs: 4, Skipped: 1, Rejected: map[string]int{}, Canonical: 3, Unique: 2, Codes: map[Code]int{Phishing: 2}},
+	}, {
+		PhishTankCSV, "phishtank.csv",
+		`[{"u":"amazon.co.uk.security-check.ga\/","m":1},{"u":"paxful.co.in\/a,b?q=1","m":1}]`,
+		Stats{URLs: 3, Rejected: map[string]int{"missing-hostname": 1}, Canonical: 2, Unique: 2, Codes: map[Code]int{Phishing: 2}},
+	}, {
+		URLhausCSV, "urlhaus.csv",
+		`[{"u":"tnet.at.ua\/index\/0-13","m":2},{"u":"1.2.3.4\/bins\/mips","m":0}]`,
+		Stats{URLs: 3, Rejected: map[string]int{}, Canonical: 3, Unique: 2, Codes: map[Code]int{Others: 1, Malware: 1}},
+	}, {
+		OpenPhish, "openphish.txt",
+		`[{"u":"owmobmen.ru\/","m":1},{"u":"repairshoppr.com\/~admin\/","m":1}]`,
+		Stats{URLs: 3, Rejected: map[string]int{}, Canonical: 3, Unique: 2, Codes: map[Code]int{Phishing: 2}},
+	}, {
+		MalwareDomains, "malwaredomains.tsv",
+		`[{"u":"autosegurancabrasil.com\/","m":1},{"u":"tonyyeo.com\/","m":0},{"u":"unsafe.ppsb.com\/","m":2}]`,
+		Stats{URLs: 4, Rejected: map[string]int{ReasonMalformed: 1}, Canonical: 3, Unique: 3, Codes: map[Code]int{Others: 1, Phishing: 1, Malware: 1}},
+	}, {
+		Plain, "plain.txt",
+		`[{"u":"www.eyemmersive.solutions\/services-offered.html"},{"u":"www.xn--mlat-zra.com\/caf%c3%a9"}]`,
+		Stats{URLs: 7, Skipped: 4, Rejected: map[string]int{"invalid-ipv6": 1}, Canonical: 2, Unique: 2, Codes: map[Code]int{NoMeta: 2}},
+	}}
+	for _, tt := range tests {
+		file, err := os.Open(filepath.Join("testdata", tt.path))
+		if err != nil {
+			t.Fatal(err)
+		}
+		entries, stats, err := Import(file, tt.format, Options{Compat: true})
+		file.Close()
+		if err != nil {
+			t.Errorf("%s: %v", tt.path, err)
+			continue
+		}
+		if got := string(oprf.MarshalEntries(entries)); got != tt.want {
+			t.Errorf("%s:\ngot  %s\nwant %s", tt.path, got, tt.want)
+		}
+		tt.stats.Format = tt.format
+		if !reflect.DeepEqual(*stats, tt.stats) {
+			t.Errorf("%s: stats %+v, want %+v", tt.path, *stats, tt.stats)
+		}
+	}
+
+	// Without Compat, only the URLs canonicalURL rejects are left out.
+	_, stats, err := Import(strings.NewReader("http://a.example.com:8080/\nhttp://b.example.com/x%3Ay\nhttp://[::1/\n"), Plain, Options{})
+	if err != nil || stats.Skipped != 0 || stats.Unique != 2 {
+		t.Errorf("without Compat: stats %+v, error %v", *stats, err)
+	}
+
+	if _, _, err := Import(strings.NewReader(`{"url": "http://a.com/"}`), PhishTankJSON, Options{}); err == nil {
+		t.Error("a PhishTank JSON dump that is not an array is imported")
+	}
+}
+
+// releaseRewritten lists the URLs of the release-json blacklists that the JS
+// converters left uncanonical, with their canonical form: with "//" or "/./" in
+// their path, escaped letters and digits, user info or a "?" right after the
+// hostname (see testData/scripts-converting/README.md).
+var releaseRewritten = []struct{ from, to string }{
+	{"artystick.co/modules/columnadverts/slides//wb/yt/index.php", "artystick.co/modules/columnadverts/slides/wb/yt/index.php"},
+	{"artystick.co/modules/columnadverts/slides//wb/yt/login.php?l=_JeHFUq_VJOXK0QWHtoGYDw1774256418&fid.13InboxLight.aspxn.1774256418&fid.125289964252813InboxLight99642_Product-userid&userid=", "artystick.co/modules/columnadverts/slides/wb/yt/login.php?l=_JeHFUq_VJOXK0QWHtoGYDw1774256418&fid.13InboxLight.aspxn.1774256418&fid.125289964252813InboxLight99642_Product-userid&userid="},
+	{"gamespagesguns.com/FDtUFS5JgDJHGD3dFd//fb1login/ar/?id=1004527&amp;id=2003991", "gamespagesguns.com/FDtUFS5JgDJHGD3dFd/fb1login/ar/?id=1004527&amp;id=2003991"},
+	{"greenlandstour.com/modules/mod_footer/tmpl//mic/index.php", "greenlandstour.com/modules/mod_footer/tmpl/mic/index.php"},
+	{"idealcaisse.fr/cs//", "idealcaisse.fr/cs/"},
+	{"www.utube.co.jp/cs//", "www.utube.co.jp/cs/"},
+	{"jmebert.com/cs//", "jmebert.com/cs/"},
+	{"showdasmelhoresoferttts.com/tel//PROMOCAO/PRODUTOf12a6a7477077af66212ef0813bcf332MRBT/Carrinho/", "showdasmelhoresoferttts.com/tel/PROMOCAO/PRODUTOf12a6a7477077af66212ef0813bcf332MRBT/Carrinho/"},
+	{"showdasmelhoresoferttts.com/tel//PROMOCAO/PRODUTOf12a6a7477077af66212ef0813bcf332MRBT/?Produto=466725e433635de54cba467a494186a4&amp;id=1", "showdasmelhoresoferttts.com/tel/PROMOCAO/PRODUTOf12a6a7477077af66212ef0813bcf332MRBT/?Produto=466725e433635de54cba467a494186a4&amp;id=1"},
+	{"30dayaffiliatechallenge.com/wp-content/plugins/revslider/admin/views/./china/?login=jim@thejimburkefamily.com", "30dayaffiliatechallenge.com/wp-content/plugins/revslider/admin/views/china/?login=jim@thejimburkefamily.com"},
+	{"pizzeriananda.fi/./discover-us/login.php", "pizzeriananda.fi/discover-us/login.php"},
+	{"chunghwadry.com/cs//", "chunghwadry.com/cs/"},
+	{"wap.xffloor.com/cs//", "wap.xffloor.com/cs/"},
+	{"www.cznbtc.cn/pa//?sec=MmmMuenchen", "www.cznbtc.cn/pa/?sec=MmmMuenchen"},
+	{"elzenhout.nl/cs//", "elzenhout.nl/cs/"},
+	{"schapendijk.nl/cs//", "schapendijk.nl/cs/"},
+	{"dressdeal.nl/cs//", "dressdeal.nl/cs/"},
+	{"esferadenegocios.com/img//", "esferadenegocios.com/img/"},
+	{"dietec.com.br/ad//", "dietec.com.br/ad/"},
+	{"db97a08f-d9d8-4908-992f-82c1a56aa0cf.htmlpasta.com?signin&usingssl=1&puserid=&co_partnerid=2&siteid=77&ru/", "db97a08f-d9d8-4908-992f-82c1a56aa0cf.htmlpasta.com/?signin&usingssl=1&puserid=&co_partnerid=2&siteid=77&ru/"},
+	{"www.qingxinzui.com/tag/paypal%4f7f%7528", "www.qingxinzui.com/tag/paypalO7fu28"},
+	{"www.qingxinzui.com/tag/paypal%4f7f%7528/", "www.qingxinzui.com/tag/paypalO7fu28/"},
+	{"malooequitrade.com/mindbark///RuBGaIn///x6.php?profileid=779396830", "malooequitrade.com/mindbark/RuBGaIn/x6.php?profileid=779396830"},
+	{"www.kainer.net/mike/mambots/system//log/index.php", "www.kainer.net/mike/mambots/system/log/index.php"},
+	{"noreply@licquid.com/", "licquid.com/"},
+	{"cassia.martins@itau-unibanco.com.br/", "itau-unibanco.com.br/"},
+	{"dumpidthong.com/cli//forms.asp.htm", "dumpidthong.com/cli/forms.asp.htm"},
+	{"aimtechgen.com/components/com_ckforms/controllers//nab/index.html", "aimtechgen.com/components/com_ckforms/controllers/nab/index.html"},
+	{"guiadesurfing.com/blog//profile/", "guiadesurfing.com/blog/profile/"},
+	{"smplewilld.com/r/4f27d391-6659-452f-8114-dede2fc07b2a//57_185.212.109.30_49_34.237.113.113/0000000_1222317_2618/", "smplewilld.com/r/4f27d391-6659-452f-8114-dede2fc07b2a/57_185.212.109.30_49_34.237.113.113/0000000_1222317_2618/"},
+	{"117.54.13.8/pratesis//wp-content/uploads/2017/12/Z_trophema_reflection.html", "117.54.13.8/pratesis/wp-content/uploads/2017/12/Z_trophema_reflection.html"},
+	{"smplewilld.com/r/cce5d5ed-f68e-4513-ad46-ce53494e3a14//169_104.232.39.119_49_34.237.113.113/0000000_1179015_127/", "smplewilld.com/r/cce5d5ed-f68e-4513-ad46-ce53494e3a14/169_104.232.39.119_49_34.237.113.113/0000000_1179015_127/"},
+	{"smplewilld.com/r/4f27d391-6659-452f-8114-dede2fc07b2a//169_136.243.122.165_49_34.237.113.113/0000000_1160428_2618/", "smplewilld.com/r/4f27d391-6659-452f-8114-dede2fc07b2a/169_136.243.122.165_49_34.237.113.113/0000000_1160428_2618/"},
+	{"smplewilld.com/r/4f27d391-6659-452f-8114-dede2fc07b2a//176_217.182.230.45_49_34.237.113.113/0000000_1173323_127/", "smplewilld.com/r/4f27d391-6659-452f-8114-dede2fc07b2a/176_217.182.230.45_49_34.237.113.113/0000000_1173323_127/"},
+	{"smplewilld.com/r/8d39fac7-8c1f-4297-a0b9-f66a8b72423b//243_148.251.98.60_55_34.237.113.113/87123422_1159034_2607/", "smplewilld.com/r/8d39fac7-8c1f-4297-a0b9-f66a8b72423b/243_148.251.98.60_55_34.237.113.113/87123422_1159034_2607/"},
+	{"billhyde.net/wokers//index.php?94a08da1fecbb6e8b46990538c7b50b2=c4ca4238a0b923820dcc509a6f75849b&amp;e2d01a414f3ea3559a4f05ca801ebf19=c453d77ed287d5b22697c37f839bf5e0&amp;id=1&amp;email=abuse@gen-i.co.nz", "billhyde.net/wokers/index.php?94a08da1fecbb6e8b46990538c7b50b2=c4ca4238a0b923820dcc509a6f75849b&amp;e2d01a414f3ea3559a4f05ca801ebf19=c453d77ed287d5b22697c37f839bf5e0&amp;id=1&amp;email=abuse@gen-i.co.nz"},
+	{"br354.teste.website/~paragomi/wp-content/plugins/vc_responsive_design//assets/css/bb-vcedo.css", "br354.teste.website/~paragomi/wp-content/plugins/vc_responsive_design/assets/css/bb-vcedo.css"},
+	{"www.scottsdalesynchro.org/scottsdale-synchronized-swimming/wp-content/themes/rezo//uploads/p_tessara_Sparmannia.html", "www.scottsdalesynchro.org/scottsdale-synchronized-swimming/wp-content/themes/rezo/uploads/p_tessara_Sparmannia.html"},
+	{"www.bouncewell.com/tenn//1dcad8cc5b82a7ef72f72716b220cf13", "www.bouncewell.com/tenn/1dcad8cc5b82a7ef72f72716b220cf13"},
+	{"fpn81171321mp.hol.es?/", "fpn81171321mp.hol.es/?/"},
+}
+
+// TestReleaseJSON imports the URLs of the release-json blacklists again,
+// with the category names of their codes. Their numbers are the deduplicated
+// ones of testData/scripts-converting/README.md (the feeds they were imported
+// from are not in the tree), and the URLs are kept as they are, with their
+// code, but for the ones of releaseRewritten. One of them,
+// "guiadesurfing.com/blog//profile/", is then a duplicate.
+func TestReleaseJSON(t *testing.T) {
+	names := map[string]string{"0": "attackpage", "1": "phishing", "2": "malware"}
+	rewrites := make(map[string]string)
+	for _, r := range releaseRewritten {
+		rewrites[r.from] = r.to
+	}
+	used := make(map[string]bool)
+	for _, tt := range []struct {
+		list      string
+		format    Format
+		canonical int
+		unique    int
+		rewritten int
+	}{
+		{"phishtank.withoutmeta", Plain, 14583, 14582, 38},
+		{"malwaredomains.withmeta", MalwareDomains, 27013, 27013, 1},
+	} {
+		release, err := oprf.ReadEntries(filepath.Join("../../testData/release-json", tt.list+".json"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var feed bytes.Buffer
+		var want []oprf.Entry
+		seen := make(map[string]bool)
+		for _, e := range release {
+			if tt.format == MalwareDomains {
+				fmt.Fprintf(&feed, "\t\t%s\t%s\treference\t20190121\n", e.U, names[string(e.M)])
+			} else {
+				fmt.Fprintln(&feed, e.U)
+			}
+			u := e.U
+			if r, ok := rewrites[u]; ok {
+				used[u] = true
+				u = r
+			}
+			if !seen[u] {
+				seen[u] = true
+				want = append(want, oprf.Entry{U: u, M: e.M})
+			}
+		}
+
+		entries, stats, err := Import(&feed, tt.format, Options{Compat: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if stats.Canonical != tt.canonical || stats.Unique != tt.unique {
+			t.Errorf("%s: %v, want %d -> %d", tt.list, stats, tt.canonical, tt.unique)
+		}
+		if got, want := oprf.MarshalEntries(entries), oprf.MarshalEntries(want); !bytes.Equal(got, want) {
+			t.Errorf("%s: the %d entries differ from the %d expected", tt.list, len(entries), len(want))
+		}
+		inRelease := make(map[string]bool, len(release))
+		for _, e := range release {
+			inRelease[e.U] = true
+		}
+		rewritten := 0
+		for _, e := range entries {
+			if !inRelease[e.U] {
+				rewritten++
+			}
+		}
+		if rewritten != tt.rewritten {
+			t.Errorf("%s: %d URLs canonicalized again, want %d", tt.list, rewritten, tt.rewritten)
+		}
+	}
+	for _, r := range releaseRewritten {
+		if !used[r.from] {
+			t.Errorf("%q is not in the release-json blacklists", r.from)
+		}
+	}
+}
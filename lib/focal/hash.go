@@ -0,0 +1,708 @@
+// Copyright 2016 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package focal
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	// pb "github.com/google/safebrowsing/internal/safebrowsing_proto"
+)
+
+const (
+	MinHashPrefixLength = 4
+	MaxHashPrefixLength = sha256.Size
+)
+
+// HashPrefix represents a SHA256 hash. It may either be
+// be full, where len(Hash) == MaxHashPrefixLength, or
+// be partial, where len(Hash) >= MinHashPrefixLength.
+type HashPrefix string
+
+// HashFromPattern returns a full hash for the given URL pattern.
+func HashFromPattern(pattern string) HashPrefix {
+	hash := sha256.New()
+	hash.Write([]byte(pattern))
+	return HashPrefix(hash.Sum(nil))
+}
+
+// Short returns the 32-bit prefix of h, the prefix Safe Browsing clients and
+// FOCAL blacklists match first.
+func (h HashPrefix) Short() HashPrefix {
+	if len(h) < MinHashPrefixLength {
+		return h
+	}
+	return h[:MinHashPrefixLength]
+}
+
+// Truncate returns the first bits bits of h. The bits of the last byte past
+// bits are cleared, so prefixes of any bit length compare as strings. It
+// panics if h is shorter than bits.
+func (h HashPrefix) Truncate(bits int) HashPrefix {
+	b := []byte(h[:(bits+7)/8])
+	if r := bits % 8; r != 0 {
+		b[len(b)-1] &= 0xff << uint(8-r)
+	}
+	return HashPrefix(b)
+}
+
+// Uint32BE returns the 32-bit prefix of h as a big-endian integer, i.e., the
+// value of its hex form.
+func (h HashPrefix) Uint32BE() uint32 {
+	return binary.BigEndian.Uint32([]byte(h[:MinHashPrefixLength]))
+}
+
+// Uint32LE returns the 32-bit prefix of h as a little-endian integer. This is
+// the value FOCAL blacklists publish in "s", i.e., new Uint32Array(hash)[0].
+func (h HashPrefix) Uint32LE() uint32 {
+	return binary.LittleEndian.Uint32([]byte(h[:MinHashPrefixLength]))
+}
+
+// HashPrefixFromUint32BE returns the 32-bit prefix whose big-endian value is v.
+func HashPrefixFromUint32BE(v uint32) HashPrefix {
+	var b [MinHashPrefixLength]byte
+	binary.BigEndian.PutUint32(b[:], v)
+	return HashPrefix(b[:])
+}
+
+// HashPrefixFromUint32LE returns the 32-bit prefix whose little-endian value
+// is v.
+func HashPrefixFromUint32LE(v uint32) HashPrefix {
+	var b [MinHashPrefixLength]byte
+	binary.LittleEndian.PutUint32(b[:], v)
+	return HashPrefix(b[:])
+}
+
+// Hex returns the lowercase hex form of h, e.g., "88981e62".
+func (h HashPrefix) Hex() string {
+	return hex.EncodeToString([]byte(h))
+}
+
+// Binary returns h as a string of bits, most significant bit first.
+func (h HashPrefix) Binary() string {
+	var b strings.Builder
+	for i := 0; i < len(h); i++ {
+		fmt.Fprintf(&b, "%08b", h[i])
+	}
+	return b.String()
+}
+
+// PrefixEncoding is a textual encoding of hash prefixes. The Go analyses, the
+// Safe Browsing database and FOCAL blacklists do not agree on one, so every
+// reader and writer of prefixes has to name the encoding it uses.
+type PrefixEncoding int
+
+const (
+	// HexPrefix is the lowercase hex form of the prefix bytes, as in
+	// GSBhashprefixes.txt and the hex(value) of gsb_v4.db.
+	HexPrefix PrefixEncoding = iota
+	// BinaryPrefix is the string of bits of the prefix, most significant
+	// bit first, as the keys of the eCrimeX indexes (ecrimematchegsb.json).
+	BinaryPrefix
+	// BigEndianPrefix is the decimal big-endian uint32 of a 32-bit prefix.
+	BigEndianPrefix
+	// LittleEndianPrefix is the decimal little-endian uint32 of a 32-bit
+	// prefix, as in the "s" array of FOCAL blacklists.
+	LittleEndianPrefix
+)
+
+var prefixEncodingNames = []string{"hex", "bin", "be", "le"}
+
+func (e PrefixEncoding) String() string {
+	if int(e) < len(prefixEncodingNames) {
+		return prefixEncodingNames[e]
+	}
+	return "PrefixEncoding(" + strconv.Itoa(int(e)) + ")"
+}
+
+// ParsePrefixEncoding returns the encoding named hex, bin, be or le.
+func ParsePrefixEncoding(name string) (PrefixEncoding, error) {
+	for i, n := range prefixEncodingNames {
+		if n == name {
+			return PrefixEncoding(i), nil
+		}
+	}
+	return 0, fmt.Errorf("focal: unknown prefix encoding %q (want hex, bin, be or le)", name)
+}
+
+// Set implements flag.Value.
+func (e *PrefixEncoding) Set(name string) error {
+	v, err := ParsePrefixEncoding(name)
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
+// Encode returns the encoded form of h. The integer encodings only encode the
+// 32-bit prefix of h.
+func (e PrefixEncoding) Encode(h HashPrefix) string {
+	switch e {
+	case BinaryPrefix:
+		return h.Binary()
+	case BigEndianPrefix:
+		return strconv.FormatUint(uint64(h.Uint32BE()), 10)
+	case LittleEndianPrefix:
+		return strconv.FormatUint(uint64(h.Uint32LE()), 10)
+	}
+	return h.Hex()
+}
+
+// Decode parses a prefix in the encoding e.
+func (e PrefixEncoding) Decode(s string) (HashPrefix, error) {
+	switch e {
+	case HexPrefix:
+		b, err := hex.DecodeString(s)
+		if err != nil {
+			return "", fmt.Errorf("focal: invalid hex prefix %q", s)
+		}
+		return HashPrefix(b), nil
+	case BinaryPrefix:
+		if len(s) == 0 || len(s)%8 != 0 {
+			return "", fmt.Errorf("focal: invalid binary prefix %q", s)
+		}
+		b := make([]byte, len(s)/8)
+		for i := range b {
+			v, err := strconv.ParseUint(s[8*i:8*i+8], 2, 8)
+			if err != nil {
+				return "", fmt.Errorf("focal: invalid binary prefix %q", s)
+			}
+			b[i] = byte(v)
+		}
+		return HashPrefix(b), nil
+	case BigEndianPrefix, LittleEndianPrefix:
+		v, err := strconv.ParseUint(s, 10, 32)
+		if err != nil {
+			return "", fmt.Errorf("focal: invalid %s prefix %q", e, s)
+		}
+		if e == BigEndianPrefix {
+			return HashPrefixFromUint32BE(uint32(v)), nil
+		}
+		return HashPrefixFromUint32LE(uint32(v)), nil
+	}
+	return "", errors.New("focal: unknown prefix encoding")
+}
+
+// HasPrefix reports whether other is a prefix of h.
+func (h HashPrefix) HasPrefix(other HashPrefix) bool {
+	return strings.HasPrefix(string(h), string(other))
+}
+
+// IsFull reports whether the hash is a full SHA256 hash.
+func (h HashPrefix) IsFull() bool {
+	return len(h) == MaxHashPrefixLength
+}
+
+// IsValid reports whether the hash is a valid partial or full hash.
+func (h HashPrefix) IsValid() bool {
+	return len(h) >= MinHashPrefixLength && len(h) <= MaxHashPrefixLength
+}
+
+type HashPrefixes []HashPrefix
+
+func (p HashPrefixes) Len() int           { return len(p) }
+func (p HashPrefixes) Less(i, j int) bool { return p[i] < p[j] }
+func (p HashPrefixes) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
+func (p HashPrefixes) Sort()              { sort.Sort(p) }
+
+// Validate checks that the list of hash prefixes is valid. It checks the
+// following parameters:
+//   - That each hash prefix is valid; that is, it has a length within
+//     MinHashPrefixLength and MaxHashPrefixLength.
+//   - That the list of prefixes is sorted.
+//   - That none of the hashes are prefixes of each other.
+func (p HashPrefixes) Validate() error {
+	var hp HashPrefix // Previous hash
+	for _, h := range p {
+		switch {
+		case !h.IsValid():
+			return errors.New("focal: invalid hash")
+		case hp >= h:
+			return errors.New("focal: unsorted hash list")
+		case h.HasPrefix(hp) && hp != "":
+			return errors.New("focal: non-unique hash prefix")
+		}
+		hp = h
+	}
+	return nil
+}
+
+// SHA256 returns the SHA256 checksum of the concatenated prefixes. For a
+// sorted list, this is the checksum Safe Browsing reports for a threat list.
+func (p HashPrefixes) SHA256() []byte {
+	hash := sha256.New()
+	for _, b := range p {
+		hash.Write([]byte(b))
+	}
+	return hash.Sum(nil)
+}
+
+// HashSet is a set of hash prefixes optimized for the fact that most hashes
+// are only 4 bytes in length. The prefixes of a set must be valid and none of
+// them may be a prefix of another, as checked by HashPrefixes.Validate.
+type HashSet struct {
+	h4 map[[MinHashPrefixLength]byte]uint8 // Value is maximum length prefix
+	hx map[HashPrefix]struct{}
+	n  int
+}
+
+// NewHashSet returns a set of the prefixes phs.
+func NewHashSet(phs HashPrefixes) *HashSet {
+	hs := new(HashSet)
+	hs.Import(phs)
+	return hs
+}
+
+func byte4(h HashPrefix) (b [4]byte) {
+	b[0], b[1], b[2], b[3] = h[0], h[1], h[2], h[3]
+	return b
+}
+
+// Len returns the number of prefixes in the set.
+func (hs *HashSet) Len() int { return hs.n }
+
+// Import replaces the content of the set by the prefixes phs.
+func (hs *HashSet) Import(phs HashPrefixes) {
+	hs.h4 = make(map[[MinHashPrefixLength]byte]uint8, len(phs))
+	hs.hx = make(map[HashPrefix]struct{})
+	hs.n = len(phs)
+	for _, h := range phs {
+		n := hs.h4[byte4(h)]
+		if len(h) > int(n) {
+			hs.h4[byte4(h)] = uint8(len(h))
+		}
+		if len(h) > 4 {
+			hs.hx[h] = struct{}{}
+		}
+	}
+}
+
+// Add adds h to the set, so that sets can be filled as prefixes are read.
+// Adding a prefix that is already in the set has no effect. Adding an invalid
+// prefix, or one that is a prefix of another in the set or has one of them as
+// a prefix, fails as HashPrefixes.Validate does and leaves the set unchanged.
+func (hs *HashSet) Add(h HashPrefix) error {
+	if !h.IsValid() {
+		return errors.New("focal: invalid hash")
+	}
+	if hs.h4 == nil {
+		hs.h4 = make(map[[MinHashPrefixLength]byte]uint8)
+		hs.hx = make(map[HashPrefix]struct{})
+	}
+	n := int(hs.h4[byte4(h)])
+	if n > 0 {
+		switch m := hs.Lookup(h); {
+		case m == len(h):
+			return nil
+		case m > 0 || n > len(h) && hs.hasLonger(h):
+			return errors.New("focal: non-unique hash prefix")
+		}
+	}
+	if len(h) > MinHashPrefixLength {
+		hs.hx[h] = struct{}{}
+	}
+	if len(h) > n {
+		hs.h4[byte4(h)] = uint8(len(h))
+	}
+	hs.n++
+	return nil
+}
+
+// hasLonger reports whether h is a prefix of a longer prefix of the set. Only
+// the prefixes longer than 4 bytes are scanned, and only when one of them
+// shares the first 4 bytes of h, which is rare.
+func (hs *HashSet) hasLonger(h HashPrefix) bool {
+	if len(h) == MinHashPrefixLength {
+		return true
+	}
+	for x := range hs.hx {
+		if len(x) > len(h) && x.HasPrefix(h) {
+			return true
+		}
+	}
+	return false
+}
+
+// Export returns the prefixes of the set, sorted.
+func (hs *HashSet) Export() HashPrefixes {
+	phs := make(HashPrefixes, 0, hs.n)
+	for h, n := range hs.h4 {
+		if n == MinHashPrefixLength {
+			phs = append(phs, HashPrefix(h[:]))
+		}
+	}
+	for h := range hs.hx {
+		phs = append(phs, h)
+	}
+	phs.Sort()
+	return phs
+}
+
+// Lookup returns the length of the prefix of h that is in the set, or 0 if
+// none is. h is usually a full hash and must be at least 4 bytes long.
+func (hs *HashSet) Lookup(h HashPrefix) int {
+	n := int(hs.h4[byte4(h)])
+	if n <= MinHashPrefixLength {
+		return n
+	}
+	if n > len(h) {
+		n = len(h)
+	}
+	for i := MinHashPrefixLength; i <= n; i++ {
+		if _, ok := hs.hx[h[:i]]; ok {
+			return i
+		}
+	}
+	return 0
+}
+
+// // decodeHashes takes a ThreatEntrySet and returns a list of hashes that should
+// // be added to the local database.
+// func decodeHashes(input *pb.ThreatEntrySet) ([]HashPrefix, error) {
+// 	switch input.CompressionType {
+// 	case pb.CompressionType_RAW:
+// 		raw := input.GetRawHashes()
+// 		if raw == nil {
+// 			return nil, errors.New("safebrowsing: nil raw hashes")
+// 		}
+// 		if raw.PrefixSize < MinHashPrefixLength || raw.PrefixSize > MaxHashPrefixLength {
+// 			return nil, errors.New("safebrowsing: invalid hash prefix length")
+// 		}
+// 		if len(raw.RawHashes)%int(raw.PrefixSize) != 0 {
+// 			return nil, errors.New("safebrowsing: invalid raw hashes")
+// 		}
+// 		hashes := make([]HashPrefix, len(raw.RawHashes)/int(raw.PrefixSize))
+// 		for i := range hashes {
+// 			hashes[i] = HashPrefix(raw.RawHashes[:raw.PrefixSize])
+// 			raw.RawHashes = raw.RawHashes[raw.PrefixSize:]
+// 		}
+// 		return hashes, nil
+// 	case pb.CompressionType_RICE:
+// 		values, err := decodeRiceIntegers(input.GetRiceHashes())
+// 		if err != nil {
+// 			return nil, err
+// 		}
+// 		hashes := make([]HashPrefix, 0, len(values))
+// 		var buf [4]byte
+// 		for _, h := range values {
+// 			binary.LittleEndian.PutUint32(buf[:], h)
+// 			hashes = append(hashes, HashPrefix(buf[:]))
+// 		}
+// 		return hashes, nil
+// 	default:
+// 		return nil, errors.New("safebrowsing: invalid compression type")
+// 	}
+// }
+
+// // decodeIndices takes a ThreatEntrySet for removals returned by the server and
+// // returns a list of indices that the client should remove from its database.
+// func decodeIndices(input *pb.ThreatEntrySet) ([]int32, error) {
+// 	switch input.CompressionType {
+// 	case pb.CompressionType_RAW:
+// 		raw := input.GetRawIndices()
+// 		if raw == nil {
+// 			return nil, errors.New("safebrowsing: invalid raw indices")
+// 		}
+// 		return raw.Indices, nil
+// 	case pb.CompressionType_RICE:
+// 		values, err := decodeRiceIntegers(input.GetRiceIndices())
+// 		if err != nil {
+// 			return nil, err
+// 		}
+// 		indices := make([]int32, 0, len(values))
+// 		for _, v := range values {
+// 			indices = append(indices, int32(v))
+// 		}
+// 		return indices, nil
+// 	default:
+// 		return nil, errors.New("safebrowsing: invalid compression type")
+// 	}
+// }
+
+// RiceDeltaEncoding is a list of sorted integers, delta encoded with
+// Golomb-Rice coding. It mirrors the RiceDeltaEncoding message of the Safe
+// Browsing API: the deltas between the NumEntries values following FirstValue
+// are Rice coded with the parameter RiceParameter in EncodedData.
+type RiceDeltaEncoding struct {
+	FirstValue    int64  `json:"firstValue,string"`
+	RiceParameter int32  `json:"riceParameter"`
+	NumEntries    int32  `json:"numEntries"`
+	EncodedData   []byte `json:"encodedData"`
+}
+
+// maxRiceParameter is the largest k EncodeRiceIntegers selects. The decoder
+// accepts up to 32 as the Safe Browsing API does.
+const maxRiceParameter = 31
+
+// EncodeRiceIntegers delta encodes a list of sorted integers, selecting the
+// Rice parameter that yields the shortest encoding.
+func EncodeRiceIntegers(values []uint32) (*RiceDeltaEncoding, error) {
+	if len(values) == 0 {
+		return nil, errors.New("focal: no values to encode")
+	}
+	deltas := make([]uint32, len(values)-1)
+	for i := range deltas {
+		if values[i+1] < values[i] {
+			return nil, errors.New("focal: unsorted values")
+		}
+		deltas[i] = values[i+1] - values[i]
+	}
+
+	k := riceParameter(deltas)
+	bw := new(bitWriter)
+	re := newRiceEncoder(bw, k)
+	for _, d := range deltas {
+		re.WriteValue(d)
+	}
+	return &RiceDeltaEncoding{
+		FirstValue:    int64(values[0]),
+		RiceParameter: int32(k),
+		NumEntries:    int32(len(deltas)),
+		EncodedData:   bw.Bytes(),
+	}, nil
+}
+
+// riceParameter returns the k that encodes the deltas in the fewest bits. A
+// delta d takes (d>>k)+1+k bits.
+func riceParameter(deltas []uint32) uint32 {
+	best, bestBits := uint32(0), uint64(0)
+	for k := uint32(0); k <= maxRiceParameter; k++ {
+		bits := uint64(len(deltas)) * uint64(1+k)
+		for _, d := range deltas {
+			bits += uint64(d >> k)
+		}
+		if k == 0 || bits < bestBits {
+			best, bestBits = k, bits
+		}
+	}
+	return best
+}
+
+// DecodeRiceIntegers decodes a list of Golomb-Rice encoded integers.
+func DecodeRiceIntegers(rice *RiceDeltaEncoding) ([]uint32, error) {
+	if rice == nil {
+		return nil, errors.New("focal: missing rice encoded data")
+	}
+	if rice.RiceParameter < 0 || rice.RiceParameter > 32 {
+		return nil, errors.New("focal: invalid k parameter")
+	}
+	if rice.FirstValue < 0 || rice.FirstValue > 1<<32-1 || rice.NumEntries < 0 {
+		return nil, errors.New("focal: invalid rice encoded values")
+	}
+
+	values := []uint32{uint32(rice.FirstValue)}
+	br := newBitReader(rice.EncodedData)
+	rd := newRiceDecoder(br, uint32(rice.RiceParameter))
+	for i := 0; i < int(rice.NumEntries); i++ {
+		delta, err := rd.ReadValue()
+		if err != nil {
+			return nil, err
+		}
+		v := values[i] + delta
+		if v < values[i] {
+			return nil, errors.New("focal: rice encoded value overflows")
+		}
+		values = append(values, v)
+	}
+
+	if br.BitsRemaining() >= 8 {
+		return nil, errors.New("focal: unconsumed rice encoded data")
+	}
+	return values, nil
+}
+
+// EncodeRicePrefixes Rice encodes a set of 4-byte hash prefixes as the
+// sorted list of their little-endian values, the order of both Safe Browsing
+// and FOCAL blacklists. Duplicates are dropped.
+func EncodeRicePrefixes(phs HashPrefixes) (*RiceDeltaEncoding, error) {
+	values := make([]uint32, 0, len(phs))
+	for _, h := range phs {
+		if len(h) != MinHashPrefixLength {
+			return nil, fmt.Errorf("focal: cannot rice encode %d-byte hash prefix", len(h))
+		}
+		values = append(values, h.Uint32LE())
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+	n := 0
+	for i, v := range values {
+		if i == 0 || v != values[n-1] {
+			values[n] = v
+			n++
+		}
+	}
+	return EncodeRiceIntegers(values[:n])
+}
+
+// DecodeRicePrefixes returns the 4-byte hash prefixes encoded by
+// EncodeRicePrefixes.
+func DecodeRicePrefixes(rice *RiceDeltaEncoding) (HashPrefixes, error) {
+	values, err := DecodeRiceIntegers(rice)
+	if err != nil {
+		return nil, err
+	}
+	hashes := make(HashPrefixes, 0, len(values))
+	for _, v := range values {
+		hashes = append(hashes, HashPrefixFromUint32LE(v))
+	}
+	return hashes, nil
+}
+
+// riceDecoder implements Golomb-Rice decoding for the Safe Browsing API.
+//
+// In a Rice decoder every number n is encoded as q and r where n = (q<<k) + r.
+// k is a constant and a parameter of the Rice decoder and can have values in
+// 0..32 inclusive. The values for q and r are encoded in the bit stream using
+// different encoding schemes. The quotient comes before the remainder.
+//
+// The quotient q is encoded in unary coding followed by a 0. E.g., 3 would be
+// encoded as 1110, 4 as 11110, and 7 as 11111110.
+//
+// The remainder r is encoded using k bits as an unsigned integer with the
+// least-significant bits coming first in the bit stream.
+//
+// For more information, see the following:
+//
+//	https://en.wikipedia.org/wiki/Golomb_coding
+type riceDecoder struct {
+	br *bitReader
+	k  uint32 // Golomb-Rice parameter
+}
+
+func newRiceDecoder(br *bitReader, k uint32) *riceDecoder {
+	return &riceDecoder{br, k}
+}
+
+func (rd *riceDecoder) ReadValue() (uint32, error) {
+	var q uint32
+	for {
+		bit, err := rd.br.ReadBits(1)
+		if err != nil {
+			return 0, err
+		}
+		q += bit
+		if bit == 0 {
+			break
+		}
+	}
+
+	r, err := rd.br.ReadBits(int(rd.k))
+	if err != nil {
+		return 0, err
+	}
+
+	v := uint64(q)<<rd.k + uint64(r)
+	if v > 1<<32-1 {
+		return 0, errors.New("focal: rice encoded value overflows")
+	}
+	return uint32(v), nil
+}
+
+// riceEncoder is the Golomb-Rice encoder matching riceDecoder.
+type riceEncoder struct {
+	bw *bitWriter
+	k  uint32 // Golomb-Rice parameter
+}
+
+func newRiceEncoder(bw *bitWriter, k uint32) *riceEncoder {
+	return &riceEncoder{bw, k}
+}
+
+func (re *riceEncoder) WriteValue(v uint32) {
+	for q := v >> re.k; q > 0; q-- {
+		re.bw.WriteBits(1, 1)
+	}
+	re.bw.WriteBits(0, 1)
+	re.bw.WriteBits(v, int(re.k))
+}
+
+// The bitReader provides functionality to read bits from a slice of bytes.
+//
+// Logically, the bit stream is constructed such that the first byte of buf
+// represent the first bits in the stream. Within a byte, the least-significant
+// bits come before the most-significant bits in the bit stream.
+//
+// This is the same bit stream format as DEFLATE (RFC 1951).
+type bitReader struct {
+	buf  []byte
+	mask byte
+}
+
+func newBitReader(buf []byte) *bitReader {
+	return &bitReader{buf, 0x01}
+}
+
+func (br *bitReader) ReadBits(n int) (uint32, error) {
+	if n < 0 || n > 32 {
+		panic("invalid number of bits")
+	}
+
+	var v uint32
+	for i := 0; i < n; i++ {
+		if len(br.buf) == 0 {
+			return v, io.ErrUnexpectedEOF
+		}
+		if br.buf[0]&br.mask > 0 {
+			v |= 1 << uint(i)
+		}
+		br.mask <<= 1
+		if br.mask == 0 {
+			br.buf, br.mask = br.buf[1:], 0x01
+		}
+	}
+	return v, nil
+}
+
+// BitsRemaining reports the number of bits left to read.
+func (br *bitReader) BitsRemaining() int {
+	n := 8 * len(br.buf)
+	for m := br.mask | 1; m != 1; m >>= 1 {
+		n--
+	}
+	return n
+}
+
+// The bitWriter writes a bit stream in the format bitReader reads.
+type bitWriter struct {
+	buf  []byte
+	mask byte
+}
+
+func (bw *bitWriter) WriteBits(v uint32, n int) {
+	if n < 0 || n > 32 {
+		panic("invalid number of bits")
+	}
+
+	for i := 0; i < n; i++ {
+		if bw.mask == 0 {
+			bw.buf, bw.mask = append(bw.buf, 0), 0x01
+		}
+		if v&(1<<uint(i)) != 0 {
+			bw.buf[len(bw.buf)-1] |= bw.mask
+		}
+		bw.mask <<= 1
+	}
+}
+
+// Bytes returns the bit stream, padded with zero bits to a whole byte.
+func (bw *bitWriter) Bytes() []byte {
+	return bw.buf
+}
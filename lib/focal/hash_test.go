@@ -0,0 +1,344 @@
+// Copyright 2016 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package focal
+
+import (
+	"encoding/hex"
+	"reflect"
+	"testing"
+)
+
+func mustDecodeHex(t *testing.T, s string) HashPrefix {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return HashPrefix(b)
+}
+
+func TestPrefixEncodings(t *testing.T) {
+	// "google.com/" is the 88981e62 prefix of testData/test-source/evidence.txt.
+	h := HashFromPattern("google.com/").Short()
+
+	vectors := []struct {
+		enc PrefixEncoding
+		s   string
+	}{
+		{HexPrefix, "88981e62"},
+		{BinaryPrefix, "10001000100110000001111001100010"},
+		{BigEndianPrefix, "2291670626"},
+		{LittleEndianPrefix, "1646172296"},
+	}
+	for _, v := range vectors {
+		if got := v.enc.Encode(h); got != v.s {
+			t.Errorf("%v.Encode(%x) = %q, want %q", v.enc, h, got, v.s)
+		}
+		got, err := v.enc.Decode(v.s)
+		if err != nil || got != h {
+			t.Errorf("%v.Decode(%q) = %x, %v, want %x", v.enc, v.s, got, err, h)
+		}
+		if e, err := ParsePrefixEncoding(v.enc.String()); err != nil || e != v.enc {
+			t.Errorf("ParsePrefixEncoding(%q) = %v, %v", v.enc.String(), e, err)
+		}
+	}
+
+	if h.Uint32BE() != 0x88981e62 || h.Uint32LE() != 0x621e9888 {
+		t.Errorf("Uint32BE, Uint32LE = %#x, %#x", h.Uint32BE(), h.Uint32LE())
+	}
+	if HashPrefixFromUint32LE(h.Uint32LE()) != h || HashPrefixFromUint32BE(h.Uint32BE()) != h {
+		t.Errorf("HashPrefixFromUint32 does not round trip %x", h)
+	}
+
+	for _, v := range []struct {
+		enc PrefixEncoding
+		s   string
+	}{
+		{HexPrefix, "88981e6"},
+		{HexPrefix, "zz981e62"},
+		{BinaryPrefix, "1000100"},
+		{BinaryPrefix, "1000100a"},
+		{BigEndianPrefix, "4294967296"},
+		{LittleEndianPrefix, "-1"},
+	} {
+		if _, err := v.enc.Decode(v.s); err == nil {
+			t.Errorf("%v.Decode(%q) unexpectedly succeeded", v.enc, v.s)
+		}
+	}
+	if _, err := ParsePrefixEncoding("base64"); err == nil {
+		t.Error("ParsePrefixEncoding(base64) unexpectedly succeeded")
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	h := HashFromPattern("google.com/")
+
+	vectors := []struct {
+		bits int
+		want string
+	}{
+		{8, "88"},
+		{12, "8890"},
+		{18, "889800"},
+		{32, "88981e62"},
+		{256, h.Hex()},
+	}
+	for _, v := range vectors {
+		got := h.Truncate(v.bits)
+		if got.Hex() != v.want {
+			t.Errorf("Truncate(%d) = %x, want %s", v.bits, got, v.want)
+		}
+		if got.Binary()[:v.bits] != h.Binary()[:v.bits] {
+			t.Errorf("Truncate(%d) = %s, not a prefix of %s", v.bits, got.Binary(), h.Binary())
+		}
+	}
+	if h.Truncate(32) != h.Short() {
+		t.Errorf("Truncate(32) = %x, want Short() = %x", h.Truncate(32), h.Short())
+	}
+}
+
+func TestHashSet(t *testing.T) {
+	full := HashFromPattern("google.com/")
+	phs := HashPrefixes{
+		mustDecodeHex(t, "00000001"),
+		mustDecodeHex(t, "0000000200"),
+		mustDecodeHex(t, "0000000301020304"),
+		mustDecodeHex(t, "0000000305"),
+		full,
+		mustDecodeHex(t, "ffffffff"),
+	}
+	hs := NewHashSet(phs)
+	if hs.Len() != len(phs) {
+		t.Errorf("Len() = %d, want %d", hs.Len(), len(phs))
+	}
+
+	vectors := []struct {
+		h    string
+		want int
+	}{
+		{"00000001", 4},
+		{"00000001ffff", 4},
+		{"00000002", 0},     // Only a longer prefix shares these 4 bytes.
+		{"0000000200", 5},   // The 5-byte prefix itself.
+		{"0000000201", 0},   // Same 4 bytes, different 5th byte.
+		{"000000030102", 0}, // Shorter than the 8-byte prefix.
+		{"00000003010203040506", 8},
+		{"0000000305ab", 5},
+		{"00000004", 0},
+		{"ffffffff00", 4},
+		{full.Hex(), 32},
+		{full.Short().Hex() + "00", 0},
+	}
+	for _, v := range vectors {
+		if got := hs.Lookup(mustDecodeHex(t, v.h)); got != v.want {
+			t.Errorf("Lookup(%s) = %d, want %d", v.h, got, v.want)
+		}
+	}
+
+	sorted := append(HashPrefixes(nil), phs...)
+	sorted.Sort()
+	if got := hs.Export(); !reflect.DeepEqual(got, sorted) {
+		t.Errorf("Export() = %x, want %x", got, sorted)
+	}
+
+	var empty HashSet
+	if empty.Len() != 0 || empty.Lookup(full) != 0 || len(empty.Export()) != 0 {
+		t.Error("the zero HashSet is not empty")
+	}
+
+	var added HashSet
+	for _, h := range append(phs, phs[0], phs[1], full) {
+		if err := added.Add(h); err != nil {
+			t.Errorf("Add(%x): %v", h, err)
+		}
+	}
+	if added.Len() != len(phs) {
+		t.Errorf("Len() after Add = %d, want %d", added.Len(), len(phs))
+	}
+	if got := added.Export(); !reflect.DeepEqual(got, sorted) {
+		t.Errorf("Export() after Add = %x, want %x", got, sorted)
+	}
+	for _, v := range vectors {
+		if got := added.Lookup(mustDecodeHex(t, v.h)); got != v.want {
+			t.Errorf("Lookup(%s) after Add = %d, want %d", v.h, got, v.want)
+		}
+	}
+
+	// Overlapping prefixes are rejected in either order, leaving the set
+	// as it was.
+	overlaps := []struct {
+		first, second string
+		lookup        string
+		want          int
+	}{
+		{"61626364", "6162636465666768", "6162636458585858", 4},
+		{"6162636465666768", "61626364", "6162636465666768", 8},
+		{"0000000200", "000000020001", "000000020001", 5},
+		{"000000020001", "0000000200", "000000020001", 6},
+		{"00000001", "000001", "00000001", 4}, // Too short.
+	}
+	for _, v := range overlaps {
+		var set HashSet
+		first := mustDecodeHex(t, v.first)
+		if err := set.Add(first); err != nil {
+			t.Fatalf("Add(%s): %v", v.first, err)
+		}
+		if err := set.Add(mustDecodeHex(t, v.second)); err == nil {
+			t.Errorf("Add(%s) after %s succeeded", v.second, v.first)
+		}
+		if set.Len() != 1 || !reflect.DeepEqual(set.Export(), HashPrefixes{first}) {
+			t.Errorf("after %s and %s: Len() = %d, Export() = %x", v.first, v.second, set.Len(), set.Export())
+		}
+		if got := set.Lookup(mustDecodeHex(t, v.lookup)); got != v.want {
+			t.Errorf("after %s and %s: Lookup(%s) = %d, want %d", v.first, v.second, v.lookup, got, v.want)
+		}
+	}
+}
+
+func TestHashPrefixesValidate(t *testing.T) {
+	vectors := []struct {
+		phs   []string
+		valid bool
+	}{
+		{[]string{}, true},
+		{[]string{"00000001", "0000000200", "88981e62", "ffffffff"}, true},
+		{[]string{"00000001", "0000000200", "0000000201"}, true},
+		{[]string{"000001"}, false},                 // Too short.
+		{[]string{"0000000200", "00000001"}, false}, // Unsorted.
+		{[]string{"00000001", "00000001"}, false},   // Duplicate.
+		{[]string{"00000001", "0000000100"}, false}, // Overlap with a 4-byte prefix.
+		{[]string{"0000000200", "000000020001"}, false},
+		{[]string{"0000000200", "0000000201", "000000020100"}, false},
+	}
+	for _, v := range vectors {
+		var phs HashPrefixes
+		for _, s := range v.phs {
+			phs = append(phs, mustDecodeHex(t, s))
+		}
+		if err := phs.Validate(); (err == nil) != v.valid {
+			t.Errorf("Validate(%v) = %v, want valid %v", v.phs, err, v.valid)
+		}
+	}
+
+	long := HashPrefixes{HashPrefix(make([]byte, MaxHashPrefixLength+1))}
+	if long.Validate() == nil {
+		t.Error("Validate accepted a prefix longer than a full hash")
+	}
+}
+
+func TestHashPrefixesSHA256(t *testing.T) {
+	phs := HashPrefixes{
+		mustDecodeHex(t, "ffffffff"),
+		mustDecodeHex(t, "0000000200"),
+		mustDecodeHex(t, "00000001"),
+		mustDecodeHex(t, "88981e62"),
+	}
+	phs.Sort()
+	const want = "5f1484da89e5a025ee5dedfc64165f08bdd2e6a25a6b4a6dbd659aef7493a3c8"
+	if got := hex.EncodeToString(phs.SHA256()); got != want {
+		t.Errorf("SHA256() = %s, want %s", got, want)
+	}
+	if got := hex.EncodeToString(NewHashSet(phs).Export().SHA256()); got != want {
+		t.Errorf("Export().SHA256() = %s, want %s", got, want)
+	}
+	if got, want := hex.EncodeToString(HashPrefixes{}.SHA256()), hex.EncodeToString([]byte(HashFromPattern(""))); got != want {
+		t.Errorf("SHA256() of no prefixes = %s, want %s", got, want)
+	}
+}
+
+func TestRiceIntegers(t *testing.T) {
+	// The deltas 2, 5, 0 take 9 bits with k = 1: 100 1101 00.
+	values := []uint32{6, 8, 13, 13}
+	want := &RiceDeltaEncoding{
+		FirstValue:    6,
+		RiceParameter: 1,
+		NumEntries:    3,
+		EncodedData:   []byte{0x59, 0x00},
+	}
+	got, err := EncodeRiceIntegers(values)
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Fatalf("EncodeRiceIntegers(%v) = %+v, %v, want %+v", values, got, err, want)
+	}
+	if dec, err := DecodeRiceIntegers(got); err != nil || !reflect.DeepEqual(dec, values) {
+		t.Errorf("DecodeRiceIntegers = %v, %v, want %v", dec, err, values)
+	}
+
+	// Spread values select a large k; a single value encodes no data.
+	for _, values := range [][]uint32{
+		{0, 1<<32 - 1},
+		{7},
+		{0, 0, 0},
+		{100, 70000, 140000, 2000000000, 2000000001, 4000000000},
+	} {
+		rice, err := EncodeRiceIntegers(values)
+		if err != nil {
+			t.Fatalf("EncodeRiceIntegers(%v): %v", values, err)
+		}
+		if dec, err := DecodeRiceIntegers(rice); err != nil || !reflect.DeepEqual(dec, values) {
+			t.Errorf("round trip of %v = %v, %v (k = %d)", values, dec, err, rice.RiceParameter)
+		}
+	}
+
+	if _, err := EncodeRiceIntegers([]uint32{2, 1}); err == nil {
+		t.Error("EncodeRiceIntegers accepted unsorted values")
+	}
+	if _, err := EncodeRiceIntegers(nil); err == nil {
+		t.Error("EncodeRiceIntegers accepted no values")
+	}
+	for _, rice := range []*RiceDeltaEncoding{
+		nil,
+		{FirstValue: 6, RiceParameter: 33},
+		{FirstValue: -1},
+		{FirstValue: 6, RiceParameter: 1, NumEntries: 3, EncodedData: []byte{0x59}},
+		{FirstValue: 6, RiceParameter: 1, NumEntries: 3, EncodedData: []byte{0x59, 0x00, 0x00}},
+		// The delta 2 (k = 1: 100) wraps 1<<32 - 1 around.
+		{FirstValue: 1<<32 - 1, RiceParameter: 1, NumEntries: 1, EncodedData: []byte{0x01}},
+		// With k = 32, a quotient of 1 (10) is a delta of 1<<32.
+		{FirstValue: 0, RiceParameter: 32, NumEntries: 1, EncodedData: []byte{0x01, 0, 0, 0, 0}},
+	} {
+		if _, err := DecodeRiceIntegers(rice); err == nil {
+			t.Errorf("DecodeRiceIntegers(%+v) unexpectedly succeeded", rice)
+		}
+	}
+}
+
+func TestRicePrefixes(t *testing.T) {
+	phs := HashPrefixes{
+		HashFromPattern("google.com/").Short(),
+		HashFromPattern("example.com/").Short(),
+		HashFromPattern("google.com/").Short(),
+		HashPrefixFromUint32LE(0),
+		HashPrefixFromUint32LE(1<<32 - 1),
+	}
+	rice, err := EncodeRicePrefixes(phs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rice.NumEntries != 3 {
+		t.Errorf("NumEntries = %d, want 3 deltas between 4 unique prefixes", rice.NumEntries)
+	}
+	got, err := DecodeRicePrefixes(rice)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := HashPrefixes{phs[3], HashFromPattern("google.com/").Short(), HashFromPattern("example.com/").Short(), phs[4]}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DecodeRicePrefixes = %x, want %x", got, want)
+	}
+
+	if _, err := EncodeRicePrefixes(HashPrefixes{HashFromPattern("google.com/")}); err == nil {
+		t.Error("EncodeRicePrefixes accepted a full hash")
+	}
+}
@@ -0,0 +1,51 @@
+package focal
+
+import (
+	"strings"
+
+	"../publicsuffix"
+)
+
+// Pattern is a pattern of a URL, with the level of its host in the domain
+// hierarchy.
+//
+// Safe Browsing looks a URL up under its hostname and up to 4 host suffixes
+// formed from the last 5 host components, only leaving out the last component
+// alone, without knowing where the registrable domain is. So the patterns of a
+// phishing page on a.example.000webhostapp.com include 000webhostapp.com/, and
+// the ones of a Google Sites page include google.com/. Blacklist builders and analyses use
+// the levels to exclude or weight such patterns.
+type Pattern struct {
+	Pattern string
+	Level   publicsuffix.Level
+}
+
+// Broad reports whether the host of p is at or above its registrable domain,
+// i.e. p matches a whole domain or sites of other owners.
+func (p Pattern) Broad() bool {
+	return p.Level >= publicsuffix.Registrable
+}
+
+// PatternLevel returns the level in list of the host of a pattern
+// (hostname/path).
+func PatternLevel(pattern string, list *publicsuffix.List) publicsuffix.Level {
+	host := pattern
+	if i := strings.Index(pattern, "/"); i >= 0 {
+		host = pattern[:i]
+	}
+	return list.Level(host)
+}
+
+// GeneratePSLPatterns returns the patterns of GeneratePatterns with the level
+// of their host in list, such as publicsuffix.Default.
+func GeneratePSLPatterns(url string, list *publicsuffix.List) ([]Pattern, error) {
+	patterns, err := GeneratePatterns(url)
+	if err != nil {
+		return nil, err
+	}
+	marked := make([]Pattern, len(patterns))
+	for i, p := range patterns {
+		marked[i] = Pattern{p, PatternLevel(p, list)}
+	}
+	return marked, nil
+}
@@ -0,0 +1,66 @@
+package focal
+
+import (
+	"reflect"
+	"testing"
+
+	"../publicsuffix"
+)
+
+func TestGeneratePSLPatterns(t *testing.T) {
+	const (
+		sub  = publicsuffix.Subdomain
+		reg  = publicsuffix.Registrable
+		shar = publicsuffix.SharedSuffix
+		pub  = publicsuffix.PublicSuffix
+	)
+	vectors := []struct {
+		url  string
+		want []Pattern
+	}{{
+		url: "http://a.example.000webhostapp.com/login.php",
+		want: []Pattern{
+			{"a.example.000webhostapp.com/", sub},
+			{"a.example.000webhostapp.com/login.php", sub},
+			{"example.000webhostapp.com/", reg},
+			{"example.000webhostapp.com/login.php", reg},
+			{"000webhostapp.com/", shar},
+			{"000webhostapp.com/login.php", shar},
+		},
+	}, {
+		url: "https://sites.google.com/view/x",
+		want: []Pattern{
+			{"sites.google.com/", sub},
+			{"sites.google.com/view/", sub},
+			{"sites.google.com/view/x", sub},
+			{"google.com/", reg},
+			{"google.com/view/", reg},
+			{"google.com/view/x", reg},
+		},
+	}, {
+		url: "http://www.example.com.br/",
+		want: []Pattern{
+			{"www.example.com.br/", sub},
+			{"example.com.br/", reg},
+			{"com.br/", pub},
+		},
+	}, {
+		url:  "http://10.1.2.3/",
+		want: []Pattern{{"10.1.2.3/", sub}},
+	}}
+	for _, v := range vectors {
+		got, err := GeneratePSLPatterns(v.url, publicsuffix.Default)
+		if err != nil {
+			t.Errorf("GeneratePSLPatterns(%q): unexpected error %v", v.url, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, v.want) {
+			t.Errorf("GeneratePSLPatterns(%q):\ngot  %v\nwant %v", v.url, got, v.want)
+		}
+		for _, p := range got {
+			if p.Broad() != (p.Level != sub) {
+				t.Errorf("%v.Broad() = %v", p, p.Broad())
+			}
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package focal
+
+import (
+	"errors"
+
+	"../golang.org/x/net/idna"
+)
+
+// Reason is why a URL is rejected, i.e. why it cannot be canonicalized nor
+// decomposed.
+type Reason int
+
+const (
+	// ReasonOther is any other error.
+	ReasonOther Reason = iota
+	// ReasonInvalidPath is a URL with a scheme but no "//" after it.
+	ReasonInvalidPath
+	// ReasonMissingHostname is a URL without a hostname, or with one of
+	// dots or of a port alone.
+	ReasonMissingHostname
+	// ReasonTooRecursive is a URL with too many nested percent escapes.
+	ReasonTooRecursive
+	// ReasonInvalidIPv6 is a bracketed hostname that is not an IPv6 address.
+	ReasonInvalidIPv6
+	// ReasonInvalidPort is a hostname with a ':' that starts no port.
+	ReasonInvalidPort
+	// ReasonIDNA is an internationalized hostname that fails IDNA processing.
+	ReasonIDNA
+)
+
+var reasonNames = []string{"other", "invalid-path", "missing-hostname", "too-recursive", "invalid-ipv6", "invalid-port", "idna"}
+
+func (r Reason) String() string {
+	if r < 0 || int(r) >= len(reasonNames) {
+		return reasonNames[ReasonOther]
+	}
+	return reasonNames[r]
+}
+
+// MarshalText encodes r as its name, e.g. in JSON reports.
+func (r Reason) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// Reasons returns the reasons, in order.
+func Reasons() []Reason {
+	rs := make([]Reason, len(reasonNames))
+	for i := range rs {
+		rs[i] = Reason(i)
+	}
+	return rs
+}
+
+// RejectReason returns the reason of an error of ParseURL, CanonicalURL or
+// GeneratePatterns.
+func RejectReason(err error) Reason {
+	var le idna.LabelError
+	var re idna.RuneError
+	switch {
+	case errors.Is(err, ErrInvalidPath):
+		return ReasonInvalidPath
+	case errors.Is(err, ErrMissingHostname):
+		return ReasonMissingHostname
+	case errors.Is(err, ErrTooRecursive):
+		return ReasonTooRecursive
+	case errors.Is(err, ErrInvalidIPv6):
+		return ReasonInvalidIPv6
+	case errors.Is(err, ErrInvalidPort):
+		return ReasonInvalidPort
+	case errors.As(err, &le), errors.As(err, &re):
+		return ReasonIDNA
+	}
+	return ReasonOther
+}
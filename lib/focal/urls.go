@@ -1,5 +1,3 @@
-package main
-
 // Copyright 2016 Google Inc. All Rights Reserved.
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
@@ -14,6 +12,11 @@ package main
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+// Package focal implements the Safe Browsing URL canonicalization, URL
+// decomposition and hashing that FOCAL blacklists, analyses and clients
+// share.
+package focal
+
 // The logic below deals with extracting patterns from a URL.
 // Patterns are all the possible host-suffix and path-prefix fragments for
 // the input URL.
@@ -43,22 +46,51 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/netip"
 	"net/url"
 	"path"
 	"regexp"
 	"strconv"
 	"strings"
 
-	"./lib/golang.org/x/net/idna"
+	"../golang.org/x/net/idna"
+)
+
+// The errors ParseURL, and so the canonicalization and decomposition of URLs,
+// reports; hostnames that fail IDNA processing report an idna.LabelError or
+// idna.RuneError instead. See RejectReason.
+var (
+	ErrInvalidPath     = errors.New("safebrowsing: invalid path")
+	ErrMissingHostname = errors.New("safebrowsing: missing hostname")
+	ErrTooRecursive    = errors.New("safebrowsing: unescaping is too recursive")
+	ErrInvalidIPv6     = errors.New("safebrowsing: invalid IPv6 address in host")
+	ErrInvalidPort     = errors.New("safebrowsing: invalid port in host")
 )
 
 var (
 	dotsRegexp          = regexp.MustCompile("[.]+")
-	portRegexp          = regexp.MustCompile(`:\d+$`)
+	portRegexp          = regexp.MustCompile(`:\d*$`)
 	possibleIPRegexp    = regexp.MustCompile(`^(?i)((?:0x[0-9a-f]+|[0-9\.])+)$`)
 	trailingSpaceRegexp = regexp.MustCompile(`^(\d{1,3}\.\d{1,3}\.\d{1,3}\.\d{1,3}) `)
 )
 
+// IDNAProfile converts internationalized hostnames to A-labels. It does the
+// UTS #46 mapping and validation browsers do for lookups: non-transitional
+// processing (ß stays ß), the bidi and joiner rules, but neither STD3 rules
+// nor hyphen checks. Hostnames it rejects are invalid URLs and the error is
+// an idna.LabelError or idna.RuneError.
+//
+// Set it to a profile with idna.Transitional(true) to get the A-labels of
+// the IDNA2003 era browsers.
+var IDNAProfile = idna.New(
+	idna.MapForLookup(),
+	idna.BidiRule(),
+	idna.Transitional(false),
+	idna.StrictDomainName(false),
+	idna.CheckHyphens(false),
+	idna.CheckJoiners(true),
+)
+
 // ValidURL parses the given string and returns true if it is a Safe Browsing
 // compatible URL.
 //
@@ -67,32 +99,32 @@ var (
 // URLs, as the first parse failure will cause LookupURLs to stop processing
 // the request and return an error.
 func ValidURL(url string) bool {
-	parsed, err := parseURL(url)
+	parsed, err := ParseURL(url)
 	return parsed != nil && err == nil
 }
 
-// generateHashes returns a set of full hashes for all patterns in the URL.
-func generateHashes(url string) (map[hashPrefix]string, error) {
-	patterns, err := generatePatterns(url)
+// GenerateHashes returns a set of full hashes for all patterns in the URL.
+func GenerateHashes(url string) (map[HashPrefix]string, error) {
+	patterns, err := GeneratePatterns(url)
 	if err != nil {
 		return nil, err
 	}
 
-	hashes := make(map[hashPrefix]string)
+	hashes := make(map[HashPrefix]string)
 	for _, p := range patterns {
-		hashes[hashFromPattern(p)] = p
+		hashes[HashFromPattern(p)] = p
 	}
 	return hashes, nil
 }
 
-// generatePatterns returns all possible host-suffix and path-prefix patterns
+// GeneratePatterns returns all possible host-suffix and path-prefix patterns
 // for the input URL.
-func generatePatterns(url string) ([]string, error) {
-	hosts, err := generateLookupHosts(url)
+func GeneratePatterns(url string) ([]string, error) {
+	hosts, err := GenerateLookupHosts(url)
 	if err != nil {
 		return nil, err
 	}
-	paths, err := generateLookupPaths(url)
+	paths, err := GenerateLookupPaths(url)
 	if err != nil {
 		return nil, err
 	}
@@ -175,7 +207,11 @@ func escape(s string) string {
 
 // unescape returns the decoded form of a percent-encoded string s.
 func unescape(s string) string {
+	if strings.IndexByte(s, '%') < 0 {
+		return s
+	}
 	var b bytes.Buffer
+	b.Grow(len(s))
 	for len(s) > 0 {
 		if len(s) >= 3 && s[0] == '%' && isHex(s[1]) && isHex(s[2]) {
 			b.WriteByte(unhex(s[1])<<4 | unhex(s[2]))
@@ -191,16 +227,36 @@ func unescape(s string) string {
 // recursiveUnescape unescapes the string s recursively until it cannot be
 // unescaped anymore. It reports an error if the unescaping process seemed to
 // have no end.
+//
+// Rather than unescaping s over and over, it unescapes in a single pass: a
+// decoded byte may complete an escape with the bytes before it, which is then
+// decoded in turn. Each byte keeps the number of unescapings it took, so that
+// inputs such as "%252525...25" fail after maxDepth of them in linear time.
 func recursiveUnescape(s string) (string, error) {
 	const maxDepth = 1024
-	for i := 0; i < maxDepth; i++ {
-		t := unescape(s)
-		if t == s {
-			return s, nil
+	if strings.IndexByte(s, '%') < 0 {
+		return s, nil
+	}
+	b := make([]byte, 0, len(s))
+	depth := make([]uint16, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		b, depth = append(b, s[i]), append(depth, 0)
+		for n := len(b); n >= 3 && b[n-3] == '%' && isHex(b[n-2]) && isHex(b[n-1]); n = len(b) {
+			d := depth[n-3]
+			if depth[n-2] > d {
+				d = depth[n-2]
+			}
+			if depth[n-1] > d {
+				d = depth[n-1]
+			}
+			if d+1 >= maxDepth {
+				return "", ErrTooRecursive
+			}
+			b[n-3], depth[n-3] = unhex(b[n-2])<<4|unhex(b[n-1]), d+1
+			b, depth = b[:n-2], depth[:n-2]
 		}
-		s = t
 	}
-	return "", errors.New("safebrowsing: unescaping is too recursive")
+	return string(b), nil
 }
 
 // normalizeEscape performs a recursive unescape and then escapes the string
@@ -248,19 +304,33 @@ func parseHost(hostish string) (host string, err error) {
 		// For example: "[fe80::1] or "[fe80::1%25en0]"
 		i := strings.LastIndex(host, "]")
 		if i < 0 {
-			return "", errors.New("safebrowsing: missing ']' in host")
+			return "", ErrInvalidIPv6
+		}
+		if port := host[i+1:]; port != "" && !portRegexp.MatchString(port) {
+			return "", ErrInvalidIPv6
+		}
+		iphost := parseIPv6Address(unescape(host[1:i]))
+		if iphost == "" {
+			return "", ErrInvalidIPv6
 		}
+		return "[" + iphost + "]", nil
 	}
-	// Remove the port if it is there.
+	// Remove the port if it is there. Any other ':' would make the
+	// canonical URL read as a scheme.
 	host = portRegexp.ReplaceAllString(host, "")
+	if strings.Contains(unescape(host), ":") {
+		return "", ErrInvalidPort
+	}
 
 	// Convert internationalized hostnames to IDNA.
 	u := unescape(host)
 	if isUnicode(u) {
-		host, err = idna.ToASCII(u)
+		host, err = IDNAProfile.ToASCII(u)
 		if err != nil {
 			return "", err
 		}
+		// Escape the result like the rest of the URL, e.g. a '%' it keeps.
+		host = escape(host)
 	}
 
 	// Remove any superfluous '.' characters in the hostname.
@@ -275,8 +345,8 @@ func parseHost(hostish string) (host string, err error) {
 	return host, nil
 }
 
-// parseURL parses urlStr as a url.URL and reports an error if not possible.
-func parseURL(urlStr string) (parsedURL *url.URL, err error) {
+// ParseURL parses urlStr as a url.URL and reports an error if not possible.
+func ParseURL(urlStr string) (parsedURL *url.URL, err error) {
 	// For legacy reasons, this is a simplified version of the net/url logic.
 	//
 	// Few cases where net/url was not helpful:
@@ -304,12 +374,13 @@ func parseURL(urlStr string) (parsedURL *url.URL, err error) {
 		return nil, err
 	}
 	parsedURL.Scheme, rest = getScheme(rest)
+	parsedURL.ForceQuery = strings.Contains(rest, "?")
 	rest, parsedURL.RawQuery = split(rest, "?", true)
 
 	// Add HTTP as scheme if none.
 	var hostish string
 	if !strings.HasPrefix(rest, "//") && parsedURL.Scheme != "" {
-		return nil, errors.New("safebrowsing: invalid path")
+		return nil, ErrInvalidPath
 	}
 	if parsedURL.Scheme == "" {
 		parsedURL.Scheme = "http"
@@ -318,18 +389,22 @@ func parseURL(urlStr string) (parsedURL *url.URL, err error) {
 		hostish, rest = split(rest[2:], "/", false)
 	}
 	if hostish == "" {
-		return nil, errors.New("safebrowsing: missing hostname")
+		return nil, ErrMissingHostname
 	}
 
 	parsedURL.Host, err = parseHost(hostish)
 	if err != nil {
 		return nil, err
 	}
+	// A hostname of dots or of a port alone has nothing left.
+	if parsedURL.Host == "" {
+		return nil, ErrMissingHostname
+	}
 	// Format the path.
 	p := path.Clean(rest)
 	if p == "." {
 		p = "/"
-	} else if rest[len(rest)-1] == '/' && p[len(p)-1] != '/' {
+	} else if strings.HasSuffix(rest, "/") && !strings.HasSuffix(p, "/") {
 		p += "/"
 	}
 	parsedURL.Path = p
@@ -370,6 +445,40 @@ func parseIPAddress(iphostname string) string {
 	return strings.Join(ss, ".")
 }
 
+// parseIPv6Address returns the RFC 5952 text representation of an IPv6
+// address, without its zone, or "" if s is not one. The zone of a link-local
+// address only makes sense on the host that wrote the URL, so it is dropped.
+//
+// For example:
+//	"FEDC:0:0:0:0:0:0:1"  =>  "fedc::1"
+//	"fe80::1%en0"         =>  "fe80::1"
+//	"::FFFF:1.2.3.4"      =>  "::ffff:1.2.3.4"
+//	"::192.9.5.5"         =>  "::192.9.5.5"
+func parseIPv6Address(s string) string {
+	if i := strings.IndexByte(s, '%'); i >= 0 {
+		s = s[:i]
+	}
+	ip, err := netip.ParseAddr(s)
+	if err != nil || !ip.Is6() {
+		return ""
+	}
+	// Like inet_ntop, keep the IPv4 notation of the deprecated
+	// IPv4-compatible addresses. netip only keeps it for IPv4-mapped ones.
+	if b := ip.As16(); allZero(b[:12]) && (b[12] != 0 || b[13] != 0) {
+		return "::" + netip.AddrFrom4([4]byte(b[12:])).String()
+	}
+	return ip.String()
+}
+
+func allZero(b []byte) bool {
+	for _, c := range b {
+		if c != 0 {
+			return false
+		}
+	}
+	return true
+}
+
 // canonicalNum parses s as an integer and attempts to encode it as a '.'
 // separated string where each element is the base-10 encoded value of each byte
 // for the corresponding number, starting with the MSB. The result is one that
@@ -394,25 +503,52 @@ func canonicalNum(s string, n int) string {
 	return strings.Join(ss, ".")
 }
 
-// canonicalURL parses a URL string and returns it as scheme://hostname/path.
-// It strips off fragments and queries.
-func canonicalURL(u string) (string, error) {
-	parsedURL, err := parseURL(u)
+// Options controls the canonicalization performed by CanonicalURL.
+//
+// The zero value canonicalizes the way FOCAL blacklists are built, i.e.,
+// without the scheme.
+type Options struct {
+	// KeepScheme makes CanonicalURL return scheme://hostname/path, as the
+	// upstream Safe Browsing client does, instead of hostname/path.
+	KeepScheme bool
+	// KeepQuery makes CanonicalURL keep the query, "?" included even if
+	// empty, as the converters of testData/scripts-converting do for the
+	// release-json blacklists.
+	KeepQuery bool
+}
+
+// CanonicalURL parses a URL string and returns it as hostname/path, the form
+// FOCAL blacklists are built from. It strips off fragments and queries.
+func CanonicalURL(u string) (string, error) {
+	return Options{}.CanonicalURL(u)
+}
+
+// CanonicalURL parses a URL string and returns it as hostname/path, or as
+// scheme://hostname/path if o.KeepScheme is set. It strips off fragments, and
+// queries unless o.KeepQuery is set.
+func (o Options) CanonicalURL(u string) (string, error) {
+	parsedURL, err := ParseURL(u)
 	if err != nil {
 		return "", err
 	}
 	// Assemble the URL ourselves to skip encodings from the net/url package.
-	// u = parsedURL.Scheme + "://" + parsedURL.Host
 	u = parsedURL.Host
+	if o.KeepScheme {
+		u = parsedURL.Scheme + "://" + u
+	}
 	if parsedURL.Path == "" {
-		return u + "/", nil
+		u += "/"
+	} else {
+		u += parsedURL.Path
+	}
+	if o.KeepQuery && parsedURL.ForceQuery {
+		u += "?" + parsedURL.RawQuery
 	}
-	u += parsedURL.Path
 	return u, nil
 }
 
 func canonicalHost(urlStr string) (string, error) {
-	parsedURL, err := parseURL(urlStr)
+	parsedURL, err := ParseURL(urlStr)
 	if err != nil {
 		return "", err
 	}
@@ -420,8 +556,8 @@ func canonicalHost(urlStr string) (string, error) {
 	return parsedURL.Host, nil
 }
 
-// generateLookupHosts returns a list of host-suffixes for the input URL.
-func generateLookupHosts(urlStr string) ([]string, error) {
+// GenerateLookupHosts returns a list of host-suffixes for the input URL.
+func GenerateLookupHosts(urlStr string) ([]string, error) {
 	// Safe Browsing policy asks to generate lookup hosts for the URL.
 	// Those are formed by the domain and also up to 4 hostnames suffixes.
 	// The last component or sometimes the pair isn't examined alone,
@@ -432,7 +568,10 @@ func generateLookupHosts(urlStr string) ([]string, error) {
 	// We just check a few extra components regardless. It's not significantly
 	// slower on the server side to check some extra hashes. Also the client
 	// does not need to keep a database of TLDs.
-	const maxHostComponents = 7
+	//
+	// The suffixes are formed from the last 5 components, which makes at most
+	// 5 hosts, and with the paths at most 30 patterns.
+	const maxHostComponents = 5
 
 	host, err := canonicalHost(urlStr)
 	if err != nil {
@@ -460,18 +599,18 @@ func generateLookupHosts(urlStr string) ([]string, error) {
 func canonicalPath(urlStr string) (string, error) {
 	// Note that this function is not used, but remains to ensure that the
 	// parsedURL.Path output matches C++ implementation.
-	parsedURL, err := parseURL(urlStr)
+	parsedURL, err := ParseURL(urlStr)
 	if err != nil {
 		return "", err
 	}
 	return parsedURL.Path, nil
 }
 
-// generateLookupPaths returns a list path-prefixes for the input URL.
-func generateLookupPaths(urlStr string) ([]string, error) {
+// GenerateLookupPaths returns a list path-prefixes for the input URL.
+func GenerateLookupPaths(urlStr string) ([]string, error) {
 	const maxPathComponents = 4
 
-	parsedURL, err := parseURL(urlStr)
+	parsedURL, err := ParseURL(urlStr)
 	if err != nil {
 		return nil, err
 	}
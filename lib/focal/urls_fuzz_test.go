@@ -0,0 +1,167 @@
+package focal
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+)
+
+// The fuzz targets are seeded with the conformance corpus and a sample of the
+// released blacklists. Run them with, e.g.:
+//
+//	go test -run '^$' -fuzz FuzzGeneratePatterns -fuzztime 1m
+const (
+	corpusPath = "../../testData/conformance/corpus.txt"
+	releaseDir = "../../testData/release-json"
+
+	// releaseSample keeps one URL of every releaseSample URLs of a release.
+	releaseSample = 500
+
+	// maxPatterns is the number of patterns Safe Browsing caps a URL at: the
+	// hostname and up to 4 suffixes, times the path and up to 5 prefixes.
+	maxPatterns = 30
+)
+
+// addSeeds adds the URLs of the conformance corpus and of the released
+// blacklists to the seed corpus of f.
+func addSeeds(f *testing.F) {
+	f.Helper()
+	file, err := os.Open(corpusPath)
+	if err != nil {
+		f.Fatal(err)
+	}
+	defer file.Close()
+	s := bufio.NewScanner(file)
+	for s.Scan() {
+		if line := s.Text(); line != "" {
+			f.Add(line)
+		}
+	}
+	if err := s.Err(); err != nil {
+		f.Fatal(err)
+	}
+
+	files, err := ioutil.ReadDir(releaseDir)
+	if err != nil {
+		f.Fatal(err)
+	}
+	for _, fi := range files {
+		if !strings.HasSuffix(fi.Name(), ".json") {
+			continue
+		}
+		data, err := ioutil.ReadFile(releaseDir + "/" + fi.Name())
+		if err != nil {
+			f.Fatal(err)
+		}
+		var entries []struct {
+			URL string `json:"u"`
+		}
+		if err := json.Unmarshal(data, &entries); err != nil {
+			f.Fatalf("%s: %v", fi.Name(), err)
+		}
+		for i := 0; i < len(entries); i += releaseSample {
+			f.Add(entries[i].URL)
+		}
+	}
+}
+
+func FuzzCanonicalURL(f *testing.F) {
+	addSeeds(f)
+	f.Fuzz(func(t *testing.T, u string) {
+		c, err := CanonicalURL(u)
+		if err != nil {
+			return
+		}
+		cc, err := CanonicalURL(c)
+		if err != nil {
+			t.Fatalf("CanonicalURL(%q) = %q, which fails to canonicalize: %v", u, c, err)
+		}
+		if cc != c {
+			t.Fatalf("CanonicalURL(%q) = %q, but CanonicalURL(%q) = %q", u, c, c, cc)
+		}
+	})
+}
+
+func FuzzGeneratePatterns(f *testing.F) {
+	addSeeds(f)
+	f.Fuzz(func(t *testing.T, u string) {
+		patterns, err := GeneratePatterns(u)
+		parsedURL, perr := ParseURL(u)
+		if (err != nil) != (perr != nil) {
+			t.Fatalf("GeneratePatterns(%q) error %v, ParseURL error %v", u, err, perr)
+		}
+		if err != nil {
+			return
+		}
+		if len(patterns) == 0 || len(patterns) > maxPatterns {
+			t.Fatalf("GeneratePatterns(%q) returned %d patterns, want 1 to %d", u, len(patterns), maxPatterns)
+		}
+		host, path := parsedURL.Host, parsedURL.Path
+		seen := make(map[string]bool)
+		for _, p := range patterns {
+			if seen[p] {
+				t.Errorf("GeneratePatterns(%q): duplicate pattern %q", u, p)
+			}
+			seen[p] = true
+
+			i := strings.Index(p, "/")
+			if i < 0 {
+				t.Errorf("GeneratePatterns(%q): pattern %q has no path", u, p)
+				continue
+			}
+			ph, pp := p[:i], p[i:]
+			if ph != host && !strings.HasSuffix(host, "."+ph) {
+				t.Errorf("GeneratePatterns(%q): host of pattern %q is not a suffix of %q", u, p, host)
+			}
+			switch {
+			case pp == path:
+			case parsedURL.RawQuery != "" && pp == path+"?"+parsedURL.RawQuery:
+			case strings.HasSuffix(pp, "/") && strings.HasPrefix(path, pp):
+			default:
+				t.Errorf("GeneratePatterns(%q): path of pattern %q is not a prefix of %q", u, p, path)
+			}
+		}
+	})
+}
+
+// passUnescape is recursiveUnescape the way upstream implements it, by
+// unescaping s until it does not change.
+func passUnescape(s string) (string, error) {
+	for i := 0; i < 1024; i++ {
+		t := unescape(s)
+		if t == s {
+			return s, nil
+		}
+		s = t
+	}
+	return "", errors.New("safebrowsing: unescaping is too recursive")
+}
+
+func FuzzRecursiveUnescape(f *testing.F) {
+	for _, s := range []string{"%", "%25", "%2541", "%%32%35", "%%4141", "%4%41", "%2%35", "%252525252525", "%%%%%", "%zz%4"} {
+		f.Add(s)
+	}
+	addSeeds(f)
+	f.Fuzz(func(t *testing.T, s string) {
+		got, err := recursiveUnescape(s)
+		want, werr := passUnescape(s)
+		if got != want || (err != nil) != (werr != nil) {
+			t.Fatalf("recursiveUnescape(%q) = %q, %v, want %q, %v", s, got, err, want, werr)
+		}
+	})
+}
+
+func TestRecursiveUnescapeDepth(t *testing.T) {
+	for _, n := range []int{1, 1022, 1023, 1024, 1025, 50000} {
+		s := "%" + strings.Repeat("25", n)
+		got, err := recursiveUnescape(s)
+		want, werr := passUnescape(s)
+		if got != want || (err != nil) != (werr != nil) {
+			t.Errorf("%d nested escapes: recursiveUnescape = %q, %v, want %q, %v", n, got, err, want, werr)
+		}
+	}
+}
@@ -12,13 +12,16 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package main
+package focal
 
 import (
+	"errors"
 	"reflect"
 	"sort"
 	"strings"
 	"testing"
+
+	"../golang.org/x/net/idna"
 )
 
 func TestGeneratePatterns(t *testing.T) {
@@ -34,7 +37,7 @@ func TestGeneratePatterns(t *testing.T) {
 		output: []string{"a.b.c/1/2/3/4/5/", "a.b.c/1/2/3/", "a.b.c/1/2/", "a.b.c/1/", "a.b.c/", "b.c/1/2/3/4/5/", "b.c/1/2/3/", "b.c/1/2/", "b.c/1/", "b.c/"},
 	}, {
 		url:    "http://a.b.c.d.e.f.g.h.i/",
-		output: []string{"a.b.c.d.e.f.g.h.i/", "c.d.e.f.g.h.i/", "d.e.f.g.h.i/", "e.f.g.h.i/", "f.g.h.i/", "g.h.i/", "h.i/"},
+		output: []string{"a.b.c.d.e.f.g.h.i/", "e.f.g.h.i/", "f.g.h.i/", "g.h.i/", "h.i/"},
 	}, {
 		url:    "http://a.b.c.d.e/1.html",
 		output: []string{"a.b.c.d.e/1.html", "a.b.c.d.e/", "b.c.d.e/1.html", "b.c.d.e/", "c.d.e/1.html", "c.d.e/", "d.e/1.html", "d.e/"},
@@ -58,10 +61,10 @@ func TestGeneratePatterns(t *testing.T) {
 		output: []string{"b/"},
 	}, {
 		url:    "https://a.b.c.d.e.f.g.h.i/",
-		output: []string{"a.b.c.d.e.f.g.h.i/", "c.d.e.f.g.h.i/", "d.e.f.g.h.i/", "e.f.g.h.i/", "f.g.h.i/", "g.h.i/", "h.i/"},
+		output: []string{"a.b.c.d.e.f.g.h.i/", "e.f.g.h.i/", "f.g.h.i/", "g.h.i/", "h.i/"},
 	}, {
 		url:    "a.b.c.d.e.f.g.h.i/",
-		output: []string{"a.b.c.d.e.f.g.h.i/", "c.d.e.f.g.h.i/", "d.e.f.g.h.i/", "e.f.g.h.i/", "f.g.h.i/", "g.h.i/", "h.i/"},
+		output: []string{"a.b.c.d.e.f.g.h.i/", "e.f.g.h.i/", "f.g.h.i/", "g.h.i/", "h.i/"},
 	}, {
 		url:    "[2001:470:1:18::114]/a/b",
 		output: []string{"[2001:470:1:18::114]/a/b", "[2001:470:1:18::114]/a/", "[2001:470:1:18::114]/"},
@@ -71,7 +74,7 @@ func TestGeneratePatterns(t *testing.T) {
 	}}
 
 	for i, v := range vectors {
-		patterns, err := generatePatterns(v.url)
+		patterns, err := GeneratePatterns(v.url)
 		if err != nil != v.fail {
 			if err != nil {
 				t.Errorf("test %d, unexpected error: %v", i, err)
@@ -83,7 +86,7 @@ func TestGeneratePatterns(t *testing.T) {
 		sort.Strings(patterns)
 		sort.Strings(v.output)
 		if !reflect.DeepEqual(patterns, v.output) {
-			t.Errorf("test %d, generatePatterns(%q):\ngot  %q\nwant %q", i, v.url, patterns, v.output)
+			t.Errorf("test %d, GeneratePatterns(%q):\ngot  %q\nwant %q", i, v.url, patterns, v.output)
 		}
 	}
 }
@@ -127,9 +130,34 @@ func TestCanonicalHost(t *testing.T) {
 		{"http://[FEDC:BA98:7654:3210:FEDC:BA98:7654:3210]:80/index.html",
 			strings.ToLower("[FEDC:BA98:7654:3210:FEDC:BA98:7654:3210]"), false},
 		{"http://[::192.9.5.5]/ipng", "[::192.9.5.5]", false},
+		{"http://[FEDC:0:0:0:0:0:0:1]/", "[fedc::1]", false},
+		{"http://[2001:db8:0:0:1:0:0:1]/", "[2001:db8::1:0:0:1]", false},
+		{"http://[2001:0db8::0001]:8080/", "[2001:db8::1]", false},
+		{"http://[2001:db8:0:1:1:1:1:1]/", "[2001:db8:0:1:1:1:1:1]", false},
+		{"http://[::FFFF:1.2.3.4]/", "[::ffff:1.2.3.4]", false},
+		{"http://[::ffff:102:304]/", "[::ffff:1.2.3.4]", false},
+		{"http://[0:0:0:0:0:0:0:1]/", "[::1]", false},
+		{"http://[fe80::1%25en0]/", "[fe80::1]", false},
+		{"http://[fe80::1%en0]:80/", "[fe80::1]", false},
+		{"http://user:pass@[%3A%3A1]/", "[::1]", false},
+		{"http://[1.2.3.4]/", "", true},
+		{"http://[::1]x/", "", true},
+		{"http://[::1/", "", true},
+		{"http://[example.com]/", "", true},
 		{"http://0x12.0x43.0x44.0x01", "18.67.68.1", false},
 		{"http://192.168.0.1:80/index.html", "192.168.0.1", false},
 		{"/asdf", "", true},
+
+		// Internationalized hostnames are converted as browsers do.
+		{"http://www\uff0egoogle\u3002com/", "www.google.com", false}, // Full-width dots.
+		{"http://\uff47\uff4f\uff4f\uff47\uff4c\uff45.com/", "google.com", false},
+		{"http://www.\u00dcMLAT.com/", "www.xn--mlat-zra.com", false},
+		{"http://www.%C3%BCmlat.com/", "www.xn--mlat-zra.com", false},
+		{"http://fa\u00df.de/", "xn--fa-hia.de", false},
+		{"http://\u0440\u0443\u0441.\u0440\u0444/", "xn--p1acf.xn--p1ai", false},
+		{"http://a\u0378b.com/", "", true}, // Unassigned code point.
+		{"http://\u05d0a.com/", "", true},  // Bidi rule.
+		{"http://a\u200db.com/", "", true}, // ZWJ without a virama.
 	}
 
 	for i, v := range vectors {
@@ -148,6 +176,23 @@ func TestCanonicalHost(t *testing.T) {
 	}
 }
 
+func TestIDNAProfile(t *testing.T) {
+	for _, host := range []string{"a\u0378b.com", "\u05d0a.com", "a\u200db.com"} {
+		_, err := parseHost(host)
+		var le idna.LabelError
+		var re idna.RuneError
+		if !errors.As(err, &le) && !errors.As(err, &re) {
+			t.Errorf("parseHost(%+q): error %v is neither an idna.LabelError nor an idna.RuneError", host, err)
+		}
+	}
+
+	defer func(p *idna.Profile) { IDNAProfile = p }(IDNAProfile)
+	IDNAProfile = idna.New(idna.MapForLookup(), idna.BidiRule(), idna.Transitional(true))
+	if host, err := parseHost("fa\u00df.de"); err != nil || host != "fass.de" {
+		t.Errorf("transitional parseHost(%+q) = %q, %v, want %q", "fa\u00df.de", host, err, "fass.de")
+	}
+}
+
 func TestGenerateLookupHosts(t *testing.T) {
 	vectors := []struct {
 		url    string
@@ -161,17 +206,23 @@ func TestGenerateLookupHosts(t *testing.T) {
 		output: []string{"a.b.c.com", "b.c.com", "c.com"},
 	}, {
 		url:    "http://a.b.c.d.e.f.kita.tokyo.jp",
-		output: []string{"a.b.c.d.e.f.kita.tokyo.jp", "c.d.e.f.kita.tokyo.jp", "d.e.f.kita.tokyo.jp", "e.f.kita.tokyo.jp", "f.kita.tokyo.jp", "kita.tokyo.jp", "tokyo.jp"},
+		output: []string{"a.b.c.d.e.f.kita.tokyo.jp", "e.f.kita.tokyo.jp", "f.kita.tokyo.jp", "kita.tokyo.jp", "tokyo.jp"},
 	}, {
 		url:    "http://[::192.9.5.5]/ipng",
 		output: []string{"[::192.9.5.5]"},
+	}, {
+		url:    "http://[2001:DB8:0:0:0:0:0:1%25eth0]:443/a/b",
+		output: []string{"[2001:db8::1]"},
+	}, {
+		url:    "http://[::FFFF:1.2.3.4]/",
+		output: []string{"[::ffff:1.2.3.4]"},
 	}, {
 		url:  "/asdf",
 		fail: true,
 	}}
 
 	for i, v := range vectors {
-		hosts, err := generateLookupHosts(v.url)
+		hosts, err := GenerateLookupHosts(v.url)
 		if err != nil != v.fail {
 			if err != nil {
 				t.Errorf("test %d, unexpected error: %v", i, err)
@@ -181,7 +232,7 @@ func TestGenerateLookupHosts(t *testing.T) {
 			continue
 		}
 		if !reflect.DeepEqual(hosts, v.output) {
-			t.Errorf("test %d, generateLookupHosts(%q):\ngot  %q\nwant %q", i, v.url, hosts, v.output)
+			t.Errorf("test %d, GenerateLookupHosts(%q):\ngot  %q\nwant %q", i, v.url, hosts, v.output)
 		}
 	}
 }
@@ -236,7 +287,7 @@ func TestGenerateLookupPaths(t *testing.T) {
 	}
 
 	for i, v := range vectors {
-		paths, err := generateLookupPaths(v.url)
+		paths, err := GenerateLookupPaths(v.url)
 		if err != nil != v.fail {
 			if err != nil {
 				t.Errorf("test %d, unexpected error: %v", i, err)
@@ -246,7 +297,7 @@ func TestGenerateLookupPaths(t *testing.T) {
 			continue
 		}
 		if !reflect.DeepEqual(paths, v.output) {
-			t.Errorf("test %d, generateLookupPaths(%q) = %q, want %q", i, v.url, paths, v.output)
+			t.Errorf("test %d, GenerateLookupPaths(%q) = %q, want %q", i, v.url, paths, v.output)
 		}
 	}
 }
@@ -290,6 +341,12 @@ func TestCanonicalURL(t *testing.T) {
 		{"http://\x01\x80.com/", "http://%01%80.com/", false},
 		{"http://notrailingslash.com", "http://notrailingslash.com/", false},
 		{"http://www.gotaport.com:1234/", "http://www.gotaport.com/", false},
+		{"http://www.gotaport.com:/", "http://www.gotaport.com/", false},
+		{"http://www.gotaport.com:80x/", "", true},
+		{"http://a:b:1234/", "", true},
+		{"http://:/", "", true},
+		{"http://.../", "", true},
+		{"http://%%C7%80/", "http://xn--%25-lsa/", false},
 		{"  http://www.google.com/  ", "http://www.google.com/", false},
 		{"http:// leadingspace.com/", "http://%20leadingspace.com/", false},
 		{"http://%20leadingspace.com/", "http://%20leadingspace.com/", false},
@@ -301,6 +358,7 @@ func TestCanonicalURL(t *testing.T) {
 		{"http://www.google.com/q?r?s%3F", "http://www.google.com/q", false},
 		{"http://www.\xC3\xBcmlat.com/", "http://www.xn--mlat-zra.com/", false},
 		{"http://[2001:470:1:18::114]/", "http://[2001:470:1:18::114]/", false}, // IPv6 literal.
+		{"http://[2001:470:1:18:0:0:0:114]/a", "http://[2001:470:1:18::114]/a", false},
 		{"http%3A%2F%2Fwackyurl.com:80/", "http://wackyurl.com/", false},
 		{"http://W!eird<>Ho$^.com/", "http://w!eird<>ho$^.com/", false},
 		{"http://i.have.way.too.many.dots.com/", "http://i.have.way.too.many.dots.com/", false},
@@ -323,7 +381,7 @@ func TestCanonicalURL(t *testing.T) {
 		{"mailto:bryner@google.com", "", true},
 	}
 	for i, v := range vectors {
-		path, err := canonicalURL(v.url)
+		path, err := Options{KeepScheme: true}.CanonicalURL(v.url)
 		if err != nil != v.fail {
 			if err != nil {
 				t.Errorf("test %d, unexpected error: %v", i, err)
@@ -333,7 +391,67 @@ func TestCanonicalURL(t *testing.T) {
 			continue
 		}
 		if path != v.output {
-			t.Errorf("test %d, canonicalURL(%q) = %q, want %q", i, v.url, path, v.output)
+			t.Errorf("test %d, CanonicalURL(%q) = %q, want %q", i, v.url, path, v.output)
+		}
+
+		// Without KeepScheme, the same URL is canonicalized without its
+		// scheme.
+		if v.fail {
+			continue
 		}
+		want := v.output[strings.Index(v.output, "://")+3:]
+		if path, _ := CanonicalURL(v.url); path != want {
+			t.Errorf("test %d, CanonicalURL(%q) = %q, want %q", i, v.url, path, want)
+		}
+	}
+}
+
+func TestCanonicalURLKeepQuery(t *testing.T) {
+	vectors := []struct {
+		url, output string
+	}{
+		{"http://www.google.com/", "www.google.com/"},
+		{"http://www.GOOgle.com/a//b/?q=%41#frag", "www.google.com/a/b/?q=A"},
+		{"http://www.google.com/?", "www.google.com/?"},
+		{"www.google.com?/", "www.google.com/?/"},
+		{"http://www.google.com/a?q=1?r=2", "www.google.com/a?q=1?r=2"},
+	}
+	for _, v := range vectors {
+		if got, err := (Options{KeepQuery: true}).CanonicalURL(v.url); got != v.output || err != nil {
+			t.Errorf("CanonicalURL(%q) = %q, %v, want %q", v.url, got, err, v.output)
+		}
+	}
+}
+
+func TestRejectReason(t *testing.T) {
+	vectors := []struct {
+		url    string
+		reason Reason
+	}{
+		{"mailto:bryner@google.com", ReasonInvalidPath},
+		{"http:///blah", ReasonMissingHostname},
+		{"http://.../", ReasonMissingHostname},
+		{"http://host/%" + strings.Repeat("25", 1024), ReasonTooRecursive},
+		{"http://[::1/", ReasonInvalidIPv6},
+		{"http://[::1]x/", ReasonInvalidIPv6},
+		{"http://a:b/", ReasonInvalidPort},
+		{"http://a͸b.com/", ReasonIDNA},
+		{"http://xn--aé.com/", ReasonIDNA},
+	}
+	for _, v := range vectors {
+		_, err := GeneratePatterns(v.url)
+		if err == nil {
+			t.Errorf("GeneratePatterns(%q): unexpected success", v.url)
+			continue
+		}
+		if got := RejectReason(err); got != v.reason {
+			t.Errorf("RejectReason(%v) = %v, want %v", err, got, v.reason)
+		}
+	}
+	if got := RejectReason(errors.New("other")); got != ReasonOther {
+		t.Errorf("RejectReason(other) = %v, want %v", got, ReasonOther)
+	}
+	if got := Reason(42).String(); got != "other" {
+		t.Errorf("Reason(42).String() = %q, want other", got)
 	}
 }
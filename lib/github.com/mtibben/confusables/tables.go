@@ -0,0 +1,6332 @@
+// This file was generated by go generate; DO NOT EDIT
+
+package confusables
+
+// Following is the original header of the source confusables.txt file
+//
+// confusables.txt
+// Date: 2020-02-13, 01:38:49 GMT
+// © 2020 Unicode®, Inc.
+// Unicode and the Unicode Logo are registered trademarks of Unicode, Inc. in the U.S. and other countries.
+// For terms of use, see http://www.unicode.org/terms_of_use.html
+//
+// Unicode Security Mechanisms for UTS #39
+// Version: 13.0.0
+//
+// For documentation and usage, see http://www.unicode.org/reports/tr39
+//
+
+var confusablesMap = map[rune]string{
+
+	0x000005AD: "\u0596",
+	0x000005AE: "\u0598",
+	0x000005A8: "\u0599",
+	0x000005A4: "\u059a",
+	0x00001AB4: "\u06db",
+	0x000020DB: "\u06db",
+	0x00000619: "\u0313",
+	0x000008F3: "\u0313",
+	0x00000343: "\u0313",
+	0x00000315: "\u0313",
+	0x0000064F: "\u0313",
+	0x0000065D: "\u0314",
+	0x0000059C: "\u0301",
+	0x0000059D: "\u0301",
+	0x00000618: "\u0301",
+	0x00000747: "\u0301",
+	0x00000341: "\u0301",
+	0x00000954: "\u0301",
+	0x0000064E: "\u0301",
+	0x00000340: "\u0300",
+	0x00000953: "\u0300",
+	0x0000030C: "\u0306",
+	0x0000A67C: "\u0306",
+	0x00000658: "\u0306",
+	0x0000065A: "\u0306",
+	0x0000036E: "\u0306",
+	0x000006E8: "\u0306\u0307",
+	0x00000310: "\u0306\u0307",
+	0x00000901: "\u0306\u0307",
+	0x00000981: "\u0306\u0307",
+	0x00000A81: "\u0306\u0307",
+	0x00000B01: "\u0306\u0307",
+	0x00000C00: "\u0306\u0307",
+	0x00000C81: "\u0306\u0307",
+	0x00000D01: "\u0306\u0307",
+	0x000114BF: "\u0306\u0307",
+	0x00001CD0: "\u0302",
+	0x00000311: "\u0302",
+	0x0000065B: "\u0302",
+	0x000007EE: "\u0302",
+	0x0000A6F0: "\u0302",
+	0x000005AF: "\u030a",
+	0x000006DF: "\u030a",
+	0x000017D3: "\u030a",
+	0x0000309A: "\u030a",
+	0x00000652: "\u030a",
+	0x00000B82: "\u030a",
+	0x00001036: "\u030a",
+	0x000017C6: "\u030a",
+	0x00011300: "\u030a",
+	0x00000E4D: "\u030a",
+	0x00000ECD: "\u030a",
+	0x00000366: "\u030a",
+	0x00002DEA: "\u030a",
+	0x000008EB: "\u0308",
+	0x000007F3: "\u0308",
+	0x0000064B: "\u030b",
+	0x000008F0: "\u030b",
+	0x00000342: "\u0303",
+	0x00000653: "\u0303",
+	0x000005C4: "\u0307",
+	0x000006EC: "\u0307",
+	0x00000740: "\u0307",
+	0x000008EA: "\u0307",
+	0x00000741: "\u0307",
+	0x00000358: "\u0307",
+	0x000005B9: "\u0307",
+	0x000005BA: "\u0307",
+	0x000005C2: "\u0307",
+	0x000005C1: "\u0307",
+	0x000007ED: "\u0307",
+	0x00000902: "\u0307",
+	0x00000A02: "\u0307",
+	0x00000A82: "\u0307",
+	0x00000BCD: "\u0307",
+	0x00000337: "\u0338",
+	0x00001AB7: "\u0328",
+	0x00000322: "\u0328",
+	0x00000345: "\u0328",
+	0x00001CD2: "\u0304",
+	0x00000305: "\u0304",
+	0x00000659: "\u0304",
+	0x000007EB: "\u0304",
+	0x0000A6F1: "\u0304",
+	0x00001CDA: "\u030e",
+	0x00000657: "\u0312",
+	0x00000357: "\u0350",
+	0x000008FF: "\u0350",
+	0x000008F8: "\u0350",
+	0x00000900: "\u0352",
+	0x00001CED: "\u0316",
+	0x00001CDC: "\u0329",
+	0x00000656: "\u0329",
+	0x00001CD5: "\u032b",
+	0x00000347: "\u0333",
+	0x000008F9: "\u0354",
+	0x000008FA: "\u0355",
+	0x0000309B: "\uff9e",
+	0x0000309C: "\uff9f",
+	0x00000336: "\u0335",
+	0x0000302C: "\u0309",
+	0x000005C5: "\u0323",
+	0x000008ED: "\u0323",
+	0x00001CDD: "\u0323",
+	0x000005B4: "\u0323",
+	0x0000065C: "\u0323",
+	0x0000093C: "\u0323",
+	0x000009BC: "\u0323",
+	0x00000A3C: "\u0323",
+	0x00000ABC: "\u0323",
+	0x00000B3C: "\u0323",
+	0x000111CA: "\u0323",
+	0x000114C3: "\u0323",
+	0x00010A3A: "\u0323",
+	0x000008EE: "\u0324",
+	0x00001CDE: "\u0324",
+	0x00000F37: "\u0325",
+	0x0000302D: "\u0325",
+	0x00000327: "\u0326",
+	0x00000321: "\u0326",
+	0x00000339: "\u0326",
+	0x00001CD9: "\u032d",
+	0x00001CD8: "\u032e",
+	0x00000952: "\u0331",
+	0x00000320: "\u0331",
+	0x000008F1: "\u064c",
+	0x000008E8: "\u064c",
+	0x000008E5: "\u064c",
+	0x0000FC5E: "\ufe72\u0651",
+	0x000008F2: "\u064d",
+	0x0000FC5F: "\ufe74\u0651",
+	0x0000FCF2: "\ufe77\u0651",
+	0x0000FC60: "\ufe76\u0651",
+	0x0000FCF3: "\ufe79\u0651",
+	0x0000FC61: "\ufe78\u0651",
+	0x0000061A: "\u0650",
+	0x00000317: "\u0650",
+	0x0000FCF4: "\ufe7b\u0651",
+	0x0000FC62: "\ufe7a\u0651",
+	0x0000FC63: "\ufe7c\u0670",
+	0x0000065F: "\u0655",
+	0x0000030D: "\u0670",
+	0x00000742: "\u073c",
+	0x00000A03: "\u0983",
+	0x00000C03: "\u0983",
+	0x00000C83: "\u0983",
+	0x00000D03: "\u0983",
+	0x00000D83: "\u0983",
+	0x00001038: "\u0983",
+	0x000114C1: "\u0983",
+	0x000017CB: "\u0e48",
+	0x00000EC8: "\u0e48",
+	0x00000EC9: "\u0e49",
+	0x00000ECA: "\u0e4a",
+	0x00000ECB: "\u0e4b",
+	0x0000A66F: "\u20e9",
+	0x00002028: " ",
+	0x00002029: " ",
+	0x00001680: " ",
+	0x00002000: " ",
+	0x00002001: " ",
+	0x00002002: " ",
+	0x00002003: " ",
+	0x00002004: " ",
+	0x00002005: " ",
+	0x00002006: " ",
+	0x00002008: " ",
+	0x00002009: " ",
+	0x0000200A: " ",
+	0x0000205F: " ",
+	0x000000A0: " ",
+	0x00002007: " ",
+	0x0000202F: " ",
+	0x000007FA: "_",
+	0x0000FE4D: "_",
+	0x0000FE4E: "_",
+	0x0000FE4F: "_",
+	0x00002010: "-",
+	0x00002011: "-",
+	0x00002012: "-",
+	0x00002013: "-",
+	0x0000FE58: "-",
+	0x000006D4: "-",
+	0x00002043: "-",
+	0x000002D7: "-",
+	0x00002212: "-",
+	0x00002796: "-",
+	0x00002CBA: "-",
+	0x00002A29: "-\u0313",
+	0x00002E1A: "-\u0308",
+	0x0000FB29: "-\u0307",
+	0x00002238: "-\u0307",
+	0x00002A2A: "-\u0323",
+	0x0000A4FE: "-.",
+	0x0000FF5E: "\u301c",
+	0x0000060D: ",",
+	0x0000066B: ",",
+	0x0000201A: ",",
+	0x000000B8: ",",
+	0x0000A4F9: ",",
+	0x00002E32: "\u060c",
+	0x0000066C: "\u060c",
+	0x0000037E: ";",
+	0x00002E35: "\u061b",
+	0x00000903: ":",
+	0x00000A83: ":",
+	0x0000FF1A: ":",
+	0x00000589: ":",
+	0x00000703: ":",
+	0x00000704: ":",
+	0x000016EC: ":",
+	0x0000FE30: ":",
+	0x00001803: ":",
+	0x00001809: ":",
+	0x0000205A: ":",
+	0x000005C3: ":",
+	0x000002F8: ":",
+	0x0000A789: ":",
+	0x00002236: ":",
+	0x000002D0: ":",
+	0x0000A4FD: ":",
+	0x00002A74: "::=",
+	0x000029F4: ":\u2192",
+	0x0000FF01: "!",
+	0x000001C3: "!",
+	0x00002D51: "!",
+	0x0000203C: "!!",
+	0x00002049: "!?",
+	0x00000294: "?",
+	0x00000241: "?",
+	0x0000097D: "?",
+	0x000013AE: "?",
+	0x0000A6EB: "?",
+	0x00002048: "?!",
+	0x00002047: "??",
+	0x00002E2E: "\u061f",
+	0x0001D16D: ".",
+	0x00002024: ".",
+	0x00000701: ".",
+	0x00000702: ".",
+	0x0000A60E: ".",
+	0x00010A50: ".",
+	0x00000660: ".",
+	0x000006F0: ".",
+	0x0000A4F8: ".",
+	0x0000A4FB: ".,",
+	0x00002025: "..",
+	0x0000A4FA: "..",
+	0x00002026: "...",
+	0x0000A6F4: "\ua6f3\ua6f3",
+	0x000030FB: "\u00b7",
+	0x0000FF65: "\u00b7",
+	0x000016EB: "\u00b7",
+	0x00000387: "\u00b7",
+	0x00002E31: "\u00b7",
+	0x00010101: "\u00b7",
+	0x00002022: "\u00b7",
+	0x00002027: "\u00b7",
+	0x00002219: "\u00b7",
+	0x000022C5: "\u00b7",
+	0x0000A78F: "\u00b7",
+	0x00001427: "\u00b7",
+	0x000022EF: "\u00b7\u00b7\u00b7",
+	0x00002D48: "\u00b7\u00b7\u00b7",
+	0x00001444: "\u00b7<",
+	0x000022D7: "\u00b7>",
+	0x00001437: "\u00b7>",
+	0x00001440: "\u00b7>",
+	0x0000152F: "\u00b74",
+	0x0000147E: "\u00b7b",
+	0x00001480: "\u00b7b\u0307",
+	0x0000147A: "\u00b7d",
+	0x00001498: "\u00b7J",
+	0x000014B6: "\u00b7L",
+	0x00001476: "\u00b7P",
+	0x00001457: "\u00b7U",
+	0x0000143A: "\u00b7V",
+	0x0000143C: "\u00b7\u0245",
+	0x000014AE: "\u00b7\u0393",
+	0x0000140E: "\u00b7\u0394",
+	0x00001459: "\u00b7\u0548",
+	0x0000140C: "\u00b7\u1401",
+	0x00001410: "\u00b7\u1404",
+	0x00001412: "\u00b7\u1405",
+	0x00001414: "\u00b7\u1406",
+	0x00001417: "\u00b7\u140a",
+	0x00001419: "\u00b7\u140b",
+	0x0000143E: "\u00b7\u1432",
+	0x00001442: "\u00b7\u1434",
+	0x00001446: "\u00b7\u1439",
+	0x0000145B: "\u00b7\u144f",
+	0x00001454: "\u00b7\u1450",
+	0x0000145D: "\u00b7\u1450",
+	0x0000145F: "\u00b7\u1451",
+	0x00001461: "\u00b7\u1455",
+	0x00001463: "\u00b7\u1456",
+	0x00001474: "\u00b7\u146b",
+	0x00001478: "\u00b7\u146e",
+	0x0000147C: "\u00b7\u1470",
+	0x00001492: "\u00b7\u1489",
+	0x00001494: "\u00b7\u148b",
+	0x00001496: "\u00b7\u148c",
+	0x0000149A: "\u00b7\u148e",
+	0x0000149C: "\u00b7\u1490",
+	0x0000149E: "\u00b7\u1491",
+	0x000014AC: "\u00b7\u14a3",
+	0x000014B0: "\u00b7\u14a6",
+	0x000014B2: "\u00b7\u14a7",
+	0x000014B4: "\u00b7\u14a8",
+	0x000014B8: "\u00b7\u14ab",
+	0x000014C9: "\u00b7\u14c0",
+	0x000018C6: "\u00b7\u14c2",
+	0x000018C8: "\u00b7\u14c3",
+	0x000018CA: "\u00b7\u14c4",
+	0x000018CC: "\u00b7\u14c5",
+	0x000014CB: "\u00b7\u14c7",
+	0x000014CD: "\u00b7\u14c8",
+	0x000014DC: "\u00b7\u14d3",
+	0x000014DE: "\u00b7\u14d5",
+	0x000014E0: "\u00b7\u14d6",
+	0x000014E2: "\u00b7\u14d7",
+	0x000014E4: "\u00b7\u14d8",
+	0x000014E6: "\u00b7\u14da",
+	0x000014E8: "\u00b7\u14db",
+	0x000014F6: "\u00b7\u14ed",
+	0x000014F8: "\u00b7\u14ef",
+	0x000014FA: "\u00b7\u14f0",
+	0x000014FC: "\u00b7\u14f1",
+	0x000014FE: "\u00b7\u14f2",
+	0x00001500: "\u00b7\u14f4",
+	0x00001502: "\u00b7\u14f5",
+	0x00001517: "\u00b7\u1510",
+	0x00001519: "\u00b7\u1511",
+	0x0000151B: "\u00b7\u1512",
+	0x0000151D: "\u00b7\u1513",
+	0x0000151F: "\u00b7\u1514",
+	0x00001521: "\u00b7\u1515",
+	0x00001523: "\u00b7\u1516",
+	0x00001531: "\u00b7\u1528",
+	0x00001533: "\u00b7\u1529",
+	0x00001535: "\u00b7\u152a",
+	0x00001537: "\u00b7\u152b",
+	0x00001539: "\u00b7\u152d",
+	0x0000153B: "\u00b7\u152e",
+	0x000018CE: "\u00b7\u1543",
+	0x000018CF: "\u00b7\u1546",
+	0x000018D0: "\u00b7\u1547",
+	0x000018D1: "\u00b7\u1548",
+	0x000018D2: "\u00b7\u1549",
+	0x000018D3: "\u00b7\u154b",
+	0x0000154E: "\u00b7\u154c",
+	0x0000155B: "\u00b7\u155a",
+	0x00001568: "\u00b7\u1567",
+	0x000018B3: "\u00b7\u18b1",
+	0x000018B6: "\u00b7\u18b4",
+	0x000018B9: "\u00b7\u18b8",
+	0x000018C2: "\u00b7\u18c0",
+	0x0000A830: "\u0964",
+	0x00000965: "\u0964\u0964",
+	0x00001C3C: "\u1c3b\u1c3b",
+	0x0000104B: "\u104a\u104a",
+	0x00001AA9: "\u1aa8\u1aa8",
+	0x00001AAB: "\u1aaa\u1aa8",
+	0x00001B5F: "\u1b5e\u1b5e",
+	0x00010A57: "\U00010a56\U00010a56",
+	0x0001144C: "\U0001144b\U0001144b",
+	0x00011642: "\U00011641\U00011641",
+	0x00011C42: "\U00011c41\U00011c41",
+	0x00001C7F: "\u1c7e\u1c7e",
+	0x0000055D: "'",
+	0x0000FF07: "'",
+	0x00002018: "'",
+	0x00002019: "'",
+	0x0000201B: "'",
+	0x00002032: "'",
+	0x00002035: "'",
+	0x0000055A: "'",
+	0x000005F3: "'",
+	0x00000060: "'",
+	0x00001FEF: "'",
+	0x0000FF40: "'",
+	0x000000B4: "'",
+	0x00000384: "'",
+	0x00001FFD: "'",
+	0x00001FBD: "'",
+	0x00001FBF: "'",
+	0x00001FFE: "'",
+	0x000002B9: "'",
+	0x00000374: "'",
+	0x000002C8: "'",
+	0x000002CA: "'",
+	0x000002CB: "'",
+	0x000002F4: "'",
+	0x000002BB: "'",
+	0x000002BD: "'",
+	0x000002BC: "'",
+	0x000002BE: "'",
+	0x0000A78C: "'",
+	0x000005D9: "'",
+	0x000007F4: "'",
+	0x000007F5: "'",
+	0x0000144A: "'",
+	0x000016CC: "'",
+	0x00016F51: "'",
+	0x00016F52: "'",
+	0x00001CD3: "''",
+	0x00000022: "''",
+	0x0000FF02: "''",
+	0x0000201C: "''",
+	0x0000201D: "''",
+	0x0000201F: "''",
+	0x00002033: "''",
+	0x00002036: "''",
+	0x00003003: "''",
+	0x000005F4: "''",
+	0x000002DD: "''",
+	0x000002BA: "''",
+	0x000002F6: "''",
+	0x000002EE: "''",
+	0x000005F2: "''",
+	0x00002034: "'''",
+	0x00002037: "'''",
+	0x00002057: "''''",
+	0x00000181: "'B",
+	0x0000018A: "'D",
+	0x00000149: "'n",
+	0x000001A4: "'P",
+	0x000001AC: "'T",
+	0x000001B3: "'Y",
+	0x0000FF3B: "(",
+	0x00002768: "(",
+	0x00002772: "(",
+	0x00003014: "(",
+	0x0000FD3E: "(",
+	0x00002E28: "((",
+	0x00003220: "(\u30fc)",
+	0x00002475: "(2)",
+	0x00002487: "(2O)",
+	0x00002476: "(3)",
+	0x00002477: "(4)",
+	0x00002478: "(5)",
+	0x00002479: "(6)",
+	0x0000247A: "(7)",
+	0x0000247B: "(8)",
+	0x0000247C: "(9)",
+	0x0000249C: "(a)",
+	0x0001F110: "(A)",
+	0x0000249D: "(b)",
+	0x0001F111: "(B)",
+	0x0000249E: "(c)",
+	0x0001F112: "(C)",
+	0x0000249F: "(d)",
+	0x0001F113: "(D)",
+	0x000024A0: "(e)",
+	0x0001F114: "(E)",
+	0x000024A1: "(f)",
+	0x0001F115: "(F)",
+	0x000024A2: "(g)",
+	0x0001F116: "(G)",
+	0x000024A3: "(h)",
+	0x0001F117: "(H)",
+	0x000024A4: "(i)",
+	0x000024A5: "(j)",
+	0x0001F119: "(J)",
+	0x000024A6: "(k)",
+	0x0001F11A: "(K)",
+	0x00002474: "(l)",
+	0x0001F118: "(l)",
+	0x000024A7: "(l)",
+	0x0001F11B: "(L)",
+	0x0000247F: "(l2)",
+	0x00002480: "(l3)",
+	0x00002481: "(l4)",
+	0x00002482: "(l5)",
+	0x00002483: "(l6)",
+	0x00002484: "(l7)",
+	0x00002485: "(l8)",
+	0x00002486: "(l9)",
+	0x0000247E: "(ll)",
+	0x0000247D: "(lO)",
+	0x0001F11C: "(M)",
+	0x000024A9: "(n)",
+	0x0001F11D: "(N)",
+	0x000024AA: "(o)",
+	0x0001F11E: "(O)",
+	0x000024AB: "(p)",
+	0x0001F11F: "(P)",
+	0x000024AC: "(q)",
+	0x0001F120: "(Q)",
+	0x000024AD: "(r)",
+	0x0001F121: "(R)",
+	0x000024A8: "(rn)",
+	0x000024AE: "(s)",
+	0x0001F122: "(S)",
+	0x0001F12A: "(S)",
+	0x000024AF: "(t)",
+	0x0001F123: "(T)",
+	0x000024B0: "(u)",
+	0x0001F124: "(U)",
+	0x000024B1: "(v)",
+	0x0001F125: "(V)",
+	0x000024B2: "(w)",
+	0x0001F126: "(W)",
+	0x000024B3: "(x)",
+	0x0001F127: "(X)",
+	0x000024B4: "(y)",
+	0x0001F128: "(Y)",
+	0x000024B5: "(z)",
+	0x0001F129: "(Z)",
+	0x00003200: "(\u1100)",
+	0x0000320E: "(\uac00)",
+	0x00003201: "(\u1102)",
+	0x0000320F: "(\ub098)",
+	0x00003202: "(\u1103)",
+	0x00003210: "(\ub2e4)",
+	0x00003203: "(\u1105)",
+	0x00003211: "(\ub77c)",
+	0x00003204: "(\u1106)",
+	0x00003212: "(\ub9c8)",
+	0x00003205: "(\u1107)",
+	0x00003213: "(\ubc14)",
+	0x00003206: "(\u1109)",
+	0x00003214: "(\uc0ac)",
+	0x00003207: "(\u110b)",
+	0x00003215: "(\uc544)",
+	0x0000321D: "(\uc624\uc804)",
+	0x0000321E: "(\uc624\ud6c4)",
+	0x00003208: "(\u110c)",
+	0x00003216: "(\uc790)",
+	0x0000321C: "(\uc8fc)",
+	0x00003209: "(\u110e)",
+	0x00003217: "(\ucc28)",
+	0x0000320A: "(\u110f)",
+	0x00003218: "(\uce74)",
+	0x0000320B: "(\u1110)",
+	0x00003219: "(\ud0c0)",
+	0x0000320C: "(\u1111)",
+	0x0000321A: "(\ud30c)",
+	0x0000320D: "(\u1112)",
+	0x0000321B: "(\ud558)",
+	0x00003226: "(\u4e03)",
+	0x00003222: "(\u4e09)",
+	0x0001F241: "(\u4e09)",
+	0x00003228: "(\u4e5d)",
+	0x00003221: "(\u4e8c)",
+	0x0001F242: "(\u4e8c)",
+	0x00003224: "(\u4e94)",
+	0x00003239: "(\u4ee3)",
+	0x0000323D: "(\u4f01)",
+	0x00003241: "(\u4f11)",
+	0x00003227: "(\u516b)",
+	0x00003225: "(\u516d)",
+	0x00003238: "(\u52b4)",
+	0x0001F247: "(\u52dd)",
+	0x00003229: "(\u5341)",
+	0x0000323F: "(\u5354)",
+	0x00003234: "(\u540d)",
+	0x0000323A: "(\u547c)",
+	0x00003223: "(\u56db)",
+	0x0000322F: "(\u571f)",
+	0x0000323B: "(\u5b66)",
+	0x0001F243: "(\u5b89)",
+	0x0001F245: "(\u6253)",
+	0x0001F248: "(\u6557)",
+	0x00003230: "(\u65e5)",
+	0x0000322A: "(\u6708)",
+	0x00003232: "(\u6709)",
+	0x0000322D: "(\u6728)",
+	0x0001F240: "(\u672c)",
+	0x00003231: "(\u682a)",
+	0x0000322C: "(\u6c34)",
+	0x0000322B: "(\u706b)",
+	0x0001F244: "(\u70b9)",
+	0x00003235: "(\u7279)",
+	0x0001F246: "(\u76d7)",
+	0x0000323C: "(\u76e3)",
+	0x00003233: "(\u793e)",
+	0x00003237: "(\u795d)",
+	0x00003240: "(\u796d)",
+	0x00003242: "(\u81ea)",
+	0x00003243: "(\u81f3)",
+	0x00003236: "(\u8ca1)",
+	0x0000323E: "(\u8cc7)",
+	0x0000322E: "(\u91d1)",
+	0x0000FF3D: ")",
+	0x00002769: ")",
+	0x00002773: ")",
+	0x00003015: ")",
+	0x0000FD3F: ")",
+	0x00002E29: "))",
+	0x00002774: "{",
+	0x0001D114: "{",
+	0x00002775: "}",
+	0x0000301A: "\u27e6",
+	0x0000301B: "\u27e7",
+	0x000027E8: "\u276c",
+	0x00002329: "\u276c",
+	0x00003008: "\u276c",
+	0x000031DB: "\u276c",
+	0x0000304F: "\u276c",
+	0x00021FE8: "\u276c",
+	0x000027E9: "\u276d",
+	0x0000232A: "\u276d",
+	0x00003009: "\u276d",
+	0x0000FF3E: "\ufe3f",
+	0x00002E3F: "\u00b6",
+	0x0000204E: "*",
+	0x0000066D: "*",
+	0x00002217: "*",
+	0x0001031F: "*",
+	0x00001735: "/",
+	0x00002041: "/",
+	0x00002215: "/",
+	0x00002044: "/",
+	0x00002571: "/",
+	0x000027CB: "/",
+	0x000029F8: "/",
+	0x0001D23A: "/",
+	0x000031D3: "/",
+	0x00003033: "/",
+	0x00002CC6: "/",
+	0x000030CE: "/",
+	0x00004E3F: "/",
+	0x00002F03: "/",
+	0x000029F6: "/\u0304",
+	0x00002AFD: "//",
+	0x00002AFB: "///",
+	0x0000FF3C: "\\",
+	0x0000FE68: "\\",
+	0x00002216: "\\",
+	0x000027CD: "\\",
+	0x000029F5: "\\",
+	0x000029F9: "\\",
+	0x0001D20F: "\\",
+	0x0001D23B: "\\",
+	0x000031D4: "\\",
+	0x00004E36: "\\",
+	0x00002F02: "\\",
+	0x00002CF9: "\\\\",
+	0x0000244A: "\\\\",
+	0x000027C8: "\\\u1455",
+	0x0000A778: "&",
+	0x00000AF0: "\u0970",
+	0x000110BB: "\u0970",
+	0x000111C7: "\u0970",
+	0x000026AC: "\u0970",
+	0x000111DB: "\ua8fc",
+	0x000017D9: "\u0e4f",
+	0x000017D5: "\u0e5a",
+	0x000017DA: "\u0e5b",
+	0x00000F0C: "\u0f0b",
+	0x00000F0E: "\u0f0d\u0f0d",
+	0x000002C4: "^",
+	0x000002C6: "^",
+	0x0000A67E: "\u02c7",
+	0x000002D8: "\u02c7",
+	0x0000203E: "\u02c9",
+	0x0000FE49: "\u02c9",
+	0x0000FE4A: "\u02c9",
+	0x0000FE4B: "\u02c9",
+	0x0000FE4C: "\u02c9",
+	0x000000AF: "\u02c9",
+	0x0000FFE3: "\u02c9",
+	0x00002594: "\u02c9",
+	0x0000044A: "\u02c9b",
+	0x0000A651: "\u02c9bi",
+	0x00000375: "\u02cf",
+	0x000002FB: "\u02ea",
+	0x0000A716: "\u02ea",
+	0x0000A714: "\u02eb",
+	0x00003002: "\u02f3",
+	0x00002E30: "\u00b0",
+	0x000002DA: "\u00b0",
+	0x00002218: "\u00b0",
+	0x000025CB: "\u00b0",
+	0x000025E6: "\u00b0",
+	0x0000235C: "\u00b0\u0332",
+	0x00002364: "\u00b0\u0308",
+	0x00002103: "\u00b0C",
+	0x00002109: "\u00b0F",
+	0x00000BF5: "\u0bf3",
+	0x00000F1B: "\u0f1a\u0f1a",
+	0x00000F1F: "\u0f1a\u0f1d",
+	0x00000FCE: "\u0f1d\u0f1a",
+	0x00000F1E: "\u0f1d\u0f1d",
+	0x000024B8: "\u00a9",
+	0x000024C7: "\u00ae",
+	0x000024C5: "\u2117",
+	0x0001D21B: "\u2144",
+	0x00002BEC: "\u219e",
+	0x00002BED: "\u219f",
+	0x00002BEE: "\u21a0",
+	0x00002BEF: "\u21a1",
+	0x000021B5: "\u21b2",
+	0x00002965: "\u21c3\u21c2",
+	0x0000296F: "\u21c3\u16da",
+	0x0001D6DB: "\u2202",
+	0x0001D715: "\u2202",
+	0x0001D74F: "\u2202",
+	0x0001D789: "\u2202",
+	0x0001D7C3: "\u2202",
+	0x0001E8CC: "\u2202",
+	0x0001E8CD: "\u2202\u0335",
+	0x000000F0: "\u2202\u0335",
+	0x00002300: "\u2205",
+	0x0001D6C1: "\u2207",
+	0x0001D6FB: "\u2207",
+	0x0001D735: "\u2207",
+	0x0001D76F: "\u2207",
+	0x0001D7A9: "\u2207",
+	0x000118A8: "\u2207",
+	0x00002362: "\u2207\u0308",
+	0x0000236B: "\u2207\u0334",
+	0x00002588: "\u220e",
+	0x000025A0: "\u220e",
+	0x00002A3F: "\u2210",
+	0x000016ED: "+",
+	0x00002795: "+",
+	0x0001029B: "+",
+	0x00002A23: "+\u0302",
+	0x00002A22: "+\u030a",
+	0x00002A24: "+\u0303",
+	0x00002214: "+\u0307",
+	0x00002A25: "+\u0323",
+	0x00002A26: "+\u0330",
+	0x00002A27: "+\u2082",
+	0x00002797: "\u00f7",
+	0x00002039: "<",
+	0x0000276E: "<",
+	0x000002C2: "<",
+	0x0001D236: "<",
+	0x00001438: "<",
+	0x000016B2: "<",
+	0x000022D6: "<\u00b7",
+	0x00002CB4: "<\u00b7",
+	0x00001445: "<\u00b7",
+	0x0000226A: "<<",
+	0x000022D8: "<<<",
+	0x00001400: "=",
+	0x00002E40: "=",
+	0x000030A0: "=",
+	0x0000A4FF: "=",
+	0x0000225A: "=\u0306",
+	0x00002259: "=\u0302",
+	0x00002257: "=\u030a",
+	0x00002250: "=\u0307",
+	0x00002251: "=\u0307\u0323",
+	0x00002A6E: "=\u20f0",
+	0x00002A75: "==",
+	0x00002A76: "===",
+	0x0000225E: "=\u036b",
+	0x0000203A: ">",
+	0x0000276F: ">",
+	0x000002C3: ">",
+	0x0001D237: ">",
+	0x00001433: ">",
+	0x00016F3F: ">",
+	0x00001441: ">\u00b7",
+	0x00002AA5: "><",
+	0x0000226B: ">>",
+	0x00002A20: ">>",
+	0x000022D9: ">>>",
+	0x00002053: "~",
+	0x000002DC: "~",
+	0x00001FC0: "~",
+	0x0000223C: "~",
+	0x00002368: "~\u0308",
+	0x00002E1E: "~\u0307",
+	0x00002A6A: "~\u0307",
+	0x00002E1F: "~\u0323",
+	0x0001E8C8: "\u2220",
+	0x000022C0: "\u2227",
+	0x0000222F: "\u222e\u222e",
+	0x00002230: "\u222e\u222e\u222e",
+	0x00002E2B: "\u2234",
+	0x00002E2A: "\u2235",
+	0x00002E2C: "\u2237",
+	0x000111DE: "\u2248",
+	0x0000264E: "\u224f",
+	0x0001F75E: "\u224f",
+	0x00002263: "\u2261",
+	0x00002A03: "\u228d",
+	0x00002A04: "\u228e",
+	0x0001D238: "\u228f",
+	0x0001D239: "\u2290",
+	0x00002A05: "\u2293",
+	0x00002A06: "\u2294",
+	0x00002A02: "\u2297",
+	0x0000235F: "\u229b",
+	0x0001F771: "\u22a0",
+	0x0001F755: "\u22a1",
+	0x000025C1: "\u22b2",
+	0x000025B7: "\u22b3",
+	0x00002363: "\u22c6\u0308",
+	0x0000FE34: "\u2307",
+	0x000025E0: "\u2312",
+	0x00002A3D: "\u2319",
+	0x00002325: "\u2324",
+	0x000029C7: "\u233b",
+	0x000025CE: "\u233e",
+	0x000029BE: "\u233e",
+	0x000029C5: "\u2342",
+	0x000029B0: "\u2349",
+	0x000023C3: "\u234b",
+	0x000023C2: "\u234e",
+	0x000023C1: "\u2355",
+	0x000023C6: "\u236d",
+	0x00002638: "\u2388",
+	0x0000FE35: "\u23dc",
+	0x0000FE36: "\u23dd",
+	0x0000FE37: "\u23de",
+	0x0000FE38: "\u23df",
+	0x0000FE39: "\u23e0",
+	0x0000FE3A: "\u23e1",
+	0x000025B1: "\u23e5",
+	0x000023FC: "\u23fb",
+	0x0000FE31: "\u2502",
+	0x0000FF5C: "\u2502",
+	0x00002503: "\u2502",
+	0x0000250F: "\u250c",
+	0x00002523: "\u251c",
+	0x00002590: "\u258c",
+	0x00002597: "\u2596",
+	0x0000259D: "\u2598",
+	0x00002610: "\u25a1",
+	0x0000FFED: "\u25aa",
+	0x000025B8: "\u25b6",
+	0x000025BA: "\u25b6",
+	0x00002CE9: "\u2627",
+	0x0001F70A: "\u2629",
+	0x0001F312: "\u263d",
+	0x0001F319: "\u263d",
+	0x000023FE: "\u263e",
+	0x0001F318: "\u263e",
+	0x000029D9: "\u299a",
+	0x0001F73A: "\u29df",
+	0x00002A3E: "\u2a1f",
+	0x000101A0: "\u2ce8",
+	0x00002669: "\U0001d158\U0001d165",
+	0x0000266A: "\U0001d158\U0001d165\U0001d16e",
+	0x000024EA: "\U0001f10d",
+	0x000021BA: "\U0001f10e",
+	0x000002D9: "\u0971",
+	0x00000D4E: "\u0971",
+	0x0000FF0D: "\u30fc",
+	0x00002014: "\u30fc",
+	0x00002015: "\u30fc",
+	0x00002500: "\u30fc",
+	0x00002501: "\u30fc",
+	0x000031D0: "\u30fc",
+	0x0000A7F7: "\u30fc",
+	0x00001173: "\u30fc",
+	0x00003161: "\u30fc",
+	0x00004E00: "\u30fc",
+	0x00002F00: "\u30fc",
+	0x00001196: "\u30fc\u30fc",
+	0x0000D7B9: "\u30fc\u1161",
+	0x0000D7BA: "\u30fc\u1165",
+	0x0000D7BB: "\u30fc\u1165\u4e28",
+	0x0000D7BC: "\u30fc\u1169",
+	0x00001195: "\u30fc\u116e",
+	0x00001174: "\u30fc\u4e28",
+	0x00003162: "\u30fc\u4e28",
+	0x00001197: "\u30fc\u4e28\u116e",
+	0x0001F10F: "$\u20e0",
+	0x000020A4: "\u00a3",
+	0x00003012: "\u20b8",
+	0x00003036: "\u20b8",
+	0x00001B5C: "\u1b50",
+	0x0000A9C6: "\ua9d0",
+	0x000114D1: "\u09e7",
+	0x00000CE7: "\u0c67",
+	0x00001065: "\u1041",
+	0x00002460: "\u2780",
+	0x00002469: "\u2789",
+	0x000023E8: "\u2081\u2080",
+	0x0001D7D0: "2",
+	0x0001D7DA: "2",
+	0x0001D7E4: "2",
+	0x0001D7EE: "2",
+	0x0001D7F8: "2",
+	0x0001FBF2: "2",
+	0x0000A75A: "2",
+	0x000001A7: "2",
+	0x000003E8: "2",
+	0x0000A644: "2",
+	0x000014BF: "2",
+	0x0000A6EF: "2",
+	0x0000A9CF: "\u0662",
+	0x000006F2: "\u0662",
+	0x00000AE8: "\u0968",
+	0x000114D2: "\u09e8",
+	0x00000CE8: "\u0c68",
+	0x00002461: "\u2781",
+	0x000001BB: "2\u0335",
+	0x0001F103: "2,",
+	0x00002489: "2.",
+	0x000033F5: "22\u65e5",
+	0x0000336E: "22\u70b9",
+	0x000033F6: "23\u65e5",
+	0x0000336F: "23\u70b9",
+	0x000033F7: "24\u65e5",
+	0x00003370: "24\u70b9",
+	0x000033F8: "25\u65e5",
+	0x000033F9: "26\u65e5",
+	0x000033FA: "27\u65e5",
+	0x000033FB: "28\u65e5",
+	0x000033FC: "29\u65e5",
+	0x000033F4: "2l\u65e5",
+	0x0000336D: "2l\u70b9",
+	0x0000249B: "2O.",
+	0x000033F3: "2O\u65e5",
+	0x0000336C: "2O\u70b9",
+	0x00000DE9: "\u0de8\u0dcf",
+	0x00000DEF: "\u0de8\u0dd3",
+	0x000033E1: "2\u65e5",
+	0x000032C1: "2\u6708",
+	0x0000335A: "2\u70b9",
+	0x0001D206: "3",
+	0x0001D7D1: "3",
+	0x0001D7DB: "3",
+	0x0001D7E5: "3",
+	0x0001D7EF: "3",
+	0x0001D7F9: "3",
+	0x0001FBF3: "3",
+	0x0000A7AB: "3",
+	0x0000021C: "3",
+	0x000001B7: "3",
+	0x0000A76A: "3",
+	0x00002CCC: "3",
+	0x00000417: "3",
+	0x000004E0: "3",
+	0x00016F3B: "3",
+	0x000118CA: "3",
+	0x000006F3: "\u0663",
+	0x0001E8C9: "\u0663",
+	0x00000AE9: "\u0969",
+	0x00002462: "\u2782",
+	0x00000498: "3\u0326",
+	0x0001F104: "3,",
+	0x0000248A: "3.",
+	0x000033FE: "3l\u65e5",
+	0x000033FD: "3O\u65e5",
+	0x000033E2: "3\u65e5",
+	0x000032C2: "3\u6708",
+	0x0000335B: "3\u70b9",
+	0x0001D7D2: "4",
+	0x0001D7DC: "4",
+	0x0001D7E6: "4",
+	0x0001D7F0: "4",
+	0x0001D7FA: "4",
+	0x0001FBF4: "4",
+	0x000013CE: "4",
+	0x000118AF: "4",
+	0x000006F4: "\u0664",
+	0x00000AEA: "\u096a",
+	0x00002463: "\u2783",
+	0x0001F105: "4,",
+	0x0000248B: "4.",
+	0x00001530: "4\u00b7",
+	0x000033E3: "4\u65e5",
+	0x000032C3: "4\u6708",
+	0x0000335C: "4\u70b9",
+	0x0001D7D3: "5",
+	0x0001D7DD: "5",
+	0x0001D7E7: "5",
+	0x0001D7F1: "5",
+	0x0001D7FB: "5",
+	0x0001FBF5: "5",
+	0x000001BC: "5",
+	0x000118BB: "5",
+	0x00002464: "\u2784",
+	0x0001F106: "5,",
+	0x0000248C: "5.",
+	0x000033E4: "5\u65e5",
+	0x000032C4: "5\u6708",
+	0x0000335D: "5\u70b9",
+	0x0001D7D4: "6",
+	0x0001D7DE: "6",
+	0x0001D7E8: "6",
+	0x0001D7F2: "6",
+	0x0001D7FC: "6",
+	0x0001FBF6: "6",
+	0x00002CD2: "6",
+	0x00000431: "6",
+	0x000013EE: "6",
+	0x000118D5: "6",
+	0x000006F6: "\u0666",
+	0x000114D6: "\u09ec",
+	0x00002465: "\u2785",
+	0x0001F107: "6,",
+	0x0000248D: "6.",
+	0x000033E5: "6\u65e5",
+	0x000032C5: "6\u6708",
+	0x0000335E: "6\u70b9",
+	0x0001D212: "7",
+	0x0001D7D5: "7",
+	0x0001D7DF: "7",
+	0x0001D7E9: "7",
+	0x0001D7F3: "7",
+	0x0001D7FD: "7",
+	0x0001FBF7: "7",
+	0x000104D2: "7",
+	0x000118C6: "7",
+	0x00002466: "\u2786",
+	0x0001F108: "7,",
+	0x0000248E: "7.",
+	0x000033E6: "7\u65e5",
+	0x000032C6: "7\u6708",
+	0x0000335F: "7\u70b9",
+	0x00000B03: "8",
+	0x000009EA: "8",
+	0x00000A6A: "8",
+	0x0001E8CB: "8",
+	0x0001D7D6: "8",
+	0x0001D7E0: "8",
+	0x0001D7EA: "8",
+	0x0001D7F4: "8",
+	0x0001D7FE: "8",
+	0x0001FBF8: "8",
+	0x00000223: "8",
+	0x00000222: "8",
+	0x0001031A: "8",
+	0x00000AEE: "\u096e",
+	0x00002467: "\u2787",
+	0x0001F109: "8,",
+	0x0000248F: "8.",
+	0x000033E7: "8\u65e5",
+	0x000032C7: "8\u6708",
+	0x00003360: "8\u70b9",
+	0x00000A67: "9",
+	0x00000B68: "9",
+	0x000009ED: "9",
+	0x00000D6D: "9",
+	0x0001D7D7: "9",
+	0x0001D7E1: "9",
+	0x0001D7EB: "9",
+	0x0001D7F5: "9",
+	0x0001D7FF: "9",
+	0x0001FBF9: "9",
+	0x0000A76E: "9",
+	0x00002CCA: "9",
+	0x000118CC: "9",
+	0x000118AC: "9",
+	0x000118D6: "9",
+	0x00000967: "\u0669",
+	0x000118E4: "\u0669",
+	0x000006F9: "\u0669",
+	0x00000CEF: "\u0c6f",
+	0x00002468: "\u2788",
+	0x0001F10A: "9,",
+	0x00002490: "9.",
+	0x000033E8: "9\u65e5",
+	0x000032C8: "9\u6708",
+	0x00003361: "9\u70b9",
+	0x0000237A: "a",
+	0x0000FF41: "a",
+	0x0001D41A: "a",
+	0x0001D44E: "a",
+	0x0001D482: "a",
+	0x0001D4B6: "a",
+	0x0001D4EA: "a",
+	0x0001D51E: "a",
+	0x0001D552: "a",
+	0x0001D586: "a",
+	0x0001D5BA: "a",
+	0x0001D5EE: "a",
+	0x0001D622: "a",
+	0x0001D656: "a",
+	0x0001D68A: "a",
+	0x00000251: "a",
+	0x000003B1: "a",
+	0x0001D6C2: "a",
+	0x0001D6FC: "a",
+	0x0001D736: "a",
+	0x0001D770: "a",
+	0x0001D7AA: "a",
+	0x00000430: "a",
+	0x00002DF6: "\u0363",
+	0x0000FF21: "A",
+	0x0001D400: "A",
+	0x0001D434: "A",
+	0x0001D468: "A",
+	0x0001D49C: "A",
+	0x0001D4D0: "A",
+	0x0001D504: "A",
+	0x0001D538: "A",
+	0x0001D56C: "A",
+	0x0001D5A0: "A",
+	0x0001D5D4: "A",
+	0x0001D608: "A",
+	0x0001D63C: "A",
+	0x0001D670: "A",
+	0x00000391: "A",
+	0x0001D6A8: "A",
+	0x0001D6E2: "A",
+	0x0001D71C: "A",
+	0x0001D756: "A",
+	0x0001D790: "A",
+	0x00000410: "A",
+	0x000013AA: "A",
+	0x000015C5: "A",
+	0x0000A4EE: "A",
+	0x00016F40: "A",
+	0x000102A0: "A",
+	0x00002376: "a\u0332",
+	0x000001CE: "\u0103",
+	0x000001CD: "\u0102",
+	0x00000227: "\u00e5",
+	0x00000226: "\u00c5",
+	0x00001E9A: "\u1ea3",
+	0x00002100: "a/c",
+	0x00002101: "a/s",
+	0x0000A733: "aa",
+	0x0000A732: "AA",
+	0x000000E6: "ae",
+	0x000004D5: "ae",
+	0x000000C6: "AE",
+	0x000004D4: "AE",
+	0x0000A735: "ao",
+	0x0000A734: "AO",
+	0x0001F707: "AR",
+	0x0000A737: "au",
+	0x0000A736: "AU",
+	0x0000A739: "av",
+	0x0000A73B: "av",
+	0x0000A738: "AV",
+	0x0000A73A: "AV",
+	0x0000A73D: "ay",
+	0x0000A73C: "AY",
+	0x0000AB7A: "\u1d00",
+	0x00002200: "\u2c6f",
+	0x0001D217: "\u2c6f",
+	0x000015C4: "\u2c6f",
+	0x0000A4EF: "\u2c6f",
+	0x0001041F: "\u2c70",
+	0x0001D41B: "b",
+	0x0001D44F: "b",
+	0x0001D483: "b",
+	0x0001D4B7: "b",
+	0x0001D4EB: "b",
+	0x0001D51F: "b",
+	0x0001D553: "b",
+	0x0001D587: "b",
+	0x0001D5BB: "b",
+	0x0001D5EF: "b",
+	0x0001D623: "b",
+	0x0001D657: "b",
+	0x0001D68B: "b",
+	0x00000184: "b",
+	0x0000042C: "b",
+	0x000013CF: "b",
+	0x00001472: "b",
+	0x000015AF: "b",
+	0x0000FF22: "B",
+	0x0000212C: "B",
+	0x0001D401: "B",
+	0x0001D435: "B",
+	0x0001D469: "B",
+	0x0001D4D1: "B",
+	0x0001D505: "B",
+	0x0001D539: "B",
+	0x0001D56D: "B",
+	0x0001D5A1: "B",
+	0x0001D5D5: "B",
+	0x0001D609: "B",
+	0x0001D63D: "B",
+	0x0001D671: "B",
+	0x0000A7B4: "B",
+	0x00000392: "B",
+	0x0001D6A9: "B",
+	0x0001D6E3: "B",
+	0x0001D71D: "B",
+	0x0001D757: "B",
+	0x0001D791: "B",
+	0x00000412: "B",
+	0x000013F4: "B",
+	0x000015F7: "B",
+	0x0000A4D0: "B",
+	0x00010282: "B",
+	0x000102A1: "B",
+	0x00010301: "B",
+	0x00000253: "b\u0314",
+	0x00001473: "b\u0307",
+	0x00000183: "b\u0304",
+	0x00000182: "b\u0304",
+	0x00000411: "b\u0304",
+	0x00000180: "b\u0335",
+	0x0000048D: "b\u0335",
+	0x0000048C: "b\u0335",
+	0x00000463: "b\u0335",
+	0x00000462: "b\u0335",
+	0x0000147F: "b\u00b7",
+	0x00001481: "b\u0307\u00b7",
+	0x00001488: "b'",
+	0x0000042B: "bl",
+	0x00000432: "\u0299",
+	0x000013FC: "\u0299",
+	0x0000FF43: "c",
+	0x0000217D: "c",
+	0x0001D41C: "c",
+	0x0001D450: "c",
+	0x0001D484: "c",
+	0x0001D4B8: "c",
+	0x0001D4EC: "c",
+	0x0001D520: "c",
+	0x0001D554: "c",
+	0x0001D588: "c",
+	0x0001D5BC: "c",
+	0x0001D5F0: "c",
+	0x0001D624: "c",
+	0x0001D658: "c",
+	0x0001D68C: "c",
+	0x00001D04: "c",
+	0x000003F2: "c",
+	0x00002CA5: "c",
+	0x00000441: "c",
+	0x0000ABAF: "c",
+	0x0001043D: "c",
+	0x00002DED: "\u0368",
+	0x0001F74C: "C",
+	0x000118F2: "C",
+	0x000118E9: "C",
+	0x0000FF23: "C",
+	0x0000216D: "C",
+	0x00002102: "C",
+	0x0000212D: "C",
+	0x0001D402: "C",
+	0x0001D436: "C",
+	0x0001D46A: "C",
+	0x0001D49E: "C",
+	0x0001D4D2: "C",
+	0x0001D56E: "C",
+	0x0001D5A2: "C",
+	0x0001D5D6: "C",
+	0x0001D60A: "C",
+	0x0001D63E: "C",
+	0x0001D672: "C",
+	0x000003F9: "C",
+	0x00002CA4: "C",
+	0x00000421: "C",
+	0x000013DF: "C",
+	0x0000A4DA: "C",
+	0x000102A2: "C",
+	0x00010302: "C",
+	0x00010415: "C",
+	0x0001051C: "C",
+	0x000000A2: "c\u0338",
+	0x0000023C: "c\u0338",
+	0x000020A1: "C\u20eb",
+	0x0001F16E: "C\u20e0",
+	0x000000E7: "c\u0326",
+	0x000004AB: "c\u0326",
+	0x000000C7: "C\u0326",
+	0x000004AA: "C\u0326",
+	0x00000187: "C'",
+	0x00002105: "c/o",
+	0x00002106: "c/u",
+	0x0001F16D: "\u33c4\t\u20dd",
+	0x000022F4: "\ua793",
+	0x0000025B: "\ua793",
+	0x000003B5: "\ua793",
+	0x000003F5: "\ua793",
+	0x0001D6C6: "\ua793",
+	0x0001D6DC: "\ua793",
+	0x0001D700: "\ua793",
+	0x0001D716: "\ua793",
+	0x0001D73A: "\ua793",
+	0x0001D750: "\ua793",
+	0x0001D774: "\ua793",
+	0x0001D78A: "\ua793",
+	0x0001D7AE: "\ua793",
+	0x0001D7C4: "\ua793",
+	0x00002C89: "\ua793",
+	0x00000454: "\ua793",
+	0x00000511: "\ua793",
+	0x0000AB9B: "\ua793",
+	0x000118CE: "\ua793",
+	0x00010429: "\ua793",
+	0x000020AC: "\ua792",
+	0x00002C88: "\ua792",
+	0x00000404: "\ua792",
+	0x00002377: "\ua793\u0332",
+	0x0000037D: "\ua73f",
+	0x000003FF: "\ua73e",
+	0x0000217E: "d",
+	0x00002146: "d",
+	0x0001D41D: "d",
+	0x0001D451: "d",
+	0x0001D485: "d",
+	0x0001D4B9: "d",
+	0x0001D4ED: "d",
+	0x0001D521: "d",
+	0x0001D555: "d",
+	0x0001D589: "d",
+	0x0001D5BD: "d",
+	0x0001D5F1: "d",
+	0x0001D625: "d",
+	0x0001D659: "d",
+	0x0001D68D: "d",
+	0x00000501: "d",
+	0x000013E7: "d",
+	0x0000146F: "d",
+	0x0000A4D2: "d",
+	0x0000216E: "D",
+	0x00002145: "D",
+	0x0001D403: "D",
+	0x0001D437: "D",
+	0x0001D46B: "D",
+	0x0001D49F: "D",
+	0x0001D4D3: "D",
+	0x0001D507: "D",
+	0x0001D53B: "D",
+	0x0001D56F: "D",
+	0x0001D5A3: "D",
+	0x0001D5D7: "D",
+	0x0001D60B: "D",
+	0x0001D63F: "D",
+	0x0001D673: "D",
+	0x000013A0: "D",
+	0x000015DE: "D",
+	0x000015EA: "D",
+	0x0000A4D3: "D",
+	0x00000257: "d\u0314",
+	0x00000256: "d\u0328",
+	0x0000018C: "d\u0304",
+	0x00000111: "d\u0335",
+	0x00000110: "D\u0335",
+	0x000000D0: "D\u0335",
+	0x00000189: "D\u0335",
+	0x000020AB: "d\u0335\u0331",
+	0x0000A77A: "\ua779",
+	0x0000147B: "d\u00b7",
+	0x00001487: "d'",
+	0x000002A4: "d\u021d",
+	0x000001F3: "dz",
+	0x000002A3: "dz",
+	0x000001F2: "Dz",
+	0x000001F1: "DZ",
+	0x000001C6: "d\u017e",
+	0x000001C5: "D\u017e",
+	0x000001C4: "D\u017d",
+	0x000002A5: "d\u0291",
+	0x0000AB70: "\u1d05",
+	0x00002E39: "\u1e9f",
+	0x000003B4: "\u1e9f",
+	0x0001D6C5: "\u1e9f",
+	0x0001D6FF: "\u1e9f",
+	0x0001D739: "\u1e9f",
+	0x0001D773: "\u1e9f",
+	0x0001D7AD: "\u1e9f",
+	0x0000056E: "\u1e9f",
+	0x00001577: "\u1e9f",
+	0x0000212E: "e",
+	0x0000FF45: "e",
+	0x0000212F: "e",
+	0x00002147: "e",
+	0x0001D41E: "e",
+	0x0001D452: "e",
+	0x0001D486: "e",
+	0x0001D4EE: "e",
+	0x0001D522: "e",
+	0x0001D556: "e",
+	0x0001D58A: "e",
+	0x0001D5BE: "e",
+	0x0001D5F2: "e",
+	0x0001D626: "e",
+	0x0001D65A: "e",
+	0x0001D68E: "e",
+	0x0000AB32: "e",
+	0x00000435: "e",
+	0x000004BD: "e",
+	0x00002DF7: "\u0364",
+	0x000022FF: "E",
+	0x0000FF25: "E",
+	0x00002130: "E",
+	0x0001D404: "E",
+	0x0001D438: "E",
+	0x0001D46C: "E",
+	0x0001D4D4: "E",
+	0x0001D508: "E",
+	0x0001D53C: "E",
+	0x0001D570: "E",
+	0x0001D5A4: "E",
+	0x0001D5D8: "E",
+	0x0001D60C: "E",
+	0x0001D640: "E",
+	0x0001D674: "E",
+	0x00000395: "E",
+	0x0001D6AC: "E",
+	0x0001D6E6: "E",
+	0x0001D720: "E",
+	0x0001D75A: "E",
+	0x0001D794: "E",
+	0x00000415: "E",
+	0x00002D39: "E",
+	0x000013AC: "E",
+	0x0000A4F0: "E",
+	0x000118A6: "E",
+	0x000118AE: "E",
+	0x00010286: "E",
+	0x0000011B: "\u0115",
+	0x0000011A: "\u0114",
+	0x00000247: "e\u0338",
+	0x00000246: "E\u0338",
+	0x000004BF: "e\u0328",
+	0x0000AB7C: "\u1d07",
+	0x00000259: "\u01dd",
+	0x000004D9: "\u01dd",
+	0x00002203: "\u018e",
+	0x00002D3A: "\u018e",
+	0x0000A4F1: "\u018e",
+	0x0000025A: "\u01dd\u02de",
+	0x00001D14: "\u01ddo",
+	0x0000AB41: "\u01ddo\u0338",
+	0x0000AB42: "\u01ddo\u0335",
+	0x000004D8: "\u018f",
+	0x0001D221: "\u0190",
+	0x00002107: "\u0190",
+	0x00000510: "\u0190",
+	0x000013CB: "\u0190",
+	0x00016F2D: "\u0190",
+	0x00010401: "\u0190",
+	0x00001D9F: "\u1d4b",
+	0x00001D08: "\u025c",
+	0x00000437: "\u025c",
+	0x00000499: "\u025c\u0326",
+	0x00010442: "\u025e",
+	0x0000A79D: "\u029a",
+	0x0001042A: "\u029a",
+	0x0001D41F: "f",
+	0x0001D453: "f",
+	0x0001D487: "f",
+	0x0001D4BB: "f",
+	0x0001D4EF: "f",
+	0x0001D523: "f",
+	0x0001D557: "f",
+	0x0001D58B: "f",
+	0x0001D5BF: "f",
+	0x0001D5F3: "f",
+	0x0001D627: "f",
+	0x0001D65B: "f",
+	0x0001D68F: "f",
+	0x0000AB35: "f",
+	0x0000A799: "f",
+	0x0000017F: "f",
+	0x00001E9D: "f",
+	0x00000584: "f",
+	0x0001D213: "F",
+	0x00002131: "F",
+	0x0001D405: "F",
+	0x0001D439: "F",
+	0x0001D46D: "F",
+	0x0001D4D5: "F",
+	0x0001D509: "F",
+	0x0001D53D: "F",
+	0x0001D571: "F",
+	0x0001D5A5: "F",
+	0x0001D5D9: "F",
+	0x0001D60D: "F",
+	0x0001D641: "F",
+	0x0001D675: "F",
+	0x0000A798: "F",
+	0x000003DC: "F",
+	0x0001D7CA: "F",
+	0x000015B4: "F",
+	0x0000A4DD: "F",
+	0x000118C2: "F",
+	0x000118A2: "F",
+	0x00010287: "F",
+	0x000102A5: "F",
+	0x00010525: "F",
+	0x00000192: "f\u0326",
+	0x00000191: "F\u0326",
+	0x00001D6E: "f\u0334",
+	0x0000213B: "FAX",
+	0x0000FB00: "ff",
+	0x0000FB03: "ffi",
+	0x0000FB04: "ffl",
+	0x0000FB01: "fi",
+	0x0000FB02: "fl",
+	0x000002A9: "f\u014b",
+	0x000015B5: "\u2132",
+	0x0000A4DE: "\u2132",
+	0x0001D230: "\ua7fb",
+	0x000015B7: "\ua7fb",
+	0x0000FF47: "g",
+	0x0000210A: "g",
+	0x0001D420: "g",
+	0x0001D454: "g",
+	0x0001D488: "g",
+	0x0001D4F0: "g",
+	0x0001D524: "g",
+	0x0001D558: "g",
+	0x0001D58C: "g",
+	0x0001D5C0: "g",
+	0x0001D5F4: "g",
+	0x0001D628: "g",
+	0x0001D65C: "g",
+	0x0001D690: "g",
+	0x00000261: "g",
+	0x00001D83: "g",
+	0x0000018D: "g",
+	0x00000581: "g",
+	0x0001D406: "G",
+	0x0001D43A: "G",
+	0x0001D46E: "G",
+	0x0001D4A2: "G",
+	0x0001D4D6: "G",
+	0x0001D50A: "G",
+	0x0001D53E: "G",
+	0x0001D572: "G",
+	0x0001D5A6: "G",
+	0x0001D5DA: "G",
+	0x0001D60E: "G",
+	0x0001D642: "G",
+	0x0001D676: "G",
+	0x0000050C: "G",
+	0x000013C0: "G",
+	0x000013F3: "G",
+	0x0000A4D6: "G",
+	0x00001DA2: "\u1d4d",
+	0x00000260: "g\u0314",
+	0x000001E7: "\u011f",
+	0x000001E6: "\u011e",
+	0x000001F5: "\u0123",
+	0x000001E5: "g\u0335",
+	0x000001E4: "G\u0335",
+	0x00000193: "G'",
+	0x0000050D: "\u0262",
+	0x0000AB90: "\u0262",
+	0x000013FB: "\u0262",
+	0x0000FF48: "h",
+	0x0000210E: "h",
+	0x0001D421: "h",
+	0x0001D489: "h",
+	0x0001D4BD: "h",
+	0x0001D4F1: "h",
+	0x0001D525: "h",
+	0x0001D559: "h",
+	0x0001D58D: "h",
+	0x0001D5C1: "h",
+	0x0001D5F5: "h",
+	0x0001D629: "h",
+	0x0001D65D: "h",
+	0x0001D691: "h",
+	0x000004BB: "h",
+	0x00000570: "h",
+	0x000013C2: "h",
+	0x0000FF28: "H",
+	0x0000210B: "H",
+	0x0000210C: "H",
+	0x0000210D: "H",
+	0x0001D407: "H",
+	0x0001D43B: "H",
+	0x0001D46F: "H",
+	0x0001D4D7: "H",
+	0x0001D573: "H",
+	0x0001D5A7: "H",
+	0x0001D5DB: "H",
+	0x0001D60F: "H",
+	0x0001D643: "H",
+	0x0001D677: "H",
+	0x00000397: "H",
+	0x0001D6AE: "H",
+	0x0001D6E8: "H",
+	0x0001D722: "H",
+	0x0001D75C: "H",
+	0x0001D796: "H",
+	0x00002C8E: "H",
+	0x0000041D: "H",
+	0x000013BB: "H",
+	0x0000157C: "H",
+	0x0000A4E7: "H",
+	0x000102CF: "H",
+	0x00001D78: "\u1d34",
+	0x00000266: "h\u0314",
+	0x0000A695: "h\u0314",
+	0x000013F2: "h\u0314",
+	0x00002C67: "H\u0329",
+	0x000004A2: "H\u0329",
+	0x00000127: "h\u0335",
+	0x0000210F: "h\u0335",
+	0x0000045B: "h\u0335",
+	0x00000126: "H\u0335",
+	0x000004C9: "H\u0326",
+	0x000004C7: "H\u0326",
+	0x0000043D: "\u029c",
+	0x0000AB8B: "\u029c",
+	0x000004A3: "\u029c\u0329",
+	0x000004CA: "\u029c\u0326",
+	0x000004C8: "\u029c\u0326",
+	0x0000050A: "\u01f6",
+	0x0000AB80: "\u2c76",
+	0x00000370: "\u2c75",
+	0x000013A8: "\u2c75",
+	0x000013B0: "\u2c75",
+	0x0000A6B1: "\u2c75",
+	0x0000A795: "\ua727",
+	0x000002DB: "i",
+	0x00002373: "i",
+	0x0000FF49: "i",
+	0x00002170: "i",
+	0x00002139: "i",
+	0x00002148: "i",
+	0x0001D422: "i",
+	0x0001D456: "i",
+	0x0001D48A: "i",
+	0x0001D4BE: "i",
+	0x0001D4F2: "i",
+	0x0001D526: "i",
+	0x0001D55A: "i",
+	0x0001D58E: "i",
+	0x0001D5C2: "i",
+	0x0001D5F6: "i",
+	0x0001D62A: "i",
+	0x0001D65E: "i",
+	0x0001D692: "i",
+	0x00000131: "i",
+	0x0001D6A4: "i",
+	0x0000026A: "i",
+	0x00000269: "i",
+	0x000003B9: "i",
+	0x00001FBE: "i",
+	0x0000037A: "i",
+	0x0001D6CA: "i",
+	0x0001D704: "i",
+	0x0001D73E: "i",
+	0x0001D778: "i",
+	0x0001D7B2: "i",
+	0x00000456: "i",
+	0x0000A647: "i",
+	0x000004CF: "i",
+	0x0000AB75: "i",
+	0x000013A5: "i",
+	0x000118C3: "i",
+	0x000024DB: "\u24be",
+	0x00002378: "i\u0332",
+	0x000001D0: "\u012d",
+	0x000001CF: "\u012c",
+	0x00000268: "i\u0335",
+	0x00001D7B: "i\u0335",
+	0x00001D7C: "i\u0335",
+	0x00002171: "ii",
+	0x00002172: "iii",
+	0x00000133: "ij",
+	0x00002173: "iv",
+	0x00002178: "ix",
+	0x0000FF4A: "j",
+	0x00002149: "j",
+	0x0001D423: "j",
+	0x0001D457: "j",
+	0x0001D48B: "j",
+	0x0001D4BF: "j",
+	0x0001D4F3: "j",
+	0x0001D527: "j",
+	0x0001D55B: "j",
+	0x0001D58F: "j",
+	0x0001D5C3: "j",
+	0x0001D5F7: "j",
+	0x0001D62B: "j",
+	0x0001D65F: "j",
+	0x0001D693: "j",
+	0x000003F3: "j",
+	0x00000458: "j",
+	0x0000FF2A: "J",
+	0x0001D409: "J",
+	0x0001D43D: "J",
+	0x0001D471: "J",
+	0x0001D4A5: "J",
+	0x0001D4D9: "J",
+	0x0001D50D: "J",
+	0x0001D541: "J",
+	0x0001D575: "J",
+	0x0001D5A9: "J",
+	0x0001D5DD: "J",
+	0x0001D611: "J",
+	0x0001D645: "J",
+	0x0001D679: "J",
+	0x0000A7B2: "J",
+	0x0000037F: "J",
+	0x00000408: "J",
+	0x000013AB: "J",
+	0x0000148D: "J",
+	0x0000A4D9: "J",
+	0x00000249: "j\u0335",
+	0x00000248: "J\u0335",
+	0x00001499: "J\u00b7",
+	0x0001D6A5: "\u0237",
+	0x00000575: "\u0237",
+	0x0000AB7B: "\u1d0a",
+	0x0001D424: "k",
+	0x0001D458: "k",
+	0x0001D48C: "k",
+	0x0001D4C0: "k",
+	0x0001D4F4: "k",
+	0x0001D528: "k",
+	0x0001D55C: "k",
+	0x0001D590: "k",
+	0x0001D5C4: "k",
+	0x0001D5F8: "k",
+	0x0001D62C: "k",
+	0x0001D660: "k",
+	0x0001D694: "k",
+	0x0000212A: "K",
+	0x0000FF2B: "K",
+	0x0001D40A: "K",
+	0x0001D43E: "K",
+	0x0001D472: "K",
+	0x0001D4A6: "K",
+	0x0001D4DA: "K",
+	0x0001D50E: "K",
+	0x0001D542: "K",
+	0x0001D576: "K",
+	0x0001D5AA: "K",
+	0x0001D5DE: "K",
+	0x0001D612: "K",
+	0x0001D646: "K",
+	0x0001D67A: "K",
+	0x0000039A: "K",
+	0x0001D6B1: "K",
+	0x0001D6EB: "K",
+	0x0001D725: "K",
+	0x0001D75F: "K",
+	0x0001D799: "K",
+	0x00002C94: "K",
+	0x0000041A: "K",
+	0x000013E6: "K",
+	0x000016D5: "K",
+	0x0000A4D7: "K",
+	0x00010518: "K",
+	0x00000199: "k\u0314",
+	0x00002C69: "K\u0329",
+	0x0000049A: "K\u0329",
+	0x000020AD: "K\u0335",
+	0x0000A740: "K\u0335",
+	0x0000049E: "K\u0335",
+	0x00000198: "K'",
+	0x000005C0: "l",
+	0x0000007C: "l",
+	0x00002223: "l",
+	0x000023FD: "l",
+	0x0000FFE8: "l",
+	0x00000031: "l",
+	0x00000661: "l",
+	0x000006F1: "l",
+	0x00010320: "l",
+	0x0001E8C7: "l",
+	0x0001D7CF: "l",
+	0x0001D7D9: "l",
+	0x0001D7E3: "l",
+	0x0001D7ED: "l",
+	0x0001D7F7: "l",
+	0x0001FBF1: "l",
+	0x00000049: "l",
+	0x0000FF29: "l",
+	0x00002160: "l",
+	0x00002110: "l",
+	0x00002111: "l",
+	0x0001D408: "l",
+	0x0001D43C: "l",
+	0x0001D470: "l",
+	0x0001D4D8: "l",
+	0x0001D540: "l",
+	0x0001D574: "l",
+	0x0001D5A8: "l",
+	0x0001D5DC: "l",
+	0x0001D610: "l",
+	0x0001D644: "l",
+	0x0001D678: "l",
+	0x00000196: "l",
+	0x0000FF4C: "l",
+	0x0000217C: "l",
+	0x00002113: "l",
+	0x0001D425: "l",
+	0x0001D459: "l",
+	0x0001D48D: "l",
+	0x0001D4C1: "l",
+	0x0001D4F5: "l",
+	0x0001D529: "l",
+	0x0001D55D: "l",
+	0x0001D591: "l",
+	0x0001D5C5: "l",
+	0x0001D5F9: "l",
+	0x0001D62D: "l",
+	0x0001D661: "l",
+	0x0001D695: "l",
+	0x000001C0: "l",
+	0x00000399: "l",
+	0x0001D6B0: "l",
+	0x0001D6EA: "l",
+	0x0001D724: "l",
+	0x0001D75E: "l",
+	0x0001D798: "l",
+	0x00002C92: "l",
+	0x00000406: "l",
+	0x000004C0: "l",
+	0x000005D5: "l",
+	0x000005DF: "l",
+	0x00000627: "l",
+	0x0001EE00: "l",
+	0x0001EE80: "l",
+	0x0000FE8E: "l",
+	0x0000FE8D: "l",
+	0x000007CA: "l",
+	0x00002D4F: "l",
+	0x000016C1: "l",
+	0x0000A4F2: "l",
+	0x00016F28: "l",
+	0x0001028A: "l",
+	0x00010309: "l",
+	0x0001D22A: "L",
+	0x0000216C: "L",
+	0x00002112: "L",
+	0x0001D40B: "L",
+	0x0001D43F: "L",
+	0x0001D473: "L",
+	0x0001D4DB: "L",
+	0x0001D50F: "L",
+	0x0001D543: "L",
+	0x0001D577: "L",
+	0x0001D5AB: "L",
+	0x0001D5DF: "L",
+	0x0001D613: "L",
+	0x0001D647: "L",
+	0x0001D67B: "L",
+	0x00002CD0: "L",
+	0x000013DE: "L",
+	0x000014AA: "L",
+	0x0000A4E1: "L",
+	0x00016F16: "L",
+	0x000118A3: "L",
+	0x000118B2: "L",
+	0x0001041B: "L",
+	0x00010526: "L",
+	0x0000FD3C: "l\u030b",
+	0x0000FD3D: "l\u030b",
+	0x00000142: "l\u0338",
+	0x00000141: "L\u0338",
+	0x0000026D: "l\u0328",
+	0x00000197: "l\u0335",
+	0x0000019A: "l\u0335",
+	0x0000026B: "l\u0334",
+	0x00000625: "l\u0655",
+	0x0000FE88: "l\u0655",
+	0x0000FE87: "l\u0655",
+	0x00000673: "l\u0655",
+	0x00000140: "l\u00b7",
+	0x0000013F: "l\u00b7",
+	0x000014B7: "l\u00b7",
+	0x0001F102: "l,",
+	0x00002488: "l.",
+	0x000005F1: "l'",
+	0x00002493: "l2.",
+	0x000033EB: "l2\u65e5",
+	0x000032CB: "l2\u6708",
+	0x00003364: "l2\u70b9",
+	0x00002494: "l3.",
+	0x000033EC: "l3\u65e5",
+	0x00003365: "l3\u70b9",
+	0x00002495: "l4.",
+	0x000033ED: "l4\u65e5",
+	0x00003366: "l4\u70b9",
+	0x00002496: "l5.",
+	0x000033EE: "l5\u65e5",
+	0x00003367: "l5\u70b9",
+	0x00002497: "l6.",
+	0x000033EF: "l6\u65e5",
+	0x00003368: "l6\u70b9",
+	0x00002498: "l7.",
+	0x000033F0: "l7\u65e5",
+	0x00003369: "l7\u70b9",
+	0x00002499: "l8.",
+	0x000033F1: "l8\u65e5",
+	0x0000336A: "l8\u70b9",
+	0x0000249A: "l9.",
+	0x000033F2: "l9\u65e5",
+	0x0000336B: "l9\u70b9",
+	0x000001C9: "lj",
+	0x00000132: "lJ",
+	0x000001C8: "Lj",
+	0x000001C7: "LJ",
+	0x00002016: "ll",
+	0x00002225: "ll",
+	0x00002161: "ll",
+	0x000001C1: "ll",
+	0x000005F0: "ll",
+	0x00010199: "l\u0335l\u0335",
+	0x00002492: "ll.",
+	0x00002162: "lll",
+	0x00010198: "l\u0335l\u0335S\u0335",
+	0x000033EA: "ll\u65e5",
+	0x000032CA: "ll\u6708",
+	0x00003363: "ll\u70b9",
+	0x0000042E: "lO",
+	0x00002491: "lO.",
+	0x000033E9: "lO\u65e5",
+	0x000032C9: "lO\u6708",
+	0x00003362: "lO\u70b9",
+	0x000002AA: "ls",
+	0x000020B6: "lt",
+	0x00002163: "lV",
+	0x00002168: "lX",
+	0x0000026E: "l\u021d",
+	0x000002AB: "lz",
+	0x00000623: "l\u0674",
+	0x0000FE84: "l\u0674",
+	0x0000FE83: "l\u0674",
+	0x00000672: "l\u0674",
+	0x00000675: "l\u0674",
+	0x0000FDF3: "l\u0643\u0628\u0631",
+	0x0000FDF2: "l\u0644\u0644\u0651\u0670o",
+	0x000033E0: "l\u65e5",
+	0x000032C0: "l\u6708",
+	0x00003359: "l\u70b9",
+	0x00002CD1: "\u029f",
+	0x0000ABAE: "\u029f",
+	0x00010443: "\u029f",
+	0x0000FF2D: "M",
+	0x0000216F: "M",
+	0x00002133: "M",
+	0x0001D40C: "M",
+	0x0001D440: "M",
+	0x0001D474: "M",
+	0x0001D4DC: "M",
+	0x0001D510: "M",
+	0x0001D544: "M",
+	0x0001D578: "M",
+	0x0001D5AC: "M",
+	0x0001D5E0: "M",
+	0x0001D614: "M",
+	0x0001D648: "M",
+	0x0001D67C: "M",
+	0x0000039C: "M",
+	0x0001D6B3: "M",
+	0x0001D6ED: "M",
+	0x0001D727: "M",
+	0x0001D761: "M",
+	0x0001D79B: "M",
+	0x000003FA: "M",
+	0x00002C98: "M",
+	0x0000041C: "M",
+	0x000013B7: "M",
+	0x000015F0: "M",
+	0x000016D6: "M",
+	0x0000A4DF: "M",
+	0x000102B0: "M",
+	0x00010311: "M",
+	0x000004CD: "M\u0326",
+	0x0001F76B: "MB",
+	0x00002DE8: "\u1ddf",
+	0x0001D427: "n",
+	0x0001D45B: "n",
+	0x0001D48F: "n",
+	0x0001D4C3: "n",
+	0x0001D4F7: "n",
+	0x0001D52B: "n",
+	0x0001D55F: "n",
+	0x0001D593: "n",
+	0x0001D5C7: "n",
+	0x0001D5FB: "n",
+	0x0001D62F: "n",
+	0x0001D663: "n",
+	0x0001D697: "n",
+	0x00000578: "n",
+	0x0000057C: "n",
+	0x0000FF2E: "N",
+	0x00002115: "N",
+	0x0001D40D: "N",
+	0x0001D441: "N",
+	0x0001D475: "N",
+	0x0001D4A9: "N",
+	0x0001D4DD: "N",
+	0x0001D511: "N",
+	0x0001D579: "N",
+	0x0001D5AD: "N",
+	0x0001D5E1: "N",
+	0x0001D615: "N",
+	0x0001D649: "N",
+	0x0001D67D: "N",
+	0x0000039D: "N",
+	0x0001D6B4: "N",
+	0x0001D6EE: "N",
+	0x0001D728: "N",
+	0x0001D762: "N",
+	0x0001D79C: "N",
+	0x00002C9A: "N",
+	0x0000A4E0: "N",
+	0x00010513: "N",
+	0x0001018E: "N\u030a",
+	0x00000273: "n\u0328",
+	0x0000019E: "n\u0329",
+	0x000003B7: "n\u0329",
+	0x0001D6C8: "n\u0329",
+	0x0001D702: "n\u0329",
+	0x0001D73C: "n\u0329",
+	0x0001D776: "n\u0329",
+	0x0001D7B0: "n\u0329",
+	0x0000019D: "N\u0326",
+	0x00001D70: "n\u0334",
+	0x000001CC: "nj",
+	0x000001CB: "Nj",
+	0x000001CA: "NJ",
+	0x00002116: "No",
+	0x00000377: "\u1d0e",
+	0x00000438: "\u1d0e",
+	0x0001044D: "\u1d0e",
+	0x00000146: "\u0272",
+	0x00000C02: "o",
+	0x00000C82: "o",
+	0x00000D02: "o",
+	0x00000D82: "o",
+	0x00000966: "o",
+	0x00000A66: "o",
+	0x00000AE6: "o",
+	0x00000BE6: "o",
+	0x00000C66: "o",
+	0x00000CE6: "o",
+	0x00000D66: "o",
+	0x00000E50: "o",
+	0x00000ED0: "o",
+	0x00001040: "o",
+	0x00000665: "o",
+	0x000006F5: "o",
+	0x0000FF4F: "o",
+	0x00002134: "o",
+	0x0001D428: "o",
+	0x0001D45C: "o",
+	0x0001D490: "o",
+	0x0001D4F8: "o",
+	0x0001D52C: "o",
+	0x0001D560: "o",
+	0x0001D594: "o",
+	0x0001D5C8: "o",
+	0x0001D5FC: "o",
+	0x0001D630: "o",
+	0x0001D664: "o",
+	0x0001D698: "o",
+	0x00001D0F: "o",
+	0x00001D11: "o",
+	0x0000AB3D: "o",
+	0x000003BF: "o",
+	0x0001D6D0: "o",
+	0x0001D70A: "o",
+	0x0001D744: "o",
+	0x0001D77E: "o",
+	0x0001D7B8: "o",
+	0x000003C3: "o",
+	0x0001D6D4: "o",
+	0x0001D70E: "o",
+	0x0001D748: "o",
+	0x0001D782: "o",
+	0x0001D7BC: "o",
+	0x00002C9F: "o",
+	0x0000043E: "o",
+	0x000010FF: "o",
+	0x00000585: "o",
+	0x000005E1: "o",
+	0x00000647: "o",
+	0x0001EE24: "o",
+	0x0001EE64: "o",
+	0x0001EE84: "o",
+	0x0000FEEB: "o",
+	0x0000FEEC: "o",
+	0x0000FEEA: "o",
+	0x0000FEE9: "o",
+	0x000006BE: "o",
+	0x0000FBAC: "o",
+	0x0000FBAD: "o",
+	0x0000FBAB: "o",
+	0x0000FBAA: "o",
+	0x000006C1: "o",
+	0x0000FBA8: "o",
+	0x0000FBA9: "o",
+	0x0000FBA7: "o",
+	0x0000FBA6: "o",
+	0x000006D5: "o",
+	0x00000D20: "o",
+	0x0000101D: "o",
+	0x000104EA: "o",
+	0x000118C8: "o",
+	0x000118D7: "o",
+	0x0001042C: "o",
+	0x00000030: "O",
+	0x000007C0: "O",
+	0x000009E6: "O",
+	0x00000B66: "O",
+	0x00003007: "O",
+	0x000114D0: "O",
+	0x000118E0: "O",
+	0x0001D7CE: "O",
+	0x0001D7D8: "O",
+	0x0001D7E2: "O",
+	0x0001D7EC: "O",
+	0x0001D7F6: "O",
+	0x0001FBF0: "O",
+	0x0000FF2F: "O",
+	0x0001D40E: "O",
+	0x0001D442: "O",
+	0x0001D476: "O",
+	0x0001D4AA: "O",
+	0x0001D4DE: "O",
+	0x0001D512: "O",
+	0x0001D546: "O",
+	0x0001D57A: "O",
+	0x0001D5AE: "O",
+	0x0001D5E2: "O",
+	0x0001D616: "O",
+	0x0001D64A: "O",
+	0x0001D67E: "O",
+	0x0000039F: "O",
+	0x0001D6B6: "O",
+	0x0001D6F0: "O",
+	0x0001D72A: "O",
+	0x0001D764: "O",
+	0x0001D79E: "O",
+	0x00002C9E: "O",
+	0x0000041E: "O",
+	0x00000555: "O",
+	0x00002D54: "O",
+	0x000012D0: "O",
+	0x00000B20: "O",
+	0x000104C2: "O",
+	0x0000A4F3: "O",
+	0x000118B5: "O",
+	0x00010292: "O",
+	0x000102AB: "O",
+	0x00010404: "O",
+	0x00010516: "O",
+	0x00002070: "\u00ba",
+	0x00001D52: "\u00ba",
+	0x000001D2: "\u014f",
+	0x000001D1: "\u014e",
+	0x000006FF: "o\u0302",
+	0x00000150: "\u00d6",
+	0x000000F8: "o\u0338",
+	0x0000AB3E: "o\u0338",
+	0x000000D8: "O\u0338",
+	0x00002D41: "O\u0338",
+	0x000001FE: "O\u0338\u0301",
+	0x00000275: "o\u0335",
+	0x0000A74B: "o\u0335",
+	0x000004E9: "o\u0335",
+	0x00000473: "o\u0335",
+	0x0000AB8E: "o\u0335",
+	0x0000ABBB: "o\u0335",
+	0x00002296: "O\u0335",
+	0x0000229D: "O\u0335",
+	0x0000236C: "O\u0335",
+	0x0001D21A: "O\u0335",
+	0x0001F714: "O\u0335",
+	0x0000019F: "O\u0335",
+	0x0000A74A: "O\u0335",
+	0x000003B8: "O\u0335",
+	0x000003D1: "O\u0335",
+	0x0001D6C9: "O\u0335",
+	0x0001D6DD: "O\u0335",
+	0x0001D703: "O\u0335",
+	0x0001D717: "O\u0335",
+	0x0001D73D: "O\u0335",
+	0x0001D751: "O\u0335",
+	0x0001D777: "O\u0335",
+	0x0001D78B: "O\u0335",
+	0x0001D7B1: "O\u0335",
+	0x0001D7C5: "O\u0335",
+	0x00000398: "O\u0335",
+	0x000003F4: "O\u0335",
+	0x0001D6AF: "O\u0335",
+	0x0001D6B9: "O\u0335",
+	0x0001D6E9: "O\u0335",
+	0x0001D6F3: "O\u0335",
+	0x0001D723: "O\u0335",
+	0x0001D72D: "O\u0335",
+	0x0001D75D: "O\u0335",
+	0x0001D767: "O\u0335",
+	0x0001D797: "O\u0335",
+	0x0001D7A1: "O\u0335",
+	0x000004E8: "O\u0335",
+	0x00000472: "O\u0335",
+	0x00002D31: "O\u0335",
+	0x000013BE: "O\u0335",
+	0x000013EB: "O\u0335",
+	0x0000AB74: "o\u031b",
+	0x0000FCD9: "o\u0670",
+	0x0001F101: "O,",
+	0x0001F100: "O.",
+	0x000001A1: "o'",
+	0x000001A0: "O'",
+	0x000013A4: "O'",
+	0x00000025: "\u00ba/\u2080",
+	0x0000066A: "\u00ba/\u2080",
+	0x00002052: "\u00ba/\u2080",
+	0x00002030: "\u00ba/\u2080\u2080",
+	0x00000609: "\u00ba/\u2080\u2080",
+	0x00002031: "\u00ba/\u2080\u2080\u2080",
+	0x0000060A: "\u00ba/\u2080\u2080\u2080",
+	0x00000153: "oe",
+	0x00000152: "OE",
+	0x00000276: "o\u1d07",
+	0x0000221E: "oo",
+	0x0000A74F: "oo",
+	0x0000A699: "oo",
+	0x0000A74E: "OO",
+	0x0000A698: "OO",
+	0x0000FCD7: "o\u062c",
+	0x0000FC51: "o\u062c",
+	0x0000FCD8: "o\u0645",
+	0x0000FC52: "o\u0645",
+	0x0000FD93: "o\u0645\u062c",
+	0x0000FD94: "o\u0645\u0645",
+	0x0000FC53: "o\u0649",
+	0x0000FC54: "o\u0649",
+	0x00000D5F: "o\u0d30o",
+	0x00001010: "o\u102c",
+	0x00003358: "O\u70b9",
+	0x00002184: "\u0254",
+	0x00001D10: "\u0254",
+	0x0000037B: "\u0254",
+	0x0001044B: "\u0254",
+	0x00002183: "\u0186",
+	0x000003FD: "\u0186",
+	0x0000A4DB: "\u0186",
+	0x00010423: "\u0186",
+	0x0000AB3F: "\u0254\u0338",
+	0x0000AB62: "\u0254e",
+	0x0001043F: "\u0277",
+	0x00002374: "p",
+	0x0000FF50: "p",
+	0x0001D429: "p",
+	0x0001D45D: "p",
+	0x0001D491: "p",
+	0x0001D4C5: "p",
+	0x0001D4F9: "p",
+	0x0001D52D: "p",
+	0x0001D561: "p",
+	0x0001D595: "p",
+	0x0001D5C9: "p",
+	0x0001D5FD: "p",
+	0x0001D631: "p",
+	0x0001D665: "p",
+	0x0001D699: "p",
+	0x000003C1: "p",
+	0x000003F1: "p",
+	0x0001D6D2: "p",
+	0x0001D6E0: "p",
+	0x0001D70C: "p",
+	0x0001D71A: "p",
+	0x0001D746: "p",
+	0x0001D754: "p",
+	0x0001D780: "p",
+	0x0001D78E: "p",
+	0x0001D7BA: "p",
+	0x0001D7C8: "p",
+	0x00002CA3: "p",
+	0x00000440: "p",
+	0x0000FF30: "P",
+	0x00002119: "P",
+	0x0001D40F: "P",
+	0x0001D443: "P",
+	0x0001D477: "P",
+	0x0001D4AB: "P",
+	0x0001D4DF: "P",
+	0x0001D513: "P",
+	0x0001D57B: "P",
+	0x0001D5AF: "P",
+	0x0001D5E3: "P",
+	0x0001D617: "P",
+	0x0001D64B: "P",
+	0x0001D67F: "P",
+	0x000003A1: "P",
+	0x0001D6B8: "P",
+	0x0001D6F2: "P",
+	0x0001D72C: "P",
+	0x0001D766: "P",
+	0x0001D7A0: "P",
+	0x00002CA2: "P",
+	0x00000420: "P",
+	0x000013E2: "P",
+	0x0000146D: "P",
+	0x0000A4D1: "P",
+	0x00010295: "P",
+	0x000001A5: "p\u0314",
+	0x00001D7D: "p\u0335",
+	0x00001477: "p\u00b7",
+	0x00001486: "P'",
+	0x00001D29: "\u1d18",
+	0x0000ABB2: "\u1d18",
+	0x000003C6: "\u0278",
+	0x000003D5: "\u0278",
+	0x0001D6D7: "\u0278",
+	0x0001D6DF: "\u0278",
+	0x0001D711: "\u0278",
+	0x0001D719: "\u0278",
+	0x0001D74B: "\u0278",
+	0x0001D753: "\u0278",
+	0x0001D785: "\u0278",
+	0x0001D78D: "\u0278",
+	0x0001D7BF: "\u0278",
+	0x0001D7C7: "\u0278",
+	0x00002CAB: "\u0278",
+	0x00000444: "\u0278",
+	0x0001D42A: "q",
+	0x0001D45E: "q",
+	0x0001D492: "q",
+	0x0001D4C6: "q",
+	0x0001D4FA: "q",
+	0x0001D52E: "q",
+	0x0001D562: "q",
+	0x0001D596: "q",
+	0x0001D5CA: "q",
+	0x0001D5FE: "q",
+	0x0001D632: "q",
+	0x0001D666: "q",
+	0x0001D69A: "q",
+	0x0000051B: "q",
+	0x00000563: "q",
+	0x00000566: "q",
+	0x0000211A: "Q",
+	0x0001D410: "Q",
+	0x0001D444: "Q",
+	0x0001D478: "Q",
+	0x0001D4AC: "Q",
+	0x0001D4E0: "Q",
+	0x0001D514: "Q",
+	0x0001D57C: "Q",
+	0x0001D5B0: "Q",
+	0x0001D5E4: "Q",
+	0x0001D618: "Q",
+	0x0001D64C: "Q",
+	0x0001D680: "Q",
+	0x00002D55: "Q",
+	0x000002A0: "q\u0314",
+	0x0001F700: "QE",
+	0x00001D90: "\u024b",
+	0x00001D0B: "\u0138",
+	0x000003BA: "\u0138",
+	0x000003F0: "\u0138",
+	0x0001D6CB: "\u0138",
+	0x0001D6DE: "\u0138",
+	0x0001D705: "\u0138",
+	0x0001D718: "\u0138",
+	0x0001D73F: "\u0138",
+	0x0001D752: "\u0138",
+	0x0001D779: "\u0138",
+	0x0001D78C: "\u0138",
+	0x0001D7B3: "\u0138",
+	0x0001D7C6: "\u0138",
+	0x00002C95: "\u0138",
+	0x0000043A: "\u0138",
+	0x0000ABB6: "\u0138",
+	0x0000049B: "\u0138\u0329",
+	0x0000049F: "\u0138\u0335",
+	0x0001D42B: "r",
+	0x0001D45F: "r",
+	0x0001D493: "r",
+	0x0001D4C7: "r",
+	0x0001D4FB: "r",
+	0x0001D52F: "r",
+	0x0001D563: "r",
+	0x0001D597: "r",
+	0x0001D5CB: "r",
+	0x0001D5FF: "r",
+	0x0001D633: "r",
+	0x0001D667: "r",
+	0x0001D69B: "r",
+	0x0000AB47: "r",
+	0x0000AB48: "r",
+	0x00001D26: "r",
+	0x00002C85: "r",
+	0x00000433: "r",
+	0x0000AB81: "r",
+	0x0001D216: "R",
+	0x0000211B: "R",
+	0x0000211C: "R",
+	0x0000211D: "R",
+	0x0001D411: "R",
+	0x0001D445: "R",
+	0x0001D479: "R",
+	0x0001D4E1: "R",
+	0x0001D57D: "R",
+	0x0001D5B1: "R",
+	0x0001D5E5: "R",
+	0x0001D619: "R",
+	0x0001D64D: "R",
+	0x0001D681: "R",
+	0x000001A6: "R",
+	0x000013A1: "R",
+	0x000013D2: "R",
+	0x000104B4: "R",
+	0x00001587: "R",
+	0x0000A4E3: "R",
+	0x00016F35: "R",
+	0x0000027D: "r\u0328",
+	0x0000027C: "r\u0329",
+	0x0000024D: "r\u0335",
+	0x00000493: "r\u0335",
+	0x00001D72: "r\u0334",
+	0x00000491: "r'",
+	0x000118E3: "rn",
+	0x0000006D: "rn",
+	0x0000217F: "rn",
+	0x0001D426: "rn",
+	0x0001D45A: "rn",
+	0x0001D48E: "rn",
+	0x0001D4C2: "rn",
+	0x0001D4F6: "rn",
+	0x0001D52A: "rn",
+	0x0001D55E: "rn",
+	0x0001D592: "rn",
+	0x0001D5C6: "rn",
+	0x0001D5FA: "rn",
+	0x0001D62E: "rn",
+	0x0001D662: "rn",
+	0x0001D696: "rn",
+	0x00011700: "rn",
+	0x000020A5: "rn\u0338",
+	0x00000271: "rn\u0326",
+	0x00001D6F: "rn\u0334",
+	0x000020A8: "Rs",
+	0x0000AB71: "\u0280",
+	0x0000ABA2: "\u0280",
+	0x0000044F: "\u1d19",
+	0x00001D73: "\u027e\u0334",
+	0x00002129: "\u027f",
+	0x0000FF53: "s",
+	0x0001D42C: "s",
+	0x0001D460: "s",
+	0x0001D494: "s",
+	0x0001D4C8: "s",
+	0x0001D4FC: "s",
+	0x0001D530: "s",
+	0x0001D564: "s",
+	0x0001D598: "s",
+	0x0001D5CC: "s",
+	0x0001D600: "s",
+	0x0001D634: "s",
+	0x0001D668: "s",
+	0x0001D69C: "s",
+	0x0000A731: "s",
+	0x000001BD: "s",
+	0x00000455: "s",
+	0x0000ABAA: "s",
+	0x000118C1: "s",
+	0x00010448: "s",
+	0x0000FF33: "S",
+	0x0001D412: "S",
+	0x0001D446: "S",
+	0x0001D47A: "S",
+	0x0001D4AE: "S",
+	0x0001D4E2: "S",
+	0x0001D516: "S",
+	0x0001D54A: "S",
+	0x0001D57E: "S",
+	0x0001D5B2: "S",
+	0x0001D5E6: "S",
+	0x0001D61A: "S",
+	0x0001D64E: "S",
+	0x0001D682: "S",
+	0x00000405: "S",
+	0x0000054F: "S",
+	0x000013D5: "S",
+	0x000013DA: "S",
+	0x0000A4E2: "S",
+	0x00016F3A: "S",
+	0x00010296: "S",
+	0x00010420: "S",
+	0x00000282: "s\u0328",
+	0x00001D74: "s\u0334",
+	0x0000A7B5: "\u00df",
+	0x000003B2: "\u00df",
+	0x000003D0: "\u00df",
+	0x0001D6C3: "\u00df",
+	0x0001D6FD: "\u00df",
+	0x0001D737: "\u00df",
+	0x0001D771: "\u00df",
+	0x0001D7AB: "\u00df",
+	0x000013F0: "\u00df",
+	0x0001F75C: "sss",
+	0x0000FB06: "st",
+	0x0000222B: "\u0283",
+	0x0000AB4D: "\u0283",
+	0x00002211: "\u01a9",
+	0x00002140: "\u01a9",
+	0x000003A3: "\u01a9",
+	0x0001D6BA: "\u01a9",
+	0x0001D6F4: "\u01a9",
+	0x0001D72E: "\u01a9",
+	0x0001D768: "\u01a9",
+	0x0001D7A2: "\u01a9",
+	0x00002D49: "\u01a9",
+	0x0000222C: "\u0283\u0283",
+	0x0000222D: "\u0283\u0283\u0283",
+	0x00002A0C: "\u0283\u0283\u0283\u0283",
+	0x0001D42D: "t",
+	0x0001D461: "t",
+	0x0001D495: "t",
+	0x0001D4C9: "t",
+	0x0001D4FD: "t",
+	0x0001D531: "t",
+	0x0001D565: "t",
+	0x0001D599: "t",
+	0x0001D5CD: "t",
+	0x0001D601: "t",
+	0x0001D635: "t",
+	0x0001D669: "t",
+	0x0001D69D: "t",
+	0x000022A4: "T",
+	0x000027D9: "T",
+	0x0001F768: "T",
+	0x0000FF34: "T",
+	0x0001D413: "T",
+	0x0001D447: "T",
+	0x0001D47B: "T",
+	0x0001D4AF: "T",
+	0x0001D4E3: "T",
+	0x0001D517: "T",
+	0x0001D54B: "T",
+	0x0001D57F: "T",
+	0x0001D5B3: "T",
+	0x0001D5E7: "T",
+	0x0001D61B: "T",
+	0x0001D64F: "T",
+	0x0001D683: "T",
+	0x000003A4: "T",
+	0x0001D6BB: "T",
+	0x0001D6F5: "T",
+	0x0001D72F: "T",
+	0x0001D769: "T",
+	0x0001D7A3: "T",
+	0x00002CA6: "T",
+	0x00000422: "T",
+	0x000013A2: "T",
+	0x0000A4D4: "T",
+	0x00016F0A: "T",
+	0x000118BC: "T",
+	0x00010297: "T",
+	0x000102B1: "T",
+	0x00010315: "T",
+	0x000001AD: "t\u0314",
+	0x00002361: "T\u0308",
+	0x0000023E: "T\u0338",
+	0x0000021A: "\u0162",
+	0x000001AE: "T\u0328",
+	0x000004AC: "T\u0329",
+	0x000020AE: "T\u20eb",
+	0x00000167: "t\u0335",
+	0x00000166: "T\u0335",
+	0x00001D75: "t\u0334",
+	0x000010A0: "\ua786",
+	0x0000A728: "T3",
+	0x000002A8: "t\u0255",
+	0x00002121: "TEL",
+	0x0000A777: "tf",
+	0x000002A6: "ts",
+	0x000002A7: "t\u0283",
+	0x0000A729: "t\u021d",
+	0x000003C4: "\u1d1b",
+	0x0001D6D5: "\u1d1b",
+	0x0001D70F: "\u1d1b",
+	0x0001D749: "\u1d1b",
+	0x0001D783: "\u1d1b",
+	0x0001D7BD: "\u1d1b",
+	0x00000442: "\u1d1b",
+	0x0000AB72: "\u1d1b",
+	0x000004AD: "\u1d1b\u0329",
+	0x00000163: "\u01ab",
+	0x0000021B: "\u01ab",
+	0x000013BF: "\u01ab",
+	0x0001D42E: "u",
+	0x0001D462: "u",
+	0x0001D496: "u",
+	0x0001D4CA: "u",
+	0x0001D4FE: "u",
+	0x0001D532: "u",
+	0x0001D566: "u",
+	0x0001D59A: "u",
+	0x0001D5CE: "u",
+	0x0001D602: "u",
+	0x0001D636: "u",
+	0x0001D66A: "u",
+	0x0001D69E: "u",
+	0x0000A79F: "u",
+	0x00001D1C: "u",
+	0x0000AB4E: "u",
+	0x0000AB52: "u",
+	0x0000028B: "u",
+	0x000003C5: "u",
+	0x0001D6D6: "u",
+	0x0001D710: "u",
+	0x0001D74A: "u",
+	0x0001D784: "u",
+	0x0001D7BE: "u",
+	0x0000057D: "u",
+	0x000104F6: "u",
+	0x000118D8: "u",
+	0x0000222A: "U",
+	0x000022C3: "U",
+	0x0001D414: "U",
+	0x0001D448: "U",
+	0x0001D47C: "U",
+	0x0001D4B0: "U",
+	0x0001D4E4: "U",
+	0x0001D518: "U",
+	0x0001D54C: "U",
+	0x0001D580: "U",
+	0x0001D5B4: "U",
+	0x0001D5E8: "U",
+	0x0001D61C: "U",
+	0x0001D650: "U",
+	0x0001D684: "U",
+	0x0000054D: "U",
+	0x00001200: "U",
+	0x000104CE: "U",
+	0x0000144C: "U",
+	0x0000A4F4: "U",
+	0x00016F42: "U",
+	0x000118B8: "U",
+	0x000001D4: "\u016d",
+	0x000001D3: "\u016c",
+	0x00001D7E: "u\u0335",
+	0x0000AB9C: "u\u0335",
+	0x00000244: "U\u0335",
+	0x000013CC: "U\u0335",
+	0x00001458: "U\u00b7",
+	0x00001467: "U'",
+	0x00001D6B: "ue",
+	0x0000AB63: "uo",
+	0x00001E43: "\uab51",
+	0x0000057A: "\u0270",
+	0x00001223: "\u0270",
+	0x00002127: "\u01b1",
+	0x0000162E: "\u01b1",
+	0x00001634: "\u01b1",
+	0x00001D7F: "\u028a\u0335",
+	0x00002228: "v",
+	0x000022C1: "v",
+	0x0000FF56: "v",
+	0x00002174: "v",
+	0x0001D42F: "v",
+	0x0001D463: "v",
+	0x0001D497: "v",
+	0x0001D4CB: "v",
+	0x0001D4FF: "v",
+	0x0001D533: "v",
+	0x0001D567: "v",
+	0x0001D59B: "v",
+	0x0001D5CF: "v",
+	0x0001D603: "v",
+	0x0001D637: "v",
+	0x0001D66B: "v",
+	0x0001D69F: "v",
+	0x00001D20: "v",
+	0x000003BD: "v",
+	0x0001D6CE: "v",
+	0x0001D708: "v",
+	0x0001D742: "v",
+	0x0001D77C: "v",
+	0x0001D7B6: "v",
+	0x00000475: "v",
+	0x000005D8: "v",
+	0x00011706: "v",
+	0x0000ABA9: "v",
+	0x000118C0: "v",
+	0x0001D20D: "V",
+	0x00000667: "V",
+	0x000006F7: "V",
+	0x00002164: "V",
+	0x0001D415: "V",
+	0x0001D449: "V",
+	0x0001D47D: "V",
+	0x0001D4B1: "V",
+	0x0001D4E5: "V",
+	0x0001D519: "V",
+	0x0001D54D: "V",
+	0x0001D581: "V",
+	0x0001D5B5: "V",
+	0x0001D5E9: "V",
+	0x0001D61D: "V",
+	0x0001D651: "V",
+	0x0001D685: "V",
+	0x00000474: "V",
+	0x00002D38: "V",
+	0x000013D9: "V",
+	0x0000142F: "V",
+	0x0000A6DF: "V",
+	0x0000A4E6: "V",
+	0x00016F08: "V",
+	0x000118A0: "V",
+	0x0001051D: "V",
+	0x00010197: "V\u0335",
+	0x0000143B: "V\u00b7",
+	0x0001F76C: "VB",
+	0x00002175: "vi",
+	0x00002176: "vii",
+	0x00002177: "viii",
+	0x00002165: "Vl",
+	0x00002166: "Vll",
+	0x00002167: "Vlll",
+	0x0001F708: "V\u1de4",
+	0x00001D27: "\u028c",
+	0x000104D8: "\u028c",
+	0x00000668: "\u0245",
+	0x000006F8: "\u0245",
+	0x0000039B: "\u0245",
+	0x0001D6B2: "\u0245",
+	0x0001D6EC: "\u0245",
+	0x0001D726: "\u0245",
+	0x0001D760: "\u0245",
+	0x0001D79A: "\u0245",
+	0x0000041B: "\u0245",
+	0x00002D37: "\u0245",
+	0x000104B0: "\u0245",
+	0x00001431: "\u0245",
+	0x0000A6CE: "\u0245",
+	0x0000A4E5: "\u0245",
+	0x00016F3D: "\u0245",
+	0x0001028D: "\u0245",
+	0x000004C5: "\u0245\u0326",
+	0x0000143D: "\u0245\u00b7",
+	0x0000026F: "w",
+	0x0001D430: "w",
+	0x0001D464: "w",
+	0x0001D498: "w",
+	0x0001D4CC: "w",
+	0x0001D500: "w",
+	0x0001D534: "w",
+	0x0001D568: "w",
+	0x0001D59C: "w",
+	0x0001D5D0: "w",
+	0x0001D604: "w",
+	0x0001D638: "w",
+	0x0001D66C: "w",
+	0x0001D6A0: "w",
+	0x00001D21: "w",
+	0x00000461: "w",
+	0x0000051D: "w",
+	0x00000561: "w",
+	0x0001170A: "w",
+	0x0001170E: "w",
+	0x0001170F: "w",
+	0x0000AB83: "w",
+	0x000118EF: "W",
+	0x000118E6: "W",
+	0x0001D416: "W",
+	0x0001D44A: "W",
+	0x0001D47E: "W",
+	0x0001D4B2: "W",
+	0x0001D4E6: "W",
+	0x0001D51A: "W",
+	0x0001D54E: "W",
+	0x0001D582: "W",
+	0x0001D5B6: "W",
+	0x0001D5EA: "W",
+	0x0001D61E: "W",
+	0x0001D652: "W",
+	0x0001D686: "W",
+	0x0000051C: "W",
+	0x000013B3: "W",
+	0x000013D4: "W",
+	0x0000A4EA: "W",
+	0x0000047D: "w\u0486\u0487",
+	0x000114C5: "w\u0307",
+	0x000020A9: "W\u0335",
+	0x0000A761: "w\u0326",
+	0x00001D0D: "\u028d",
+	0x0000043C: "\u028d",
+	0x0000AB87: "\u028d",
+	0x000004CE: "\u028d\u0326",
+	0x0000166E: "x",
+	0x000000D7: "x",
+	0x0000292B: "x",
+	0x0000292C: "x",
+	0x00002A2F: "x",
+	0x0000FF58: "x",
+	0x00002179: "x",
+	0x0001D431: "x",
+	0x0001D465: "x",
+	0x0001D499: "x",
+	0x0001D4CD: "x",
+	0x0001D501: "x",
+	0x0001D535: "x",
+	0x0001D569: "x",
+	0x0001D59D: "x",
+	0x0001D5D1: "x",
+	0x0001D605: "x",
+	0x0001D639: "x",
+	0x0001D66D: "x",
+	0x0001D6A1: "x",
+	0x00000445: "x",
+	0x00001541: "x",
+	0x0000157D: "x",
+	0x00002DEF: "\u036f",
+	0x0000166D: "X",
+	0x00002573: "X",
+	0x00010322: "X",
+	0x000118EC: "X",
+	0x0000FF38: "X",
+	0x00002169: "X",
+	0x0001D417: "X",
+	0x0001D44B: "X",
+	0x0001D47F: "X",
+	0x0001D4B3: "X",
+	0x0001D4E7: "X",
+	0x0001D51B: "X",
+	0x0001D54F: "X",
+	0x0001D583: "X",
+	0x0001D5B7: "X",
+	0x0001D5EB: "X",
+	0x0001D61F: "X",
+	0x0001D653: "X",
+	0x0001D687: "X",
+	0x0000A7B3: "X",
+	0x000003A7: "X",
+	0x0001D6BE: "X",
+	0x0001D6F8: "X",
+	0x0001D732: "X",
+	0x0001D76C: "X",
+	0x0001D7A6: "X",
+	0x00002CAC: "X",
+	0x00000425: "X",
+	0x00002D5D: "X",
+	0x000016B7: "X",
+	0x0000A4EB: "X",
+	0x00010290: "X",
+	0x000102B4: "X",
+	0x00010317: "X",
+	0x00010527: "X",
+	0x00002A30: "x\u0307",
+	0x000004B2: "X\u0329",
+	0x00010196: "X\u0335",
+	0x0000217A: "xi",
+	0x0000217B: "xii",
+	0x0000216A: "Xl",
+	0x0000216B: "Xll",
+	0x00000263: "y",
+	0x00001D8C: "y",
+	0x0000FF59: "y",
+	0x0001D432: "y",
+	0x0001D466: "y",
+	0x0001D49A: "y",
+	0x0001D4CE: "y",
+	0x0001D502: "y",
+	0x0001D536: "y",
+	0x0001D56A: "y",
+	0x0001D59E: "y",
+	0x0001D5D2: "y",
+	0x0001D606: "y",
+	0x0001D63A: "y",
+	0x0001D66E: "y",
+	0x0001D6A2: "y",
+	0x0000028F: "y",
+	0x00001EFF: "y",
+	0x0000AB5A: "y",
+	0x000003B3: "y",
+	0x0000213D: "y",
+	0x0001D6C4: "y",
+	0x0001D6FE: "y",
+	0x0001D738: "y",
+	0x0001D772: "y",
+	0x0001D7AC: "y",
+	0x00000443: "y",
+	0x000004AF: "y",
+	0x000010E7: "y",
+	0x000118DC: "y",
+	0x0000FF39: "Y",
+	0x0001D418: "Y",
+	0x0001D44C: "Y",
+	0x0001D480: "Y",
+	0x0001D4B4: "Y",
+	0x0001D4E8: "Y",
+	0x0001D51C: "Y",
+	0x0001D550: "Y",
+	0x0001D584: "Y",
+	0x0001D5B8: "Y",
+	0x0001D5EC: "Y",
+	0x0001D620: "Y",
+	0x0001D654: "Y",
+	0x0001D688: "Y",
+	0x000003A5: "Y",
+	0x000003D2: "Y",
+	0x0001D6BC: "Y",
+	0x0001D6F6: "Y",
+	0x0001D730: "Y",
+	0x0001D76A: "Y",
+	0x0001D7A4: "Y",
+	0x00002CA8: "Y",
+	0x00000423: "Y",
+	0x000004AE: "Y",
+	0x000013A9: "Y",
+	0x000013BD: "Y",
+	0x0000A4EC: "Y",
+	0x00016F43: "Y",
+	0x000118A4: "Y",
+	0x000102B2: "Y",
+	0x000001B4: "y\u0314",
+	0x0000024F: "y\u0335",
+	0x000004B1: "y\u0335",
+	0x000000A5: "Y\u0335",
+	0x0000024E: "Y\u0335",
+	0x000004B0: "Y\u0335",
+	0x00000292: "\u021d",
+	0x0000A76B: "\u021d",
+	0x00002CCD: "\u021d",
+	0x000004E1: "\u021d",
+	0x000010F3: "\u021d",
+	0x0001D433: "z",
+	0x0001D467: "z",
+	0x0001D49B: "z",
+	0x0001D4CF: "z",
+	0x0001D503: "z",
+	0x0001D537: "z",
+	0x0001D56B: "z",
+	0x0001D59F: "z",
+	0x0001D5D3: "z",
+	0x0001D607: "z",
+	0x0001D63B: "z",
+	0x0001D66F: "z",
+	0x0001D6A3: "z",
+	0x00001D22: "z",
+	0x0000AB93: "z",
+	0x000118C4: "z",
+	0x000102F5: "Z",
+	0x000118E5: "Z",
+	0x0000FF3A: "Z",
+	0x00002124: "Z",
+	0x00002128: "Z",
+	0x0001D419: "Z",
+	0x0001D44D: "Z",
+	0x0001D481: "Z",
+	0x0001D4B5: "Z",
+	0x0001D4E9: "Z",
+	0x0001D585: "Z",
+	0x0001D5B9: "Z",
+	0x0001D5ED: "Z",
+	0x0001D621: "Z",
+	0x0001D655: "Z",
+	0x0001D689: "Z",
+	0x00000396: "Z",
+	0x0001D6AD: "Z",
+	0x0001D6E7: "Z",
+	0x0001D721: "Z",
+	0x0001D75B: "Z",
+	0x0001D795: "Z",
+	0x000013C3: "Z",
+	0x0000A4DC: "Z",
+	0x000118A9: "Z",
+	0x00000290: "z\u0328",
+	0x000001B6: "z\u0335",
+	0x000001B5: "Z\u0335",
+	0x00000225: "z\u0326",
+	0x00000224: "Z\u0326",
+	0x00001D76: "z\u0334",
+	0x000001BF: "\u00fe",
+	0x000003F8: "\u00fe",
+	0x000003F7: "\u00de",
+	0x000104C4: "\u00de",
+	0x00002079: "\ua770",
+	0x00001D24: "\u01a8",
+	0x000003E9: "\u01a8",
+	0x0000A645: "\u01a8",
+	0x0000044C: "\u0185",
+	0x0000AB9F: "\u0185",
+	0x0000044B: "\u0185i",
+	0x0000AB7E: "\u0242",
+	0x000002E4: "\u02c1",
+	0x0000A6CD: "\u02a1",
+	0x00002299: "\u0298",
+	0x00002609: "\u0298",
+	0x00002A00: "\u0298",
+	0x0000A668: "\u0298",
+	0x00002D59: "\u0298",
+	0x000104C3: "\u0298",
+	0x0000213E: "\u0393",
+	0x0001D6AA: "\u0393",
+	0x0001D6E4: "\u0393",
+	0x0001D71E: "\u0393",
+	0x0001D758: "\u0393",
+	0x0001D792: "\u0393",
+	0x00002C84: "\u0393",
+	0x00000413: "\u0393",
+	0x000013B1: "\u0393",
+	0x000014A5: "\u0393",
+	0x00016F07: "\u0393",
+	0x00000492: "\u0393\u0335",
+	0x000014AF: "\u0393\u00b7",
+	0x00000490: "\u0393'",
+	0x00002206: "\u0394",
+	0x000025B3: "\u0394",
+	0x0001F702: "\u0394",
+	0x0001D6AB: "\u0394",
+	0x0001D6E5: "\u0394",
+	0x0001D71F: "\u0394",
+	0x0001D759: "\u0394",
+	0x0001D793: "\u0394",
+	0x00002C86: "\u0394",
+	0x00002D60: "\u0394",
+	0x00001403: "\u0394",
+	0x00016F1A: "\u0394",
+	0x00010285: "\u0394",
+	0x000102A3: "\u0394",
+	0x00002359: "\u0394\u0332",
+	0x0000140F: "\u0394\u00b7",
+	0x0000142C: "\u0394\u1420",
+	0x0001D7CB: "\u03dd",
+	0x0001D6C7: "\u03b6",
+	0x0001D701: "\u03b6",
+	0x0001D73B: "\u03b6",
+	0x0001D775: "\u03b6",
+	0x0001D7AF: "\u03b6",
+	0x00002CE4: "\u03d7",
+	0x0001D6CC: "\u03bb",
+	0x0001D706: "\u03bb",
+	0x0001D740: "\u03bb",
+	0x0001D77A: "\u03bb",
+	0x0001D7B4: "\u03bb",
+	0x00002C96: "\u03bb",
+	0x000104DB: "\u03bb",
+	0x000000B5: "\u03bc",
+	0x0001D6CD: "\u03bc",
+	0x0001D707: "\u03bc",
+	0x0001D741: "\u03bc",
+	0x0001D77B: "\u03bc",
+	0x0001D7B5: "\u03bc",
+	0x0001D6CF: "\u03be",
+	0x0001D709: "\u03be",
+	0x0001D743: "\u03be",
+	0x0001D77D: "\u03be",
+	0x0001D7B7: "\u03be",
+	0x0001D6B5: "\u039e",
+	0x0001D6EF: "\u039e",
+	0x0001D729: "\u039e",
+	0x0001D763: "\u039e",
+	0x0001D79D: "\u039e",
+	0x000003D6: "\u03c0",
+	0x0000213C: "\u03c0",
+	0x0001D6D1: "\u03c0",
+	0x0001D6E1: "\u03c0",
+	0x0001D70B: "\u03c0",
+	0x0001D71B: "\u03c0",
+	0x0001D745: "\u03c0",
+	0x0001D755: "\u03c0",
+	0x0001D77F: "\u03c0",
+	0x0001D78F: "\u03c0",
+	0x0001D7B9: "\u03c0",
+	0x0001D7C9: "\u03c0",
+	0x00001D28: "\u03c0",
+	0x0000043F: "\u03c0",
+	0x0000220F: "\u03a0",
+	0x0000213F: "\u03a0",
+	0x0001D6B7: "\u03a0",
+	0x0001D6F1: "\u03a0",
+	0x0001D72B: "\u03a0",
+	0x0001D765: "\u03a0",
+	0x0001D79F: "\u03a0",
+	0x00002CA0: "\u03a0",
+	0x0000041F: "\u03a0",
+	0x0000A6DB: "\u03a0",
+	0x000102AD: "\u03d8",
+	0x00010312: "\u03d8",
+	0x000003DB: "\u03c2",
+	0x0001D6D3: "\u03c2",
+	0x0001D70D: "\u03c2",
+	0x0001D747: "\u03c2",
+	0x0001D781: "\u03c2",
+	0x0001D7BB: "\u03c2",
+	0x0001D6BD: "\u03a6",
+	0x0001D6F7: "\u03a6",
+	0x0001D731: "\u03a6",
+	0x0001D76B: "\u03a6",
+	0x0001D7A5: "\u03a6",
+	0x00002CAA: "\u03a6",
+	0x00000424: "\u03a6",
+	0x00000553: "\u03a6",
+	0x00001240: "\u03a6",
+	0x000016F0: "\u03a6",
+	0x000102B3: "\u03a6",
+	0x0000AB53: "\u03c7",
+	0x0000AB55: "\u03c7",
+	0x0001D6D8: "\u03c7",
+	0x0001D712: "\u03c7",
+	0x0001D74C: "\u03c7",
+	0x0001D786: "\u03c7",
+	0x0001D7C0: "\u03c7",
+	0x00002CAD: "\u03c7",
+	0x0001D6D9: "\u03c8",
+	0x0001D713: "\u03c8",
+	0x0001D74D: "\u03c8",
+	0x0001D787: "\u03c8",
+	0x0001D7C1: "\u03c8",
+	0x00000471: "\u03c8",
+	0x000104F9: "\u03c8",
+	0x0001D6BF: "\u03a8",
+	0x0001D6F9: "\u03a8",
+	0x0001D733: "\u03a8",
+	0x0001D76D: "\u03a8",
+	0x0001D7A7: "\u03a8",
+	0x00002CAE: "\u03a8",
+	0x00000470: "\u03a8",
+	0x000104D1: "\u03a8",
+	0x000016D8: "\u03a8",
+	0x000102B5: "\u03a8",
+	0x00002375: "\u03c9",
+	0x0000A7B7: "\u03c9",
+	0x0001D6DA: "\u03c9",
+	0x0001D714: "\u03c9",
+	0x0001D74E: "\u03c9",
+	0x0001D788: "\u03c9",
+	0x0001D7C2: "\u03c9",
+	0x00002CB1: "\u03c9",
+	0x0000A64D: "\u03c9",
+	0x00002126: "\u03a9",
+	0x0001D6C0: "\u03a9",
+	0x0001D6FA: "\u03a9",
+	0x0001D734: "\u03a9",
+	0x0001D76E: "\u03a9",
+	0x0001D7A8: "\u03a9",
+	0x0000162F: "\u03a9",
+	0x00001635: "\u03a9",
+	0x000102B6: "\u03a9",
+	0x00002379: "\u03c9\u0332",
+	0x00001F7D: "\u1ff4",
+	0x00002630: "\u2cb6",
+	0x00002CDC: "\u03ec",
+	0x00000497: "\u0436\u0329",
+	0x00000496: "\u0416\u0329",
+	0x0001D20B: "\u0418",
+	0x00000376: "\u0418",
+	0x0000A6A1: "\u0418",
+	0x00010425: "\u0418",
+	0x00000419: "\u040d",
+	0x0000048A: "\u040d\u0326",
+	0x0000045D: "\u0439",
+	0x0000048B: "\u0439\u0326",
+	0x000104BC: "\u04c3",
+	0x00001D2B: "\u043b",
+	0x000004C6: "\u043b\u0326",
+	0x0000AB60: "\u0459",
+	0x000104EB: "\ua669",
+	0x00001DEE: "\u2dec",
+	0x000104CD: "\u040b",
+	0x0001D202: "\u04fe",
+	0x0001D222: "\u0460",
+	0x000013C7: "\u0460",
+	0x000015EF: "\u0460",
+	0x0000047C: "\u0460\u0486\u0487",
+	0x000018ED: "\u0460\u00b7",
+	0x0000A7B6: "\ua64c",
+	0x000004CC: "\u04b7",
+	0x000004CB: "\u04b6",
+	0x000004BE: "\u04bc\u0328",
+	0x00002CBD: "\u0448",
+	0x00002CBC: "\u0428",
+	0x0000A650: "\u042al",
+	0x00002108: "\u042d",
+	0x0001F701: "\ua658",
+	0x00016F1C: "\ua658",
+	0x0000A992: "\u2c3f",
+	0x00000587: "\u0565\u0582",
+	0x00001294: "\u0571",
+	0x0000FB14: "\u0574\u0565",
+	0x0000FB15: "\u0574\u056b",
+	0x0000FB17: "\u0574\u056d",
+	0x0000FB13: "\u0574\u0576",
+	0x00002229: "\u0548",
+	0x000022C2: "\u0548",
+	0x0001D245: "\u0548",
+	0x00001260: "\u0548",
+	0x0000144E: "\u0548",
+	0x0000A4F5: "\u0548",
+	0x0000145A: "\u0548\u00b7",
+	0x00001468: "\u0548'",
+	0x0000FB16: "\u057e\u0576",
+	0x000020BD: "\u0554",
+	0x000002D3: "\u0559",
+	0x000002BF: "\u0559",
+	0x00002135: "\u05d0",
+	0x0000FB21: "\u05d0",
+	0x0000FB2F: "\ufb2e",
+	0x0000FB30: "\ufb2e",
+	0x0000FB4F: "\u05d0\u05dc",
+	0x00002136: "\u05d1",
+	0x00002137: "\u05d2",
+	0x00002138: "\u05d3",
+	0x0000FB22: "\u05d3",
+	0x0000FB23: "\u05d4",
+	0x0000FB39: "\ufb1d",
+	0x0000FB24: "\u05db",
+	0x0000FB25: "\u05dc",
+	0x0000FB26: "\u05dd",
+	0x0000FB20: "\u05e2",
+	0x0000FB27: "\u05e8",
+	0x0000FB2B: "\ufb2a",
+	0x0000FB49: "\ufb2a",
+	0x0000FB2D: "\ufb2c",
+	0x0000FB28: "\u05ea",
+	0x0000FE80: "\u0621",
+	0x000006FD: "\u0621\u0348",
+	0x0000FE82: "\u0622",
+	0x0000FE81: "\u0622",
+	0x0000FB51: "\u0671",
+	0x0000FB50: "\u0671",
+	0x0001EE01: "\u0628",
+	0x0001EE21: "\u0628",
+	0x0001EE61: "\u0628",
+	0x0001EE81: "\u0628",
+	0x0001EEA1: "\u0628",
+	0x0000FE91: "\u0628",
+	0x0000FE92: "\u0628",
+	0x0000FE90: "\u0628",
+	0x0000FE8F: "\u0628",
+	0x00000751: "\u0628\u06db",
+	0x000008B6: "\u0628\u06e2",
+	0x000008A1: "\u0628\u0654",
+	0x0000FCA0: "\u0628o",
+	0x0000FCE2: "\u0628o",
+	0x0000FC9C: "\u0628\u062c",
+	0x0000FC05: "\u0628\u062c",
+	0x0000FC9D: "\u0628\u062d",
+	0x0000FC06: "\u0628\u062d",
+	0x0000FDC2: "\u0628\u062d\u0649",
+	0x0000FC9E: "\u0628\u062e",
+	0x0000FC07: "\u0628\u062e",
+	0x0000FCD2: "\u0628\u062e",
+	0x0000FC4B: "\u0628\u062e",
+	0x0000FD9E: "\u0628\u062e\u0649",
+	0x0000FC6A: "\u0628\u0631",
+	0x0000FC6B: "\u0628\u0632",
+	0x0000FC9F: "\u0628\u0645",
+	0x0000FCE1: "\u0628\u0645",
+	0x0000FC6C: "\u0628\u0645",
+	0x0000FC08: "\u0628\u0645",
+	0x0000FC6D: "\u0628\u0646",
+	0x0000FC6E: "\u0628\u0649",
+	0x0000FC09: "\u0628\u0649",
+	0x0000FC6F: "\u0628\u0649",
+	0x0000FC0A: "\u0628\u0649",
+	0x0000FB54: "\u067b",
+	0x0000FB55: "\u067b",
+	0x0000FB53: "\u067b",
+	0x0000FB52: "\u067b",
+	0x000006D0: "\u067b",
+	0x0000FBE6: "\u067b",
+	0x0000FBE7: "\u067b",
+	0x0000FBE5: "\u067b",
+	0x0000FBE4: "\u067b",
+	0x0000FB5C: "\u0680",
+	0x0000FB5D: "\u0680",
+	0x0000FB5B: "\u0680",
+	0x0000FB5A: "\u0680",
+	0x000008A9: "\u0754",
+	0x00000767: "\u0754",
+	0x00002365: "\u0629",
+	0x000000F6: "\u0629",
+	0x0000FE94: "\u0629",
+	0x0000FE93: "\u0629",
+	0x000006C3: "\u0629",
+	0x0001EE15: "\u062a",
+	0x0001EE35: "\u062a",
+	0x0001EE75: "\u062a",
+	0x0001EE95: "\u062a",
+	0x0001EEB5: "\u062a",
+	0x0000FE97: "\u062a",
+	0x0000FE98: "\u062a",
+	0x0000FE96: "\u062a",
+	0x0000FE95: "\u062a",
+	0x0000FCA5: "\u062ao",
+	0x0000FCE4: "\u062ao",
+	0x0000FCA1: "\u062a\u062c",
+	0x0000FC0B: "\u062a\u062c",
+	0x0000FD50: "\u062a\u062c\u0645",
+	0x0000FDA0: "\u062a\u062c\u0649",
+	0x0000FD9F: "\u062a\u062c\u0649",
+	0x0000FCA2: "\u062a\u062d",
+	0x0000FC0C: "\u062a\u062d",
+	0x0000FD52: "\u062a\u062d\u062c",
+	0x0000FD51: "\u062a\u062d\u062c",
+	0x0000FD53: "\u062a\u062d\u0645",
+	0x0000FCA3: "\u062a\u062e",
+	0x0000FC0D: "\u062a\u062e",
+	0x0000FD54: "\u062a\u062e\u0645",
+	0x0000FDA2: "\u062a\u062e\u0649",
+	0x0000FDA1: "\u062a\u062e\u0649",
+	0x0000FC70: "\u062a\u0631",
+	0x0000FC71: "\u062a\u0632",
+	0x0000FCA4: "\u062a\u0645",
+	0x0000FCE3: "\u062a\u0645",
+	0x0000FC72: "\u062a\u0645",
+	0x0000FC0E: "\u062a\u0645",
+	0x0000FD55: "\u062a\u0645\u062c",
+	0x0000FD56: "\u062a\u0645\u062d",
+	0x0000FD57: "\u062a\u0645\u062e",
+	0x0000FDA4: "\u062a\u0645\u0649",
+	0x0000FDA3: "\u062a\u0645\u0649",
+	0x0000FC73: "\u062a\u0646",
+	0x0000FC74: "\u062a\u0649",
+	0x0000FC0F: "\u062a\u0649",
+	0x0000FC75: "\u062a\u0649",
+	0x0000FC10: "\u062a\u0649",
+	0x0000FB60: "\u067a",
+	0x0000FB61: "\u067a",
+	0x0000FB5F: "\u067a",
+	0x0000FB5E: "\u067a",
+	0x0000FB64: "\u067f",
+	0x0000FB65: "\u067f",
+	0x0000FB63: "\u067f",
+	0x0000FB62: "\u067f",
+	0x0001EE02: "\u062c",
+	0x0001EE22: "\u062c",
+	0x0001EE42: "\u062c",
+	0x0001EE62: "\u062c",
+	0x0001EE82: "\u062c",
+	0x0001EEA2: "\u062c",
+	0x0000FE9F: "\u062c",
+	0x0000FEA0: "\u062c",
+	0x0000FE9E: "\u062c",
+	0x0000FE9D: "\u062c",
+	0x0000FCA7: "\u062c\u062d",
+	0x0000FC15: "\u062c\u062d",
+	0x0000FDA6: "\u062c\u062d\u0649",
+	0x0000FDBE: "\u062c\u062d\u0649",
+	0x0000FDFB: "\u062c\u0644 \u062c\u0644l\u0644o",
+	0x0000FCA8: "\u062c\u0645",
+	0x0000FC16: "\u062c\u0645",
+	0x0000FD59: "\u062c\u0645\u062d",
+	0x0000FD58: "\u062c\u0645\u062d",
+	0x0000FDA7: "\u062c\u0645\u0649",
+	0x0000FDA5: "\u062c\u0645\u0649",
+	0x0000FD1D: "\u062c\u0649",
+	0x0000FD01: "\u062c\u0649",
+	0x0000FD1E: "\u062c\u0649",
+	0x0000FD02: "\u062c\u0649",
+	0x0000FB78: "\u0683",
+	0x0000FB79: "\u0683",
+	0x0000FB77: "\u0683",
+	0x0000FB76: "\u0683",
+	0x0000FB74: "\u0684",
+	0x0000FB75: "\u0684",
+	0x0000FB73: "\u0684",
+	0x0000FB72: "\u0684",
+	0x0000FB7C: "\u0686",
+	0x0000FB7D: "\u0686",
+	0x0000FB7B: "\u0686",
+	0x0000FB7A: "\u0686",
+	0x0000FB80: "\u0687",
+	0x0000FB81: "\u0687",
+	0x0000FB7F: "\u0687",
+	0x0000FB7E: "\u0687",
+	0x0001EE07: "\u062d",
+	0x0001EE27: "\u062d",
+	0x0001EE47: "\u062d",
+	0x0001EE67: "\u062d",
+	0x0001EE87: "\u062d",
+	0x0001EEA7: "\u062d",
+	0x0000FEA3: "\u062d",
+	0x0000FEA4: "\u062d",
+	0x0000FEA2: "\u062d",
+	0x0000FEA1: "\u062d",
+	0x00000685: "\u062d\u06db",
+	0x00000681: "\u062d\u0654",
+	0x00000772: "\u062d\u0654",
+	0x0000FCA9: "\u062d\u062c",
+	0x0000FC17: "\u062d\u062c",
+	0x0000FDBF: "\u062d\u062c\u0649",
+	0x0000FCAA: "\u062d\u0645",
+	0x0000FC18: "\u062d\u0645",
+	0x0000FD5B: "\u062d\u0645\u0649",
+	0x0000FD5A: "\u062d\u0645\u0649",
+	0x0000FD1B: "\u062d\u0649",
+	0x0000FCFF: "\u062d\u0649",
+	0x0000FD1C: "\u062d\u0649",
+	0x0000FD00: "\u062d\u0649",
+	0x0001EE17: "\u062e",
+	0x0001EE37: "\u062e",
+	0x0001EE57: "\u062e",
+	0x0001EE77: "\u062e",
+	0x0001EE97: "\u062e",
+	0x0001EEB7: "\u062e",
+	0x0000FEA7: "\u062e",
+	0x0000FEA8: "\u062e",
+	0x0000FEA6: "\u062e",
+	0x0000FEA5: "\u062e",
+	0x0000FCAB: "\u062e\u062c",
+	0x0000FC19: "\u062e\u062c",
+	0x0000FC1A: "\u062e\u062d",
+	0x0000FCAC: "\u062e\u0645",
+	0x0000FC1B: "\u062e\u0645",
+	0x0000FD1F: "\u062e\u0649",
+	0x0000FD03: "\u062e\u0649",
+	0x0000FD20: "\u062e\u0649",
+	0x0000FD04: "\u062e\u0649",
+	0x000102E1: "\u062f",
+	0x0001EE03: "\u062f",
+	0x0001EE83: "\u062f",
+	0x0001EEA3: "\u062f",
+	0x0000FEAA: "\u062f",
+	0x0000FEA9: "\u062f",
+	0x00000688: "\u062f\u0615",
+	0x0000FB89: "\u062f\u0615",
+	0x0000FB88: "\u062f\u0615",
+	0x0000068E: "\u062f\u06db",
+	0x0000FB87: "\u062f\u06db",
+	0x0000FB86: "\u062f\u06db",
+	0x000006EE: "\u062f\u0302",
+	0x000008AE: "\u062f\u0324\u0323",
+	0x0001EE18: "\u0630",
+	0x0001EE98: "\u0630",
+	0x0001EEB8: "\u0630",
+	0x0000FEAC: "\u0630",
+	0x0000FEAB: "\u0630",
+	0x0000FC5B: "\u0630\u0670",
+	0x0000068B: "\u068a\u0615",
+	0x0000FB85: "\u068c",
+	0x0000FB84: "\u068c",
+	0x0000FB83: "\u068d",
+	0x0000FB82: "\u068d",
+	0x0001EE13: "\u0631",
+	0x0001EE93: "\u0631",
+	0x0001EEB3: "\u0631",
+	0x0000FEAE: "\u0631",
+	0x0000FEAD: "\u0631",
+	0x00000691: "\u0631\u0615",
+	0x0000FB8D: "\u0631\u0615",
+	0x0000FB8C: "\u0631\u0615",
+	0x00000698: "\u0631\u06db",
+	0x0000FB8B: "\u0631\u06db",
+	0x0000FB8A: "\u0631\u06db",
+	0x00000692: "\u0631\u0306",
+	0x000008B9: "\u0631\u0306\u0307",
+	0x000006EF: "\u0631\u0302",
+	0x0000076C: "\u0631\u0654",
+	0x0000FC5C: "\u0631\u0670",
+	0x0000FDF6: "\u0631\u0633\u0648\u0644",
+	0x0000FDFC: "\u0631\u0649l\u0644",
+	0x0001EE06: "\u0632",
+	0x0001EE86: "\u0632",
+	0x0001EEA6: "\u0632",
+	0x0000FEB0: "\u0632",
+	0x0000FEAF: "\u0632",
+	0x000008B2: "\u0632\u0302",
+	0x00000771: "\u0697\u0615",
+	0x0001EE0E: "\u0633",
+	0x0001EE2E: "\u0633",
+	0x0001EE4E: "\u0633",
+	0x0001EE6E: "\u0633",
+	0x0001EE8E: "\u0633",
+	0x0001EEAE: "\u0633",
+	0x0000FEB3: "\u0633",
+	0x0000FEB4: "\u0633",
+	0x0000FEB2: "\u0633",
+	0x0000FEB1: "\u0633",
+	0x00000634: "\u0633\u06db",
+	0x0001EE14: "\u0633\u06db",
+	0x0001EE34: "\u0633\u06db",
+	0x0001EE54: "\u0633\u06db",
+	0x0001EE74: "\u0633\u06db",
+	0x0001EE94: "\u0633\u06db",
+	0x0001EEB4: "\u0633\u06db",
+	0x0000FEB7: "\u0633\u06db",
+	0x0000FEB8: "\u0633\u06db",
+	0x0000FEB6: "\u0633\u06db",
+	0x0000FEB5: "\u0633\u06db",
+	0x0000077E: "\u0633\u0302",
+	0x0000FD31: "\u0633o",
+	0x0000FCE8: "\u0633o",
+	0x0000FD32: "\u0633\u06dbo",
+	0x0000FCEA: "\u0633\u06dbo",
+	0x0000FCAD: "\u0633\u062c",
+	0x0000FD34: "\u0633\u062c",
+	0x0000FC1C: "\u0633\u062c",
+	0x0000FD2D: "\u0633\u06db\u062c",
+	0x0000FD37: "\u0633\u06db\u062c",
+	0x0000FD25: "\u0633\u06db\u062c",
+	0x0000FD09: "\u0633\u06db\u062c",
+	0x0000FD5D: "\u0633\u062c\u062d",
+	0x0000FD5E: "\u0633\u062c\u0649",
+	0x0000FD69: "\u0633\u06db\u062c\u0649",
+	0x0000FCAE: "\u0633\u062d",
+	0x0000FD35: "\u0633\u062d",
+	0x0000FC1D: "\u0633\u062d",
+	0x0000FD2E: "\u0633\u06db\u062d",
+	0x0000FD38: "\u0633\u06db\u062d",
+	0x0000FD26: "\u0633\u06db\u062d",
+	0x0000FD0A: "\u0633\u06db\u062d",
+	0x0000FD5C: "\u0633\u062d\u062c",
+	0x0000FD68: "\u0633\u06db\u062d\u0645",
+	0x0000FD67: "\u0633\u06db\u062d\u0645",
+	0x0000FDAA: "\u0633\u06db\u062d\u0649",
+	0x0000FCAF: "\u0633\u062e",
+	0x0000FD36: "\u0633\u062e",
+	0x0000FC1E: "\u0633\u062e",
+	0x0000FD2F: "\u0633\u06db\u062e",
+	0x0000FD39: "\u0633\u06db\u062e",
+	0x0000FD27: "\u0633\u06db\u062e",
+	0x0000FD0B: "\u0633\u06db\u062e",
+	0x0000FDA8: "\u0633\u062e\u0649",
+	0x0000FDC6: "\u0633\u062e\u0649",
+	0x0000FD2A: "\u0633\u0631",
+	0x0000FD0E: "\u0633\u0631",
+	0x0000FD29: "\u0633\u06db\u0631",
+	0x0000FD0D: "\u0633\u06db\u0631",
+	0x0000FCB0: "\u0633\u0645",
+	0x0000FCE7: "\u0633\u0645",
+	0x0000FC1F: "\u0633\u0645",
+	0x0000FD30: "\u0633\u06db\u0645",
+	0x0000FCE9: "\u0633\u06db\u0645",
+	0x0000FD28: "\u0633\u06db\u0645",
+	0x0000FD0C: "\u0633\u06db\u0645",
+	0x0000FD61: "\u0633\u0645\u062c",
+	0x0000FD60: "\u0633\u0645\u062d",
+	0x0000FD5F: "\u0633\u0645\u062d",
+	0x0000FD6B: "\u0633\u06db\u0645\u062e",
+	0x0000FD6A: "\u0633\u06db\u0645\u062e",
+	0x0000FD63: "\u0633\u0645\u0645",
+	0x0000FD62: "\u0633\u0645\u0645",
+	0x0000FD6D: "\u0633\u06db\u0645\u0645",
+	0x0000FD6C: "\u0633\u06db\u0645\u0645",
+	0x0000FD17: "\u0633\u0649",
+	0x0000FCFB: "\u0633\u0649",
+	0x0000FD18: "\u0633\u0649",
+	0x0000FCFC: "\u0633\u0649",
+	0x0000FD19: "\u0633\u06db\u0649",
+	0x0000FCFD: "\u0633\u06db\u0649",
+	0x0000FD1A: "\u0633\u06db\u0649",
+	0x0000FCFE: "\u0633\u06db\u0649",
+	0x000102F2: "\u0635",
+	0x0001EE11: "\u0635",
+	0x0001EE31: "\u0635",
+	0x0001EE51: "\u0635",
+	0x0001EE71: "\u0635",
+	0x0001EE91: "\u0635",
+	0x0001EEB1: "\u0635",
+	0x0000FEBB: "\u0635",
+	0x0000FEBC: "\u0635",
+	0x0000FEBA: "\u0635",
+	0x0000FEB9: "\u0635",
+	0x0000069E: "\u0635\u06db",
+	0x000008AF: "\u0635\u0324\u0323",
+	0x0000FCB1: "\u0635\u062d",
+	0x0000FC20: "\u0635\u062d",
+	0x0000FD65: "\u0635\u062d\u062d",
+	0x0000FD64: "\u0635\u062d\u062d",
+	0x0000FDA9: "\u0635\u062d\u0649",
+	0x0000FCB2: "\u0635\u062e",
+	0x0000FD2B: "\u0635\u0631",
+	0x0000FD0F: "\u0635\u0631",
+	0x0000FDF5: "\u0635\u0644\u0639\u0645",
+	0x0000FDF9: "\u0635\u0644\u0649",
+	0x0000FDF0: "\u0635\u0644\u0649",
+	0x0000FDFA: "\u0635\u0644\u0649 l\u0644\u0644o \u0639\u0644\u0649o \u0648\u0633\u0644\u0645",
+	0x0000FCB3: "\u0635\u0645",
+	0x0000FC21: "\u0635\u0645",
+	0x0000FDC5: "\u0635\u0645\u0645",
+	0x0000FD66: "\u0635\u0645\u0645",
+	0x0000FD21: "\u0635\u0649",
+	0x0000FD05: "\u0635\u0649",
+	0x0000FD22: "\u0635\u0649",
+	0x0000FD06: "\u0635\u0649",
+	0x0001EE19: "\u0636",
+	0x0001EE39: "\u0636",
+	0x0001EE59: "\u0636",
+	0x0001EE79: "\u0636",
+	0x0001EE99: "\u0636",
+	0x0001EEB9: "\u0636",
+	0x0000FEBF: "\u0636",
+	0x0000FEC0: "\u0636",
+	0x0000FEBE: "\u0636",
+	0x0000FEBD: "\u0636",
+	0x0000FCB4: "\u0636\u062c",
+	0x0000FC22: "\u0636\u062c",
+	0x0000FCB5: "\u0636\u062d",
+	0x0000FC23: "\u0636\u062d",
+	0x0000FD6E: "\u0636\u062d\u0649",
+	0x0000FDAB: "\u0636\u062d\u0649",
+	0x0000FCB6: "\u0636\u062e",
+	0x0000FC24: "\u0636\u062e",
+	0x0000FD70: "\u0636\u062e\u0645",
+	0x0000FD6F: "\u0636\u062e\u0645",
+	0x0000FD2C: "\u0636\u0631",
+	0x0000FD10: "\u0636\u0631",
+	0x0000FCB7: "\u0636\u0645",
+	0x0000FC25: "\u0636\u0645",
+	0x0000FD23: "\u0636\u0649",
+	0x0000FD07: "\u0636\u0649",
+	0x0000FD24: "\u0636\u0649",
+	0x0000FD08: "\u0636\u0649",
+	0x000102E8: "\u0637",
+	0x0001EE08: "\u0637",
+	0x0001EE68: "\u0637",
+	0x0001EE88: "\u0637",
+	0x0001EEA8: "\u0637",
+	0x0000FEC3: "\u0637",
+	0x0000FEC4: "\u0637",
+	0x0000FEC2: "\u0637",
+	0x0000FEC1: "\u0637",
+	0x0000069F: "\u0637\u06db",
+	0x0000FCB8: "\u0637\u062d",
+	0x0000FC26: "\u0637\u062d",
+	0x0000FD33: "\u0637\u0645",
+	0x0000FD3A: "\u0637\u0645",
+	0x0000FC27: "\u0637\u0645",
+	0x0000FD72: "\u0637\u0645\u062d",
+	0x0000FD71: "\u0637\u0645\u062d",
+	0x0000FD73: "\u0637\u0645\u0645",
+	0x0000FD74: "\u0637\u0645\u0649",
+	0x0000FD11: "\u0637\u0649",
+	0x0000FCF5: "\u0637\u0649",
+	0x0000FD12: "\u0637\u0649",
+	0x0000FCF6: "\u0637\u0649",
+	0x0001EE1A: "\u0638",
+	0x0001EE7A: "\u0638",
+	0x0001EE9A: "\u0638",
+	0x0001EEBA: "\u0638",
+	0x0000FEC7: "\u0638",
+	0x0000FEC8: "\u0638",
+	0x0000FEC6: "\u0638",
+	0x0000FEC5: "\u0638",
+	0x0000FCB9: "\u0638\u0645",
+	0x0000FD3B: "\u0638\u0645",
+	0x0000FC28: "\u0638\u0645",
+	0x0000060F: "\u0639",
+	0x0001EE0F: "\u0639",
+	0x0001EE2F: "\u0639",
+	0x0001EE4F: "\u0639",
+	0x0001EE6F: "\u0639",
+	0x0001EE8F: "\u0639",
+	0x0001EEAF: "\u0639",
+	0x0000FECB: "\u0639",
+	0x0000FECC: "\u0639",
+	0x0000FECA: "\u0639",
+	0x0000FEC9: "\u0639",
+	0x0000FCBA: "\u0639\u062c",
+	0x0000FC29: "\u0639\u062c",
+	0x0000FDC4: "\u0639\u062c\u0645",
+	0x0000FD75: "\u0639\u062c\u0645",
+	0x0000FDF7: "\u0639\u0644\u0649o",
+	0x0000FCBB: "\u0639\u0645",
+	0x0000FC2A: "\u0639\u0645",
+	0x0000FD77: "\u0639\u0645\u0645",
+	0x0000FD76: "\u0639\u0645\u0645",
+	0x0000FD78: "\u0639\u0645\u0649",
+	0x0000FDB6: "\u0639\u0645\u0649",
+	0x0000FD13: "\u0639\u0649",
+	0x0000FCF7: "\u0639\u0649",
+	0x0000FD14: "\u0639\u0649",
+	0x0000FCF8: "\u0639\u0649",
+	0x0001EE1B: "\u063a",
+	0x0001EE3B: "\u063a",
+	0x0001EE5B: "\u063a",
+	0x0001EE7B: "\u063a",
+	0x0001EE9B: "\u063a",
+	0x0001EEBB: "\u063a",
+	0x0000FECF: "\u063a",
+	0x0000FED0: "\u063a",
+	0x0000FECE: "\u063a",
+	0x0000FECD: "\u063a",
+	0x0000FCBC: "\u063a\u062c",
+	0x0000FC2B: "\u063a\u062c",
+	0x0000FCBD: "\u063a\u0645",
+	0x0000FC2C: "\u063a\u0645",
+	0x0000FD79: "\u063a\u0645\u0645",
+	0x0000FD7B: "\u063a\u0645\u0649",
+	0x0000FD7A: "\u063a\u0645\u0649",
+	0x0000FD15: "\u063a\u0649",
+	0x0000FCF9: "\u063a\u0649",
+	0x0000FD16: "\u063a\u0649",
+	0x0000FCFA: "\u063a\u0649",
+	0x0001EE10: "\u0641",
+	0x0001EE30: "\u0641",
+	0x0001EE70: "\u0641",
+	0x0001EE90: "\u0641",
+	0x0001EEB0: "\u0641",
+	0x0000FED3: "\u0641",
+	0x0000FED4: "\u0641",
+	0x0000FED2: "\u0641",
+	0x0000FED1: "\u0641",
+	0x000006A7: "\u0641",
+	0x0000FCBE: "\u0641\u062c",
+	0x0000FC2D: "\u0641\u062c",
+	0x0000FCBF: "\u0641\u062d",
+	0x0000FC2E: "\u0641\u062d",
+	0x0000FCC0: "\u0641\u062e",
+	0x0000FC2F: "\u0641\u062e",
+	0x0000FD7D: "\u0641\u062e\u0645",
+	0x0000FD7C: "\u0641\u062e\u0645",
+	0x0000FCC1: "\u0641\u0645",
+	0x0000FC30: "\u0641\u0645",
+	0x0000FDC1: "\u0641\u0645\u0649",
+	0x0000FC7C: "\u0641\u0649",
+	0x0000FC31: "\u0641\u0649",
+	0x0000FC7D: "\u0641\u0649",
+	0x0000FC32: "\u0641\u0649",
+	0x0001EE1E: "\u06a1",
+	0x0001EE7E: "\u06a1",
+	0x000008BB: "\u06a1",
+	0x0000066F: "\u06a1",
+	0x0001EE1F: "\u06a1",
+	0x0001EE5F: "\u06a1",
+	0x000008BC: "\u06a1",
+	0x000006A4: "\u06a1\u06db",
+	0x0000FB6C: "\u06a1\u06db",
+	0x0000FB6D: "\u06a1\u06db",
+	0x0000FB6B: "\u06a1\u06db",
+	0x0000FB6A: "\u06a1\u06db",
+	0x000006A8: "\u06a1\u06db",
+	0x000008A4: "\u06a2\u06db",
+	0x0000FB70: "\u06a6",
+	0x0000FB71: "\u06a6",
+	0x0000FB6F: "\u06a6",
+	0x0000FB6E: "\u06a6",
+	0x0001EE12: "\u0642",
+	0x0001EE32: "\u0642",
+	0x0001EE52: "\u0642",
+	0x0001EE72: "\u0642",
+	0x0001EE92: "\u0642",
+	0x0001EEB2: "\u0642",
+	0x0000FED7: "\u0642",
+	0x0000FED8: "\u0642",
+	0x0000FED6: "\u0642",
+	0x0000FED5: "\u0642",
+	0x0000FCC2: "\u0642\u062d",
+	0x0000FC33: "\u0642\u062d",
+	0x0000FDF1: "\u0642\u0644\u0649",
+	0x0000FCC3: "\u0642\u0645",
+	0x0000FC34: "\u0642\u0645",
+	0x0000FDB4: "\u0642\u0645\u062d",
+	0x0000FD7E: "\u0642\u0645\u062d",
+	0x0000FD7F: "\u0642\u0645\u0645",
+	0x0000FDB2: "\u0642\u0645\u0649",
+	0x0000FC7E: "\u0642\u0649",
+	0x0000FC35: "\u0642\u0649",
+	0x0000FC7F: "\u0642\u0649",
+	0x0000FC36: "\u0642\u0649",
+	0x0001EE0A: "\u0643",
+	0x0001EE2A: "\u0643",
+	0x0001EE6A: "\u0643",
+	0x0000FEDB: "\u0643",
+	0x0000FEDC: "\u0643",
+	0x0000FEDA: "\u0643",
+	0x0000FED9: "\u0643",
+	0x000006A9: "\u0643",
+	0x0000FB90: "\u0643",
+	0x0000FB91: "\u0643",
+	0x0000FB8F: "\u0643",
+	0x0000FB8E: "\u0643",
+	0x000006AA: "\u0643",
+	0x000006AD: "\u0643\u06db",
+	0x0000FBD5: "\u0643\u06db",
+	0x0000FBD6: "\u0643\u06db",
+	0x0000FBD4: "\u0643\u06db",
+	0x0000FBD3: "\u0643\u06db",
+	0x00000763: "\u0643\u06db",
+	0x0000FC80: "\u0643l",
+	0x0000FC37: "\u0643l",
+	0x0000FCC4: "\u0643\u062c",
+	0x0000FC38: "\u0643\u062c",
+	0x0000FCC5: "\u0643\u062d",
+	0x0000FC39: "\u0643\u062d",
+	0x0000FCC6: "\u0643\u062e",
+	0x0000FC3A: "\u0643\u062e",
+	0x0000FCC7: "\u0643\u0644",
+	0x0000FCEB: "\u0643\u0644",
+	0x0000FC81: "\u0643\u0644",
+	0x0000FC3B: "\u0643\u0644",
+	0x0000FCC8: "\u0643\u0645",
+	0x0000FCEC: "\u0643\u0645",
+	0x0000FC82: "\u0643\u0645",
+	0x0000FC3C: "\u0643\u0645",
+	0x0000FDC3: "\u0643\u0645\u0645",
+	0x0000FDBB: "\u0643\u0645\u0645",
+	0x0000FDB7: "\u0643\u0645\u0649",
+	0x0000FC83: "\u0643\u0649",
+	0x0000FC3D: "\u0643\u0649",
+	0x0000FC84: "\u0643\u0649",
+	0x0000FC3E: "\u0643\u0649",
+	0x00000762: "\u06ac",
+	0x0000FB94: "\u06af",
+	0x0000FB95: "\u06af",
+	0x0000FB93: "\u06af",
+	0x0000FB92: "\u06af",
+	0x000008B0: "\u06af",
+	0x000006B4: "\u06af\u06db",
+	0x0000FB9C: "\u06b1",
+	0x0000FB9D: "\u06b1",
+	0x0000FB9B: "\u06b1",
+	0x0000FB9A: "\u06b1",
+	0x0000FB98: "\u06b3",
+	0x0000FB99: "\u06b3",
+	0x0000FB97: "\u06b3",
+	0x0000FB96: "\u06b3",
+	0x0001EE0B: "\u0644",
+	0x0001EE2B: "\u0644",
+	0x0001EE4B: "\u0644",
+	0x0001EE8B: "\u0644",
+	0x0001EEAB: "\u0644",
+	0x0000FEDF: "\u0644",
+	0x0000FEE0: "\u0644",
+	0x0000FEDE: "\u0644",
+	0x0000FEDD: "\u0644",
+	0x000006B7: "\u0644\u06db",
+	0x000006B5: "\u0644\u0306",
+	0x0000FEFC: "\u0644l",
+	0x0000FEFB: "\u0644l",
+	0x0000FEFA: "\u0644l\u0655",
+	0x0000FEF9: "\u0644l\u0655",
+	0x0000FEF8: "\u0644l\u0674",
+	0x0000FEF7: "\u0644l\u0674",
+	0x0000FCCD: "\u0644o",
+	0x0000FEF6: "\u0644\u0622",
+	0x0000FEF5: "\u0644\u0622",
+	0x0000FCC9: "\u0644\u062c",
+	0x0000FC3F: "\u0644\u062c",
+	0x0000FD83: "\u0644\u062c\u062c",
+	0x0000FD84: "\u0644\u062c\u062c",
+	0x0000FDBA: "\u0644\u062c\u0645",
+	0x0000FDBC: "\u0644\u062c\u0645",
+	0x0000FDAC: "\u0644\u062c\u0649",
+	0x0000FCCA: "\u0644\u062d",
+	0x0000FC40: "\u0644\u062d",
+	0x0000FDB5: "\u0644\u062d\u0645",
+	0x0000FD80: "\u0644\u062d\u0645",
+	0x0000FD82: "\u0644\u062d\u0649",
+	0x0000FD81: "\u0644\u062d\u0649",
+	0x0000FCCB: "\u0644\u062e",
+	0x0000FC41: "\u0644\u062e",
+	0x0000FD86: "\u0644\u062e\u0645",
+	0x0000FD85: "\u0644\u062e\u0645",
+	0x0000FCCC: "\u0644\u0645",
+	0x0000FCED: "\u0644\u0645",
+	0x0000FC85: "\u0644\u0645",
+	0x0000FC42: "\u0644\u0645",
+	0x0000FD88: "\u0644\u0645\u062d",
+	0x0000FD87: "\u0644\u0645\u062d",
+	0x0000FDAD: "\u0644\u0645\u0649",
+	0x0000FC86: "\u0644\u0649",
+	0x0000FC43: "\u0644\u0649",
+	0x0000FC87: "\u0644\u0649",
+	0x0000FC44: "\u0644\u0649",
+	0x0001EE0C: "\u0645",
+	0x0001EE2C: "\u0645",
+	0x0001EE6C: "\u0645",
+	0x0001EE8C: "\u0645",
+	0x0001EEAC: "\u0645",
+	0x0000FEE3: "\u0645",
+	0x0000FEE4: "\u0645",
+	0x0000FEE2: "\u0645",
+	0x0000FEE1: "\u0645",
+	0x000008A7: "\u0645\u06db",
+	0x000006FE: "\u0645\u0348",
+	0x0000FC88: "\u0645l",
+	0x0000FCCE: "\u0645\u062c",
+	0x0000FC45: "\u0645\u062c",
+	0x0000FD8C: "\u0645\u062c\u062d",
+	0x0000FD92: "\u0645\u062c\u062e",
+	0x0000FD8D: "\u0645\u062c\u0645",
+	0x0000FDC0: "\u0645\u062c\u0649",
+	0x0000FCCF: "\u0645\u062d",
+	0x0000FC46: "\u0645\u062d",
+	0x0000FD89: "\u0645\u062d\u062c",
+	0x0000FD8A: "\u0645\u062d\u0645",
+	0x0000FDF4: "\u0645\u062d\u0645\u062f",
+	0x0000FD8B: "\u0645\u062d\u0649",
+	0x0000FCD0: "\u0645\u062e",
+	0x0000FC47: "\u0645\u062e",
+	0x0000FD8E: "\u0645\u062e\u062c",
+	0x0000FD8F: "\u0645\u062e\u0645",
+	0x0000FDB9: "\u0645\u062e\u0649",
+	0x0000FCD1: "\u0645\u0645",
+	0x0000FC89: "\u0645\u0645",
+	0x0000FC48: "\u0645\u0645",
+	0x0000FDB1: "\u0645\u0645\u0649",
+	0x0000FC49: "\u0645\u0649",
+	0x0000FC4A: "\u0645\u0649",
+	0x0001EE0D: "\u0646",
+	0x0001EE2D: "\u0646",
+	0x0001EE4D: "\u0646",
+	0x0001EE6D: "\u0646",
+	0x0001EE8D: "\u0646",
+	0x0001EEAD: "\u0646",
+	0x0000FEE7: "\u0646",
+	0x0000FEE8: "\u0646",
+	0x0000FEE6: "\u0646",
+	0x0000FEE5: "\u0646",
+	0x00000768: "\u0646\u0615",
+	0x00000769: "\u0646\u0306",
+	0x0000FCD6: "\u0646o",
+	0x0000FCEF: "\u0646o",
+	0x0000FDB8: "\u0646\u062c\u062d",
+	0x0000FDBD: "\u0646\u062c\u062d",
+	0x0000FD98: "\u0646\u062c\u0645",
+	0x0000FD97: "\u0646\u062c\u0645",
+	0x0000FD99: "\u0646\u062c\u0649",
+	0x0000FDC7: "\u0646\u062c\u0649",
+	0x0000FCD3: "\u0646\u062d",
+	0x0000FC4C: "\u0646\u062d",
+	0x0000FD95: "\u0646\u062d\u0645",
+	0x0000FD96: "\u0646\u062d\u0649",
+	0x0000FDB3: "\u0646\u062d\u0649",
+	0x0000FCD4: "\u0646\u062e",
+	0x0000FC4D: "\u0646\u062e",
+	0x0000FC8A: "\u0646\u0631",
+	0x0000FC8B: "\u0646\u0632",
+	0x0000FCD5: "\u0646\u0645",
+	0x0000FCEE: "\u0646\u0645",
+	0x0000FC8C: "\u0646\u0645",
+	0x0000FC4E: "\u0646\u0645",
+	0x0000FD9B: "\u0646\u0645\u0649",
+	0x0000FD9A: "\u0646\u0645\u0649",
+	0x0000FC8D: "\u0646\u0646",
+	0x0000FC8E: "\u0646\u0649",
+	0x0000FC4F: "\u0646\u0649",
+	0x0000FC8F: "\u0646\u0649",
+	0x0000FC50: "\u0646\u0649",
+	0x000006C2: "\u06c0",
+	0x0000FBA5: "\u06c0",
+	0x0000FBA4: "\u06c0",
+	0x000102E4: "\u0648",
+	0x0001EE05: "\u0648",
+	0x0001EE85: "\u0648",
+	0x0001EEA5: "\u0648",
+	0x0000FEEE: "\u0648",
+	0x0000FEED: "\u0648",
+	0x000008B1: "\u0648",
+	0x000006CB: "\u0648\u06db",
+	0x0000FBDF: "\u0648\u06db",
+	0x0000FBDE: "\u0648\u06db",
+	0x000006C7: "\u0648\u0313",
+	0x0000FBD8: "\u0648\u0313",
+	0x0000FBD7: "\u0648\u0313",
+	0x000006C6: "\u0648\u0306",
+	0x0000FBDA: "\u0648\u0306",
+	0x0000FBD9: "\u0648\u0306",
+	0x000006C9: "\u0648\u0302",
+	0x0000FBE3: "\u0648\u0302",
+	0x0000FBE2: "\u0648\u0302",
+	0x000006C8: "\u0648\u0670",
+	0x0000FBDC: "\u0648\u0670",
+	0x0000FBDB: "\u0648\u0670",
+	0x00000624: "\u0648\u0674",
+	0x0000FE86: "\u0648\u0674",
+	0x0000FE85: "\u0648\u0674",
+	0x00000676: "\u0648\u0674",
+	0x00000677: "\u0648\u0313\u0674",
+	0x0000FBDD: "\u0648\u0313\u0674",
+	0x0000FDF8: "\u0648\u0633\u0644\u0645",
+	0x0000FBE1: "\u06c5",
+	0x0000FBE0: "\u06c5",
+	0x0000066E: "\u0649",
+	0x0001EE1C: "\u0649",
+	0x0001EE7C: "\u0649",
+	0x000006BA: "\u0649",
+	0x0001EE1D: "\u0649",
+	0x0001EE5D: "\u0649",
+	0x0000FB9F: "\u0649",
+	0x0000FB9E: "\u0649",
+	0x000008BD: "\u0649",
+	0x0000FBE8: "\u0649",
+	0x0000FBE9: "\u0649",
+	0x0000FEF0: "\u0649",
+	0x0000FEEF: "\u0649",
+	0x0000064A: "\u0649",
+	0x0001EE09: "\u0649",
+	0x0001EE29: "\u0649",
+	0x0001EE49: "\u0649",
+	0x0001EE69: "\u0649",
+	0x0001EE89: "\u0649",
+	0x0001EEA9: "\u0649",
+	0x0000FEF3: "\u0649",
+	0x0000FEF4: "\u0649",
+	0x0000FEF2: "\u0649",
+	0x0000FEF1: "\u0649",
+	0x000006CC: "\u0649",
+	0x0000FBFE: "\u0649",
+	0x0000FBFF: "\u0649",
+	0x0000FBFD: "\u0649",
+	0x0000FBFC: "\u0649",
+	0x000006D2: "\u0649",
+	0x0000FBAF: "\u0649",
+	0x0000FBAE: "\u0649",
+	0x00000679: "\u0649\u0615",
+	0x0000FB68: "\u0649\u0615",
+	0x0000FB69: "\u0649\u0615",
+	0x0000FB67: "\u0649\u0615",
+	0x0000FB66: "\u0649\u0615",
+	0x000006BB: "\u0649\u0615",
+	0x0000FBA2: "\u0649\u0615",
+	0x0000FBA3: "\u0649\u0615",
+	0x0000FBA1: "\u0649\u0615",
+	0x0000FBA0: "\u0649\u0615",
+	0x0000067E: "\u0649\u06db",
+	0x0000FB58: "\u0649\u06db",
+	0x0000FB59: "\u0649\u06db",
+	0x0000FB57: "\u0649\u06db",
+	0x0000FB56: "\u0649\u06db",
+	0x0000062B: "\u0649\u06db",
+	0x0001EE16: "\u0649\u06db",
+	0x0001EE36: "\u0649\u06db",
+	0x0001EE76: "\u0649\u06db",
+	0x0001EE96: "\u0649\u06db",
+	0x0001EEB6: "\u0649\u06db",
+	0x0000FE9B: "\u0649\u06db",
+	0x0000FE9C: "\u0649\u06db",
+	0x0000FE9A: "\u0649\u06db",
+	0x0000FE99: "\u0649\u06db",
+	0x000006BD: "\u0649\u06db",
+	0x000006D1: "\u0649\u06db",
+	0x0000063F: "\u0649\u06db",
+	0x000008B7: "\u0649\u06db\u06e2",
+	0x00000756: "\u0649\u0306",
+	0x000006CE: "\u0649\u0306",
+	0x000008BA: "\u0649\u0306\u0307",
+	0x0000063D: "\u0649\u0302",
+	0x000008A8: "\u0649\u0654",
+	0x0000FC90: "\u0649\u0670",
+	0x0000FC5D: "\u0649\u0670",
+	0x0000FCDE: "\u0649o",
+	0x0000FCF1: "\u0649o",
+	0x0000FCE6: "\u0649\u06dbo",
+	0x00000626: "\u0649\u0674",
+	0x0000FE8B: "\u0649\u0674",
+	0x0000FE8C: "\u0649\u0674",
+	0x0000FE8A: "\u0649\u0674",
+	0x0000FE89: "\u0649\u0674",
+	0x00000678: "\u0649\u0674",
+	0x0000FBEB: "\u0649\u0674l",
+	0x0000FBEA: "\u0649\u0674l",
+	0x0000FC9B: "\u0649\u0674o",
+	0x0000FCE0: "\u0649\u0674o",
+	0x0000FBED: "\u0649\u0674o",
+	0x0000FBEC: "\u0649\u0674o",
+	0x0000FBF8: "\u0649\u0674\u067b",
+	0x0000FBF7: "\u0649\u0674\u067b",
+	0x0000FBF6: "\u0649\u0674\u067b",
+	0x0000FC97: "\u0649\u0674\u062c",
+	0x0000FC00: "\u0649\u0674\u062c",
+	0x0000FC98: "\u0649\u0674\u062d",
+	0x0000FC01: "\u0649\u0674\u062d",
+	0x0000FC99: "\u0649\u0674\u062e",
+	0x0000FC64: "\u0649\u0674\u0631",
+	0x0000FC65: "\u0649\u0674\u0632",
+	0x0000FC9A: "\u0649\u0674\u0645",
+	0x0000FCDF: "\u0649\u0674\u0645",
+	0x0000FC66: "\u0649\u0674\u0645",
+	0x0000FC02: "\u0649\u0674\u0645",
+	0x0000FC67: "\u0649\u0674\u0646",
+	0x0000FBEF: "\u0649\u0674\u0648",
+	0x0000FBEE: "\u0649\u0674\u0648",
+	0x0000FBF1: "\u0649\u0674\u0648\u0313",
+	0x0000FBF0: "\u0649\u0674\u0648\u0313",
+	0x0000FBF3: "\u0649\u0674\u0648\u0306",
+	0x0000FBF2: "\u0649\u0674\u0648\u0306",
+	0x0000FBF5: "\u0649\u0674\u0648\u0670",
+	0x0000FBF4: "\u0649\u0674\u0648\u0670",
+	0x0000FBFB: "\u0649\u0674\u0649",
+	0x0000FBFA: "\u0649\u0674\u0649",
+	0x0000FC68: "\u0649\u0674\u0649",
+	0x0000FBF9: "\u0649\u0674\u0649",
+	0x0000FC03: "\u0649\u0674\u0649",
+	0x0000FC69: "\u0649\u0674\u0649",
+	0x0000FC04: "\u0649\u0674\u0649",
+	0x0000FCDA: "\u0649\u062c",
+	0x0000FC55: "\u0649\u062c",
+	0x0000FC11: "\u0649\u06db\u062c",
+	0x0000FDAF: "\u0649\u062c\u0649",
+	0x0000FCDB: "\u0649\u062d",
+	0x0000FC56: "\u0649\u062d",
+	0x0000FDAE: "\u0649\u062d\u0649",
+	0x0000FCDC: "\u0649\u062e",
+	0x0000FC57: "\u0649\u062e",
+	0x0000FC91: "\u0649\u0631",
+	0x0000FC76: "\u0649\u06db\u0631",
+	0x0000FC92: "\u0649\u0632",
+	0x0000FC77: "\u0649\u06db\u0632",
+	0x0000FCDD: "\u0649\u0645",
+	0x0000FCF0: "\u0649\u0645",
+	0x0000FC93: "\u0649\u0645",
+	0x0000FC58: "\u0649\u0645",
+	0x0000FCA6: "\u0649\u06db\u0645",
+	0x0000FCE5: "\u0649\u06db\u0645",
+	0x0000FC78: "\u0649\u06db\u0645",
+	0x0000FC12: "\u0649\u06db\u0645",
+	0x0000FD9D: "\u0649\u0645\u0645",
+	0x0000FD9C: "\u0649\u0645\u0645",
+	0x0000FDB0: "\u0649\u0645\u0649",
+	0x0000FC94: "\u0649\u0646",
+	0x0000FC79: "\u0649\u06db\u0646",
+	0x0000FC95: "\u0649\u0649",
+	0x0000FC59: "\u0649\u0649",
+	0x0000FC96: "\u0649\u0649",
+	0x0000FC5A: "\u0649\u0649",
+	0x0000FC7A: "\u0649\u06db\u0649",
+	0x0000FC13: "\u0649\u06db\u0649",
+	0x0000FC7B: "\u0649\u06db\u0649",
+	0x0000FC14: "\u0649\u06db\u0649",
+	0x0000FBB1: "\u06d3",
+	0x0000FBB0: "\u06d3",
+	0x000102B8: "\u2d40",
+	0x0000205E: "\u2d42",
+	0x00002E3D: "\u2d42",
+	0x00002999: "\u2d42",
+	0x0000FE19: "\u2d57",
+	0x0000205D: "\u2d57",
+	0x000022EE: "\u2d57",
+	0x00000544: "\u1206",
+	0x0000054C: "\u1261",
+	0x0000053B: "\u12ae",
+	0x0000054A: "\u1323",
+	0x00000906: "\u0905\u093e",
+	0x00000912: "\u0905\u093e\u0946",
+	0x00000913: "\u0905\u093e\u0947",
+	0x00000914: "\u0905\u093e\u0948",
+	0x00000904: "\u0905\u0946",
+	0x00000911: "\u0905\u0949",
+	0x0000090D: "\u090f\u0945",
+	0x0000090E: "\u090f\u0946",
+	0x00000910: "\u090f\u0947",
+	0x00000908: "\u0930\u094d\u0907",
+	0x00000ABD: "\u093d",
+	0x000111DC: "\ua8fb",
+	0x000111CB: "\u093a",
+	0x00000AC1: "\u0941",
+	0x00000AC2: "\u0942",
+	0x00000A4B: "\u0946",
+	0x00000A4D: "\u094d",
+	0x00000ACD: "\u094d",
+	0x00000986: "\u0985\u09be",
+	0x000009E0: "\u098b\u09c3",
+	0x000009E1: "\u098b\u09c3",
+	0x00011492: "\u0998",
+	0x00011494: "\u099a",
+	0x00011496: "\u099c",
+	0x00011498: "\u099e",
+	0x00011499: "\u099f",
+	0x0001149B: "\u09a1",
+	0x000114AA: "\u09a3",
+	0x0001149E: "\u09a4",
+	0x0001149F: "\u09a5",
+	0x000114A0: "\u09a6",
+	0x000114A1: "\u09a7",
+	0x000114A2: "\u09a8",
+	0x000114A3: "\u09aa",
+	0x000114A9: "\u09ac",
+	0x000114A7: "\u09ae",
+	0x000114A8: "\u09af",
+	0x000114AB: "\u09b0",
+	0x0001149D: "\u09b2",
+	0x000114AD: "\u09b7",
+	0x000114AE: "\u09b8",
+	0x000114C4: "\u09bd",
+	0x000114B0: "\u09be",
+	0x000114B1: "\u09bf",
+	0x000114B9: "\u09c7",
+	0x000114BC: "\u09cb",
+	0x000114BE: "\u09cc",
+	0x000114C2: "\u09cd",
+	0x000114BD: "\u09d7",
+	0x00000A09: "\u0a73\u0a41",
+	0x00000A0A: "\u0a73\u0a42",
+	0x00000A06: "\u0a05\u0a3e",
+	0x00000A10: "\u0a05\u0a48",
+	0x00000A14: "\u0a05\u0a4c",
+	0x00000A07: "\u0a72\u0a3f",
+	0x00000A08: "\u0a72\u0a40",
+	0x00000A0F: "\u0a72\u0a47",
+	0x00000A86: "\u0a85\u0abe",
+	0x00000A91: "\u0a85\u0abe\u0ac5",
+	0x00000A93: "\u0a85\u0abe\u0ac7",
+	0x00000A94: "\u0a85\u0abe\u0ac8",
+	0x00000A8D: "\u0a85\u0ac5",
+	0x00000A8F: "\u0a85\u0ac7",
+	0x00000A90: "\u0a85\u0ac8",
+	0x00000B06: "\u0b05\u0b3e",
+	0x00000BEE: "\u0b85",
+	0x00000BB0: "\u0b88",
+	0x00000BBE: "\u0b88",
+	0x00000BEB: "\u0b88\u0bc1",
+	0x00000BE8: "\u0b89",
+	0x00000D09: "\u0b89",
+	0x00000B8A: "\u0b89\u0bb3",
+	0x00000D0A: "\u0b89\u0d57",
+	0x00000BED: "\u0b8e",
+	0x00000BF7: "\u0b8e\u0bb5",
+	0x00000B9C: "\u0b90",
+	0x00000D1C: "\u0b90",
+	0x00000BE7: "\u0b95",
+	0x00000BEA: "\u0b9a",
+	0x00000BEC: "\u0b9a\u0bc1",
+	0x00000BF2: "\u0b9a\u0bc2",
+	0x00000D3A: "\u0b9f\u0bbf",
+	0x00000D23: "\u0ba3",
+	0x00000BFA: "\u0ba8\u0bc0",
+	0x00000BF4: "\u0bae\u0bc0",
+	0x00000BF0: "\u0baf",
+	0x00000D34: "\u0bb4",
+	0x00000BD7: "\u0bb3",
+	0x00000BC8: "\u0ba9",
+	0x00000D36: "\u0bb6",
+	0x00000BF8: "\u0bb7",
+	0x00000D3F: "\u0bbf",
+	0x00000D40: "\u0bbf",
+	0x00000BCA: "\u0bc6\u0b88",
+	0x00000BCC: "\u0bc6\u0bb3",
+	0x00000BCB: "\u0bc7\u0b88",
+	0x00000C85: "\u0c05",
+	0x00000C86: "\u0c06",
+	0x00000C87: "\u0c07",
+	0x00000C60: "\u0c0b\u0c3e",
+	0x00000C61: "\u0c0c\u0c3e",
+	0x00000C92: "\u0c12",
+	0x00000C14: "\u0c12\u0c4c",
+	0x00000C94: "\u0c12\u0c4c",
+	0x00000C13: "\u0c12\u0c55",
+	0x00000C93: "\u0c12\u0c55",
+	0x00000C9C: "\u0c1c",
+	0x00000C9E: "\u0c1e",
+	0x00000C22: "\u0c21\u0323",
+	0x00000CA3: "\u0c23",
+	0x00000C25: "\u0c27\u05bc",
+	0x00000C2D: "\u0c2c\u0323",
+	0x00000CAF: "\u0c2f",
+	0x00000C20: "\u0c30\u05bc",
+	0x00000CB1: "\u0c31",
+	0x00000CB2: "\u0c32",
+	0x00000C37: "\u0c35\u0323",
+	0x00000C39: "\u0c35\u0c3e",
+	0x00000C2E: "\u0c35\u0c41",
+	0x00000C42: "\u0c41\u0c3e",
+	0x00000C44: "\u0c43\u0c3e",
+	0x00000CE1: "\u0c8c\u0cbe",
+	0x00000D08: "\u0d07\u0d57",
+	0x00000D10: "\u0d0e\u0d46",
+	0x00000D13: "\u0d12\u0d3e",
+	0x00000D14: "\u0d12\u0d57",
+	0x00000D61: "\u0d1e",
+	0x00000D6B: "\u0d26\u0d4d\u0d30",
+	0x00000D79: "\u0d28\u0d41",
+	0x00000D0C: "\u0d28\u0d41",
+	0x00000D19: "\u0d28\u0d41",
+	0x00000D6F: "\u0d28\u0d4d",
+	0x00000D7B: "\u0d28\u0d4d",
+	0x00000D6C: "\u0d28\u0d4d\u0d28",
+	0x00000D5A: "\u0d28\u0d4d\u0d2e",
+	0x00000D31: "\u0d30",
+	0x00000D6A: "\u0d30\u0d4d",
+	0x00000D7C: "\u0d30\u0d4d",
+	0x00000D6E: "\u0d35\u0d4d\u0d30",
+	0x00000D76: "\u0d39\u0d4d\u0d2e",
+	0x00000D42: "\u0d41",
+	0x00000D43: "\u0d41",
+	0x00000D48: "\u0d46\u0d46",
+	0x00000DEA: "\u0da2",
+	0x00000DEB: "\u0daf",
+	0x00011413: "\U00011434\U00011442\U00011412",
+	0x00011419: "\U00011434\U00011442\U00011418",
+	0x00011424: "\U00011434\U00011442\U00011423",
+	0x0001142A: "\U00011434\U00011442\U00011429",
+	0x0001142D: "\U00011434\U00011442\U0001142c",
+	0x0001142F: "\U00011434\U00011442\U0001142e",
+	0x000115D8: "\U00011582",
+	0x000115D9: "\U00011582",
+	0x000115DA: "\U00011583",
+	0x000115DB: "\U00011584",
+	0x000115DC: "\U000115b2",
+	0x000115DD: "\U000115b3",
+	0x00000E03: "\u0e02",
+	0x00000E14: "\u0e04",
+	0x00000E15: "\u0e04",
+	0x00000E21: "\u0e06",
+	0x00000E88: "\u0e08",
+	0x00000E0B: "\u0e0a",
+	0x00000E0F: "\u0e0e",
+	0x00000E17: "\u0e11",
+	0x00000E9A: "\u0e1a",
+	0x00000E9B: "\u0e1b",
+	0x00000E9D: "\u0e1d",
+	0x00000E9E: "\u0e1e",
+	0x00000E9F: "\u0e1f",
+	0x00000E26: "\u0e20",
+	0x00000E8D: "\u0e22",
+	0x000017D4: "\u0e2f",
+	0x00000E45: "\u0e32",
+	0x00000E33: "\u030a\u0e32",
+	0x000017B7: "\u0e34",
+	0x000017B8: "\u0e35",
+	0x000017B9: "\u0e36",
+	0x000017BA: "\u0e37",
+	0x00000EB8: "\u0e38",
+	0x00000EB9: "\u0e39",
+	0x00000E41: "\u0e40\u0e40",
+	0x00000EDC: "\u0eab\u0e99",
+	0x00000EDD: "\u0eab\u0ea1",
+	0x00000EB3: "\u030a\u0eb2",
+	0x00000F02: "\u0f60\u0f74\u0f82\u0f7f",
+	0x00000F03: "\u0f60\u0f74\u0f82\u0f14",
+	0x00000F6A: "\u0f62",
+	0x00000F00: "\u0f68\u0f7c\u0f7e",
+	0x00000F77: "\u0fb2\u0f71\u0f80",
+	0x00000F79: "\u0fb3\u0f71\u0f80",
+	0x00011CB2: "\U00011caa",
+	0x00001081: "\u1002\u103e",
+	0x00001000: "\u1002\u102c",
+	0x00001070: "\u1003\u103e",
+	0x00001066: "\u1015\u103e",
+	0x0000101F: "\u1015\u102c",
+	0x0000106F: "\u1015\u102c\u103e",
+	0x0000107E: "\u107d\u103e",
+	0x00001029: "\u101e\u103c",
+	0x0000102A: "\u101e\u103c\u1031\u102c\u103a",
+	0x0000109E: "\u1083\u030a",
+	0x000017A3: "\u17a2",
+	0x000019D0: "\u199e",
+	0x000019D1: "\u19b1",
+	0x00001A80: "\u1a45",
+	0x00001A90: "\u1a45",
+	0x0000AA53: "\uaa01",
+	0x0000AA56: "\uaa23",
+	0x00001B52: "\u1b0d",
+	0x00001B53: "\u1b11",
+	0x00001B58: "\u1b28",
+	0x0000A9A3: "\ua99d",
+	0x00001896: "\u185c",
+	0x00001855: "\u1835",
+	0x00001FF6: "\u13ef",
+	0x0000140D: "\u1401\u00b7",
+	0x0000142B: "\u1401\u1420",
+	0x00001411: "\u1404\u00b7",
+	0x00001413: "\u1405\u00b7",
+	0x0000142D: "\u1405\u1420",
+	0x00001415: "\u1406\u00b7",
+	0x00001418: "\u140a\u00b7",
+	0x0000142E: "\u140a\u1420",
+	0x0000141A: "\u140b\u00b7",
+	0x000018DD: "\u141e\u18df",
+	0x000014D1: "\u1421",
+	0x00001540: "\u1429",
+	0x0000143F: "\u1432\u00b7",
+	0x00001443: "\u1434\u00b7",
+	0x00002369: "\u1435",
+	0x00001447: "\u1439\u00b7",
+	0x0000145C: "\u144f\u00b7",
+	0x00002E27: "\u1450",
+	0x00002283: "\u1450",
+	0x0000145E: "\u1450\u00b7",
+	0x00001469: "\u1450'",
+	0x000027C9: "\u1450/",
+	0x00002AD7: "\u1450\u1455",
+	0x00001460: "\u1451\u00b7",
+	0x00002E26: "\u1455",
+	0x00002282: "\u1455",
+	0x00001462: "\u1455\u00b7",
+	0x0000146A: "\u1455'",
+	0x00001464: "\u1456\u00b7",
+	0x00001475: "\u146b\u00b7",
+	0x00001485: "\u146b'",
+	0x00001479: "\u146e\u00b7",
+	0x0000147D: "\u1470\u00b7",
+	0x00001603: "\u1489",
+	0x00001493: "\u1489\u00b7",
+	0x00001495: "\u148b\u00b7",
+	0x00001497: "\u148c\u00b7",
+	0x0000149B: "\u148e\u00b7",
+	0x00001602: "\u1490",
+	0x0000149D: "\u1490\u00b7",
+	0x0000149F: "\u1491\u00b7",
+	0x000014AD: "\u14a3\u00b7",
+	0x000014B1: "\u14a6\u00b7",
+	0x000014B3: "\u14a7\u00b7",
+	0x000014B5: "\u14a8\u00b7",
+	0x000014B9: "\u14ab\u00b7",
+	0x000014CA: "\u14c0\u00b7",
+	0x000018C7: "\u14c2\u00b7",
+	0x000018C9: "\u14c3\u00b7",
+	0x000018CB: "\u14c4\u00b7",
+	0x000018CD: "\u14c5\u00b7",
+	0x000014CC: "\u14c7\u00b7",
+	0x000014CE: "\u14c8\u00b7",
+	0x00001604: "\u14d3",
+	0x000014DD: "\u14d3\u00b7",
+	0x000014DF: "\u14d5\u00b7",
+	0x000014E1: "\u14d6\u00b7",
+	0x000014E3: "\u14d7\u00b7",
+	0x000014E5: "\u14d8\u00b7",
+	0x00001607: "\u14da",
+	0x000014E7: "\u14da\u00b7",
+	0x000014E9: "\u14db\u00b7",
+	0x000014F7: "\u14ed\u00b7",
+	0x000014F9: "\u14ef\u00b7",
+	0x000014FB: "\u14f0\u00b7",
+	0x000014FD: "\u14f1\u00b7",
+	0x000014FF: "\u14f2\u00b7",
+	0x00001501: "\u14f4\u00b7",
+	0x00001503: "\u14f5\u00b7",
+	0x0000150C: "\u150b<",
+	0x0000150E: "\u150bb",
+	0x0000150D: "\u150b\u1455",
+	0x0000150F: "\u150b\u1490",
+	0x00001518: "\u1510\u00b7",
+	0x0000151A: "\u1511\u00b7",
+	0x0000151C: "\u1512\u00b7",
+	0x0000151E: "\u1513\u00b7",
+	0x00001520: "\u1514\u00b7",
+	0x00001522: "\u1515\u00b7",
+	0x00001524: "\u1516\u00b7",
+	0x00001532: "\u1528\u00b7",
+	0x00001534: "\u1529\u00b7",
+	0x00001536: "\u152a\u00b7",
+	0x00001538: "\u152b\u00b7",
+	0x0000153A: "\u152d\u00b7",
+	0x0000153C: "\u152e\u00b7",
+	0x00001622: "\u1543",
+	0x000018E0: "\u1543\u00b7",
+	0x00001623: "\u1546",
+	0x00001624: "\u154a",
+	0x0000154F: "\u154c\u00b7",
+	0x00001583: "\u1550b",
+	0x00001584: "\u1550b\u0307",
+	0x00001581: "\u1550d",
+	0x0000157F: "\u1550P",
+	0x0000166F: "\u1550\u146b",
+	0x0000157E: "\u1550\u146c",
+	0x00001580: "\u1550\u146e",
+	0x00001582: "\u1550\u1470",
+	0x00001585: "\u1550\u1483",
+	0x0000155C: "\u155a\u00b7",
+	0x000018E3: "\u155e\u00b7",
+	0x000018E4: "\u1566\u00b7",
+	0x00001569: "\u1567\u00b7",
+	0x000018E5: "\u156b\u00b7",
+	0x000018E8: "\u1586\u00b7",
+	0x00001591: "\u1595J",
+	0x00001670: "\u1595\u1489",
+	0x0000158E: "\u1595\u148a",
+	0x0000158F: "\u1595\u148b",
+	0x00001590: "\u1595\u148c",
+	0x00001592: "\u1595\u148e",
+	0x00001593: "\u1595\u1490",
+	0x00001594: "\u1595\u1491",
+	0x00001673: "\u1596J",
+	0x00001671: "\u1596\u148b",
+	0x00001672: "\u1596\u148c",
+	0x00001674: "\u1596\u148e",
+	0x00001675: "\u1596\u1490",
+	0x00001676: "\u1596\u1491",
+	0x000018EA: "\u1597\u00b7",
+	0x00001677: "\u15a7\u00b7",
+	0x00001678: "\u15a8\u00b7",
+	0x00001679: "\u15a9\u00b7",
+	0x0000167A: "\u15aa\u00b7",
+	0x0000167B: "\u15ab\u00b7",
+	0x0000167C: "\u15ac\u00b7",
+	0x0000167D: "\u15ad\u00b7",
+	0x00002AAB: "\u15d2",
+	0x00002AAA: "\u15d5",
+	0x0000A4F7: "\u15e1",
+	0x000018F0: "\u15f4\u00b7",
+	0x000018F2: "\u161b\u00b7",
+	0x00001DBB: "\u1646",
+	0x0000A4ED: "\u1660",
+	0x00001DBA: "\u18d4",
+	0x00001D3E: "\u18d6",
+	0x000018DC: "\u18df\u141e",
+	0x000002E1: "\u18f3",
+	0x000002B3: "\u18f4",
+	0x000002E2: "\u18f5",
+	0x000018DB: "\u18f5",
+	0x0000A6B0: "\u16b9",
+	0x000016E1: "\u16bc",
+	0x0000237F: "\u16bd",
+	0x000016C2: "\u16bd",
+	0x0001D23F: "\u16cb",
+	0x00002191: "\u16cf",
+	0x000021BF: "\u16d0",
+	0x0000296E: "\u16d0\u21c2",
+	0x00002963: "\u16d0\u16da",
+	0x00002D63: "\u16ef",
+	0x000021BE: "\u16da",
+	0x00002A21: "\u16da",
+	0x000022C4: "\u16dc",
+	0x000025C7: "\u16dc",
+	0x000025CA: "\u16dc",
+	0x00002662: "\u16dc",
+	0x0001F754: "\u16dc",
+	0x000118B7: "\u16dc",
+	0x00010294: "\u16dc",
+	0x0000235A: "\u16dc\u0332",
+	0x000022C8: "\u16de",
+	0x00002A1D: "\u16de",
+	0x000104D0: "\u16e6",
+	0x00002195: "\u16e8",
+	0x00010CFC: "\U00010c82",
+	0x00010CFA: "\U00010ca5",
+	0x00003131: "\u1100",
+	0x000011A8: "\u1100",
+	0x00001101: "\u1100\u1100",
+	0x00003132: "\u1100\u1100",
+	0x000011A9: "\u1100\u1100",
+	0x000011FA: "\u1100\u1102",
+	0x0000115A: "\u1100\u1103",
+	0x000011C3: "\u1100\u1105",
+	0x000011FB: "\u1100\u1107",
+	0x000011AA: "\u1100\u1109",
+	0x00003133: "\u1100\u1109",
+	0x000011C4: "\u1100\u1109\u1100",
+	0x000011FC: "\u1100\u110e",
+	0x000011FD: "\u1100\u110f",
+	0x000011FE: "\u1100\u1112",
+	0x00003134: "\u1102",
+	0x000011AB: "\u1102",
+	0x00001113: "\u1102\u1100",
+	0x000011C5: "\u1102\u1100",
+	0x00001114: "\u1102\u1102",
+	0x00003165: "\u1102\u1102",
+	0x000011FF: "\u1102\u1102",
+	0x00001115: "\u1102\u1103",
+	0x00003166: "\u1102\u1103",
+	0x000011C6: "\u1102\u1103",
+	0x0000D7CB: "\u1102\u1105",
+	0x00001116: "\u1102\u1107",
+	0x0000115B: "\u1102\u1109",
+	0x000011C7: "\u1102\u1109",
+	0x00003167: "\u1102\u1109",
+	0x0000115C: "\u1102\u110c",
+	0x000011AC: "\u1102\u110c",
+	0x00003135: "\u1102\u110c",
+	0x0000D7CC: "\u1102\u110e",
+	0x000011C9: "\u1102\u1110",
+	0x0000115D: "\u1102\u1112",
+	0x000011AD: "\u1102\u1112",
+	0x00003136: "\u1102\u1112",
+	0x000011C8: "\u1102\u1140",
+	0x00003168: "\u1102\u1140",
+	0x00003137: "\u1103",
+	0x000011AE: "\u1103",
+	0x00001117: "\u1103\u1100",
+	0x000011CA: "\u1103\u1100",
+	0x00001104: "\u1103\u1103",
+	0x00003138: "\u1103\u1103",
+	0x0000D7CD: "\u1103\u1103",
+	0x0000D7CE: "\u1103\u1103\u1107",
+	0x0000115E: "\u1103\u1105",
+	0x000011CB: "\u1103\u1105",
+	0x0000A960: "\u1103\u1106",
+	0x0000A961: "\u1103\u1107",
+	0x0000D7CF: "\u1103\u1107",
+	0x0000A962: "\u1103\u1109",
+	0x0000D7D0: "\u1103\u1109",
+	0x0000D7D1: "\u1103\u1109\u1100",
+	0x0000A963: "\u1103\u110c",
+	0x0000D7D2: "\u1103\u110c",
+	0x0000D7D3: "\u1103\u110e",
+	0x0000D7D4: "\u1103\u1110",
+	0x00003139: "\u1105",
+	0x000011AF: "\u1105",
+	0x0000A964: "\u1105\u1100",
+	0x000011B0: "\u1105\u1100",
+	0x0000313A: "\u1105\u1100",
+	0x0000A965: "\u1105\u1100\u1100",
+	0x0000D7D5: "\u1105\u1100\u1100",
+	0x000011CC: "\u1105\u1100\u1109",
+	0x00003169: "\u1105\u1100\u1109",
+	0x0000D7D6: "\u1105\u1100\u1112",
+	0x00001118: "\u1105\u1102",
+	0x000011CD: "\u1105\u1102",
+	0x0000A966: "\u1105\u1103",
+	0x000011CE: "\u1105\u1103",
+	0x0000316A: "\u1105\u1103",
+	0x0000A967: "\u1105\u1103\u1103",
+	0x000011CF: "\u1105\u1103\u1112",
+	0x00001119: "\u1105\u1105",
+	0x000011D0: "\u1105\u1105",
+	0x0000D7D7: "\u1105\u1105\u110f",
+	0x0000A968: "\u1105\u1106",
+	0x000011B1: "\u1105\u1106",
+	0x0000313B: "\u1105\u1106",
+	0x000011D1: "\u1105\u1106\u1100",
+	0x000011D2: "\u1105\u1106\u1109",
+	0x0000D7D8: "\u1105\u1106\u1112",
+	0x0000A969: "\u1105\u1107",
+	0x000011B2: "\u1105\u1107",
+	0x0000313C: "\u1105\u1107",
+	0x0000D7D9: "\u1105\u1107\u1103",
+	0x0000A96A: "\u1105\u1107\u1107",
+	0x000011D3: "\u1105\u1107\u1109",
+	0x0000316B: "\u1105\u1107\u1109",
+	0x0000A96B: "\u1105\u1107\u110b",
+	0x000011D5: "\u1105\u1107\u110b",
+	0x0000D7DA: "\u1105\u1107\u1111",
+	0x000011D4: "\u1105\u1107\u1112",
+	0x0000A96C: "\u1105\u1109",
+	0x000011B3: "\u1105\u1109",
+	0x0000313D: "\u1105\u1109",
+	0x000011D6: "\u1105\u1109\u1109",
+	0x0000111B: "\u1105\u110b",
+	0x0000D7DD: "\u1105\u110b",
+	0x0000A96D: "\u1105\u110c",
+	0x0000A96E: "\u1105\u110f",
+	0x000011D8: "\u1105\u110f",
+	0x000011B4: "\u1105\u1110",
+	0x0000313E: "\u1105\u1110",
+	0x000011B5: "\u1105\u1111",
+	0x0000313F: "\u1105\u1111",
+	0x0000111A: "\u1105\u1112",
+	0x00003140: "\u1105\u1112",
+	0x0000113B: "\u1105\u1112",
+	0x000011B6: "\u1105\u1112",
+	0x0000D7F2: "\u1105\u1112",
+	0x000011D7: "\u1105\u1140",
+	0x0000316C: "\u1105\u1140",
+	0x0000D7DB: "\u1105\u114c",
+	0x000011D9: "\u1105\u1159",
+	0x0000316D: "\u1105\u1159",
+	0x0000D7DC: "\u1105\u1159\u1112",
+	0x00003141: "\u1106",
+	0x000011B7: "\u1106",
+	0x0000A96F: "\u1106\u1100",
+	0x000011DA: "\u1106\u1100",
+	0x0000D7DE: "\u1106\u1102",
+	0x0000D7DF: "\u1106\u1102\u1102",
+	0x0000A970: "\u1106\u1103",
+	0x000011DB: "\u1106\u1105",
+	0x0000D7E0: "\u1106\u1106",
+	0x0000111C: "\u1106\u1107",
+	0x0000316E: "\u1106\u1107",
+	0x000011DC: "\u1106\u1107",
+	0x0000D7E1: "\u1106\u1107\u1109",
+	0x0000A971: "\u1106\u1109",
+	0x000011DD: "\u1106\u1109",
+	0x0000316F: "\u1106\u1109",
+	0x000011DE: "\u1106\u1109\u1109",
+	0x0000111D: "\u1106\u110b",
+	0x00003171: "\u1106\u110b",
+	0x000011E2: "\u1106\u110b",
+	0x0000D7E2: "\u1106\u110c",
+	0x000011E0: "\u1106\u110e",
+	0x000011E1: "\u1106\u1112",
+	0x000011DF: "\u1106\u1140",
+	0x00003170: "\u1106\u1140",
+	0x00003142: "\u1107",
+	0x000011B8: "\u1107",
+	0x0000111E: "\u1107\u1100",
+	0x00003172: "\u1107\u1100",
+	0x0000111F: "\u1107\u1102",
+	0x00001120: "\u1107\u1103",
+	0x00003173: "\u1107\u1103",
+	0x0000D7E3: "\u1107\u1103",
+	0x000011E3: "\u1107\u1105",
+	0x0000D7E4: "\u1107\u1105\u1111",
+	0x0000D7E5: "\u1107\u1106",
+	0x00001108: "\u1107\u1107",
+	0x00003143: "\u1107\u1107",
+	0x0000D7E6: "\u1107\u1107",
+	0x0000112C: "\u1107\u1107\u110b",
+	0x00003179: "\u1107\u1107\u110b",
+	0x00001121: "\u1107\u1109",
+	0x00003144: "\u1107\u1109",
+	0x000011B9: "\u1107\u1109",
+	0x00001122: "\u1107\u1109\u1100",
+	0x00003174: "\u1107\u1109\u1100",
+	0x00001123: "\u1107\u1109\u1103",
+	0x00003175: "\u1107\u1109\u1103",
+	0x0000D7E7: "\u1107\u1109\u1103",
+	0x00001124: "\u1107\u1109\u1107",
+	0x00001125: "\u1107\u1109\u1109",
+	0x00001126: "\u1107\u1109\u110c",
+	0x0000A972: "\u1107\u1109\u1110",
+	0x0000112B: "\u1107\u110b",
+	0x00003178: "\u1107\u110b",
+	0x000011E6: "\u1107\u110b",
+	0x00001127: "\u1107\u110c",
+	0x00003176: "\u1107\u110c",
+	0x0000D7E8: "\u1107\u110c",
+	0x00001128: "\u1107\u110e",
+	0x0000D7E9: "\u1107\u110e",
+	0x0000A973: "\u1107\u110f",
+	0x00001129: "\u1107\u1110",
+	0x00003177: "\u1107\u1110",
+	0x0000112A: "\u1107\u1111",
+	0x000011E4: "\u1107\u1111",
+	0x0000A974: "\u1107\u1112",
+	0x000011E5: "\u1107\u1112",
+	0x00003145: "\u1109",
+	0x000011BA: "\u1109",
+	0x0000112D: "\u1109\u1100",
+	0x0000317A: "\u1109\u1100",
+	0x000011E7: "\u1109\u1100",
+	0x0000112E: "\u1109\u1102",
+	0x0000317B: "\u1109\u1102",
+	0x0000112F: "\u1109\u1103",
+	0x0000317C: "\u1109\u1103",
+	0x000011E8: "\u1109\u1103",
+	0x00001130: "\u1109\u1105",
+	0x000011E9: "\u1109\u1105",
+	0x00001131: "\u1109\u1106",
+	0x0000D7EA: "\u1109\u1106",
+	0x00001132: "\u1109\u1107",
+	0x0000317D: "\u1109\u1107",
+	0x000011EA: "\u1109\u1107",
+	0x00001133: "\u1109\u1107\u1100",
+	0x0000D7EB: "\u1109\u1107\u110b",
+	0x0000110A: "\u1109\u1109",
+	0x00003146: "\u1109\u1109",
+	0x000011BB: "\u1109\u1109",
+	0x0000D7EC: "\u1109\u1109\u1100",
+	0x0000D7ED: "\u1109\u1109\u1103",
+	0x0000A975: "\u1109\u1109\u1107",
+	0x00001134: "\u1109\u1109\u1109",
+	0x00001135: "\u1109\u110b",
+	0x00001136: "\u1109\u110c",
+	0x0000317E: "\u1109\u110c",
+	0x0000D7EF: "\u1109\u110c",
+	0x00001137: "\u1109\u110e",
+	0x0000D7F0: "\u1109\u110e",
+	0x00001138: "\u1109\u110f",
+	0x00001139: "\u1109\u1110",
+	0x0000D7F1: "\u1109\u1110",
+	0x0000113A: "\u1109\u1111",
+	0x0000D7EE: "\u1109\u1140",
+	0x00003147: "\u110b",
+	0x000011BC: "\u110b",
+	0x00001141: "\u110b\u1100",
+	0x000011EC: "\u110b\u1100",
+	0x000011ED: "\u110b\u1100\u1100",
+	0x00001142: "\u110b\u1103",
+	0x0000A976: "\u110b\u1105",
+	0x00001143: "\u110b\u1106",
+	0x00001144: "\u110b\u1107",
+	0x00001145: "\u110b\u1109",
+	0x000011F1: "\u110b\u1109",
+	0x00003182: "\u110b\u1109",
+	0x00001147: "\u110b\u110b",
+	0x00003180: "\u110b\u110b",
+	0x000011EE: "\u110b\u110b",
+	0x00001148: "\u110b\u110c",
+	0x00001149: "\u110b\u110e",
+	0x000011EF: "\u110b\u110f",
+	0x0000114A: "\u110b\u1110",
+	0x0000114B: "\u110b\u1111",
+	0x0000A977: "\u110b\u1112",
+	0x00001146: "\u110b\u1140",
+	0x000011F2: "\u110b\u1140",
+	0x00003183: "\u110b\u1140",
+	0x00003148: "\u110c",
+	0x000011BD: "\u110c",
+	0x0000D7F7: "\u110c\u1107",
+	0x0000D7F8: "\u110c\u1107\u1107",
+	0x0000114D: "\u110c\u110b",
+	0x0000110D: "\u110c\u110c",
+	0x00003149: "\u110c\u110c",
+	0x0000D7F9: "\u110c\u110c",
+	0x0000A978: "\u110c\u110c\u1112",
+	0x0000314A: "\u110e",
+	0x000011BE: "\u110e",
+	0x00001152: "\u110e\u110f",
+	0x00001153: "\u110e\u1112",
+	0x0000314B: "\u110f",
+	0x000011BF: "\u110f",
+	0x0000314C: "\u1110",
+	0x000011C0: "\u1110",
+	0x0000A979: "\u1110\u1110",
+	0x0000314D: "\u1111",
+	0x000011C1: "\u1111",
+	0x00001156: "\u1111\u1107",
+	0x000011F3: "\u1111\u1107",
+	0x0000D7FA: "\u1111\u1109",
+	0x00001157: "\u1111\u110b",
+	0x00003184: "\u1111\u110b",
+	0x000011F4: "\u1111\u110b",
+	0x0000D7FB: "\u1111\u1110",
+	0x0000A97A: "\u1111\u1112",
+	0x0000314E: "\u1112",
+	0x000011C2: "\u1112",
+	0x000011F5: "\u1112\u1102",
+	0x000011F6: "\u1112\u1105",
+	0x000011F7: "\u1112\u1106",
+	0x000011F8: "\u1112\u1107",
+	0x0000A97B: "\u1112\u1109",
+	0x00001158: "\u1112\u1112",
+	0x00003185: "\u1112\u1112",
+	0x0000113D: "\u113c\u113c",
+	0x0000113F: "\u113e\u113e",
+	0x0000317F: "\u1140",
+	0x000011EB: "\u1140",
+	0x0000D7F3: "\u1140\u1107",
+	0x0000D7F4: "\u1140\u1107\u110b",
+	0x00003181: "\u114c",
+	0x000011F0: "\u114c",
+	0x0000D7F5: "\u114c\u1106",
+	0x0000D7F6: "\u114c\u1112",
+	0x0000114F: "\u114e\u114e",
+	0x00001151: "\u1150\u1150",
+	0x00003186: "\u1159",
+	0x000011F9: "\u1159",
+	0x0000A97C: "\u1159\u1159",
+	0x00003164: "\u1160",
+	0x0000314F: "\u1161",
+	0x000011A3: "\u1161\u30fc",
+	0x00001176: "\u1161\u1169",
+	0x00001177: "\u1161\u116e",
+	0x00001162: "\u1161\u4e28",
+	0x00003150: "\u1161\u4e28",
+	0x00003151: "\u1163",
+	0x00001178: "\u1163\u1169",
+	0x00001179: "\u1163\u116d",
+	0x000011A4: "\u1163\u116e",
+	0x00001164: "\u1163\u4e28",
+	0x00003152: "\u1163\u4e28",
+	0x00003153: "\u1165",
+	0x0000117C: "\u1165\u30fc",
+	0x0000117A: "\u1165\u1169",
+	0x0000117B: "\u1165\u116e",
+	0x00001166: "\u1165\u4e28",
+	0x00003154: "\u1165\u4e28",
+	0x00003155: "\u1167",
+	0x000011A5: "\u1167\u1163",
+	0x0000117D: "\u1167\u1169",
+	0x0000117E: "\u1167\u116e",
+	0x00001168: "\u1167\u4e28",
+	0x00003156: "\u1167\u4e28",
+	0x00003157: "\u1169",
+	0x0000116A: "\u1169\u1161",
+	0x00003158: "\u1169\u1161",
+	0x0000116B: "\u1169\u1161\u4e28",
+	0x00003159: "\u1169\u1161\u4e28",
+	0x000011A6: "\u1169\u1163",
+	0x000011A7: "\u1169\u1163\u4e28",
+	0x0000117F: "\u1169\u1165",
+	0x00001180: "\u1169\u1165\u4e28",
+	0x0000D7B0: "\u1169\u1167",
+	0x00001181: "\u1169\u1167\u4e28",
+	0x00001182: "\u1169\u1169",
+	0x0000D7B1: "\u1169\u1169\u4e28",
+	0x00001183: "\u1169\u116e",
+	0x0000116C: "\u1169\u4e28",
+	0x0000315A: "\u1169\u4e28",
+	0x0000315B: "\u116d",
+	0x0000D7B2: "\u116d\u1161",
+	0x0000D7B3: "\u116d\u1161\u4e28",
+	0x00001184: "\u116d\u1163",
+	0x00003187: "\u116d\u1163",
+	0x00001186: "\u116d\u1163",
+	0x00001185: "\u116d\u1163\u4e28",
+	0x00003188: "\u116d\u1163\u4e28",
+	0x0000D7B4: "\u116d\u1165",
+	0x00001187: "\u116d\u1169",
+	0x00001188: "\u116d\u4e28",
+	0x00003189: "\u116d\u4e28",
+	0x0000315C: "\u116e",
+	0x00001189: "\u116e\u1161",
+	0x0000118A: "\u116e\u1161\u4e28",
+	0x0000116F: "\u116e\u1165",
+	0x0000315D: "\u116e\u1165",
+	0x0000118B: "\u116e\u1165\u30fc",
+	0x00001170: "\u116e\u1165\u4e28",
+	0x0000315E: "\u116e\u1165\u4e28",
+	0x0000D7B5: "\u116e\u1167",
+	0x0000118C: "\u116e\u1167\u4e28",
+	0x0000118D: "\u116e\u116e",
+	0x00001171: "\u116e\u4e28",
+	0x0000315F: "\u116e\u4e28",
+	0x0000D7B6: "\u116e\u4e28\u4e28",
+	0x00003160: "\u1172",
+	0x0000118E: "\u1172\u1161",
+	0x0000D7B7: "\u1172\u1161\u4e28",
+	0x0000118F: "\u1172\u1165",
+	0x00001190: "\u1172\u1165\u4e28",
+	0x00001191: "\u1172\u1167",
+	0x0000318A: "\u1172\u1167",
+	0x00001192: "\u1172\u1167\u4e28",
+	0x0000318B: "\u1172\u1167\u4e28",
+	0x0000D7B8: "\u1172\u1169",
+	0x00001193: "\u1172\u116e",
+	0x00001194: "\u1172\u4e28",
+	0x0000318C: "\u1172\u4e28",
+	0x0000318D: "\u119e",
+	0x0000D7C5: "\u119e\u1161",
+	0x0000119F: "\u119e\u1165",
+	0x0000D7C6: "\u119e\u1165\u4e28",
+	0x000011A0: "\u119e\u116e",
+	0x000011A2: "\u119e\u119e",
+	0x000011A1: "\u119e\u4e28",
+	0x0000318E: "\u119e\u4e28",
+	0x000030D8: "\u3078",
+	0x00002341: "\u303c",
+	0x000029C4: "\u303c",
+	0x0000A49E: "\ua04a",
+	0x0000A4AC: "\ua050",
+	0x0000A49C: "\ua0c0",
+	0x0000A4A8: "\ua132",
+	0x0000A4BF: "\ua259",
+	0x0000A4BE: "\ua2b1",
+	0x0000A494: "\ua2cd",
+	0x0000A4C0: "\ua3ab",
+	0x0000A4C2: "\ua3b5",
+	0x0000A4BA: "\ua3bf",
+	0x0000A4B0: "\ua3c2",
+	0x0000A4A7: "\ua458",
+	0x000022A5: "\ua4d5",
+	0x000027C2: "\ua4d5",
+	0x0001D21C: "\ua4d5",
+	0x0000A7B1: "\ua4d5",
+	0x0000A79E: "\ua4e4",
+	0x00002141: "\ua4e8",
+	0x00002142: "\ua4f6",
+	0x0001D215: "\ua4f6",
+	0x0001D22B: "\ua4f6",
+	0x00016F26: "\ua4f6",
+	0x00010411: "\ua4f6",
+	0x00002143: "\U00016f00",
+	0x00011AE6: "\U00011ae5\U00011aef",
+	0x00011AE8: "\U00011ae5\U00011ae5",
+	0x00011AE9: "\U00011ae5\U00011ae5\U00011aef",
+	0x00011AEA: "\U00011ae5\U00011ae5\U00011af0",
+	0x00011AE7: "\U00011ae5\U00011af0",
+	0x00011AF4: "\U00011af3\U00011aef",
+	0x00011AF6: "\U00011af3\U00011af3",
+	0x00011AF7: "\U00011af3\U00011af3\U00011aef",
+	0x00011AF8: "\U00011af3\U00011af3\U00011af0",
+	0x00011AF5: "\U00011af3\U00011af0",
+	0x00011AEC: "\U00011aeb\U00011aef",
+	0x00011AED: "\U00011aeb\U00011aeb",
+	0x00011AEE: "\U00011aeb\U00011aeb\U00011aef",
+	0x00002295: "\U000102a8",
+	0x00002A01: "\U000102a8",
+	0x0001F728: "\U000102a8",
+	0x0000A69A: "\U000102a8",
+	0x000025BD: "\U000102bc",
+	0x0001D214: "\U000102bc",
+	0x0001F704: "\U000102bc",
+	0x000029D6: "\U000102c0",
+	0x0000A79B: "\U0001043a",
+	0x0000A79A: "\U00010412",
+	0x000104A0: "\U00010486",
+	0x000103D1: "\U00010382",
+	0x000103D3: "\U00010393",
+	0x00012038: "\U0001039a",
+	0x00002625: "\U0001099e",
+	0x000132F9: "\U0001099e",
+	0x00003039: "\u5344",
+	0x0000F967: "\u4e0d",
+	0x0002F800: "\u4e3d",
+	0x0000FA70: "\u4e26",
+	0x0000239C: "\u4e28",
+	0x0000239F: "\u4e28",
+	0x000023A2: "\u4e28",
+	0x000023A5: "\u4e28",
+	0x000023AA: "\u4e28",
+	0x000023AE: "\u4e28",
+	0x000031D1: "\u4e28",
+	0x00001175: "\u4e28",
+	0x00003163: "\u4e28",
+	0x00002F01: "\u4e28",
+	0x0000119C: "\u4e28\u30fc",
+	0x00001198: "\u4e28\u1161",
+	0x00001199: "\u4e28\u1163",
+	0x0000D7BD: "\u4e28\u1163\u1169",
+	0x0000D7BE: "\u4e28\u1163\u4e28",
+	0x0000D7BF: "\u4e28\u1167",
+	0x0000D7C0: "\u4e28\u1167\u4e28",
+	0x0000119A: "\u4e28\u1169",
+	0x0000D7C1: "\u4e28\u1169\u4e28",
+	0x0000D7C2: "\u4e28\u116d",
+	0x0000119B: "\u4e28\u116e",
+	0x0000D7C3: "\u4e28\u1172",
+	0x0000119D: "\u4e28\u119e",
+	0x0000D7C4: "\u4e28\u4e28",
+	0x0000F905: "\u4e32",
+	0x0002F801: "\u4e38",
+	0x0000F95E: "\u4e39",
+	0x0002F802: "\u4e41",
+	0x000031E0: "\u4e59",
+	0x00002F04: "\u4e59",
+	0x000031DF: "\u4e5a",
+	0x00002E83: "\u4e5a",
+	0x000031D6: "\u4e5b",
+	0x00002E82: "\u4e5b",
+	0x00002EF2: "\u4e80",
+	0x0000F91B: "\u4e82",
+	0x000031DA: "\u4e85",
+	0x00002F05: "\u4e85",
+	0x0000F9BA: "\u4e86",
+	0x000030CB: "\u4e8c",
+	0x00002F06: "\u4e8c",
+	0x0002F803: "\U00020122",
+	0x00002F07: "\u4ea0",
+	0x0000F977: "\u4eae",
+	0x00002F08: "\u4eba",
+	0x000030A4: "\u4ebb",
+	0x00002E85: "\u4ebb",
+	0x0000F9FD: "\u4ec0",
+	0x0002F819: "\u4ecc",
+	0x0000F9A8: "\u4ee4",
+	0x0002F804: "\u4f60",
+	0x00005002: "\u4f75",
+	0x0002F807: "\u4f75",
+	0x0000FA73: "\u4f80",
+	0x0000F92D: "\u4f86",
+	0x0000F9B5: "\u4f8b",
+	0x0000FA30: "\u4fae",
+	0x0002F805: "\u4fae",
+	0x0002F806: "\u4fbb",
+	0x0000F965: "\u4fbf",
+	0x0000503C: "\u5024",
+	0x0000F9D4: "\u502b",
+	0x0002F808: "\u507a",
+	0x0002F809: "\u5099",
+	0x0002F80B: "\u50cf",
+	0x0000F9BB: "\u50da",
+	0x0000FA31: "\u50e7",
+	0x0002F80A: "\u50e7",
+	0x0002F80C: "\u349e",
+	0x00002F09: "\u513f",
+	0x0000FA0C: "\u5140",
+	0x00002E8E: "\u5140",
+	0x0000FA74: "\u5145",
+	0x0000FA32: "\u514d",
+	0x0002F80E: "\u514d",
+	0x0002F80F: "\u5154",
+	0x0002F810: "\u5164",
+	0x00002F0A: "\u5165",
+	0x0002F814: "\u5167",
+	0x0000FA72: "\u5168",
+	0x0000F978: "\u5169",
+	0x000030CF: "\u516b",
+	0x00002F0B: "\u516b",
+	0x0000F9D1: "\u516d",
+	0x0002F811: "\u5177",
+	0x0002F812: "\U0002051c",
+	0x0002F91B: "\U00020525",
+	0x0000FA75: "\u5180",
+	0x0002F813: "\u34b9",
+	0x00002F0C: "\u5182",
+	0x0002F815: "\u518d",
+	0x0002F816: "\U0002054b",
+	0x0002F8D2: "\u5192",
+	0x0002F8D3: "\u5195",
+	0x0002F9CA: "\u34bb",
+	0x0002F8D4: "\u6700",
+	0x00002F0D: "\u5196",
+	0x0002F817: "\u5197",
+	0x0002F818: "\u51a4",
+	0x00002F0E: "\u51ab",
+	0x0002F81A: "\u51ac",
+	0x0000FA71: "\u51b5",
+	0x0002F81B: "\u51b5",
+	0x0000F92E: "\u51b7",
+	0x0000F979: "\u51c9",
+	0x0000F955: "\u51cc",
+	0x0000F954: "\u51dc",
+	0x0000FA15: "\u51de",
+	0x00002F0F: "\u51e0",
+	0x0002F80D: "\U0002063a",
+	0x0002F81D: "\u51f5",
+	0x00002F10: "\u51f5",
+	0x00002F11: "\u5200",
+	0x00002E89: "\u5202",
+	0x0002F81E: "\u5203",
+	0x0000FA00: "\u5207",
+	0x0002F850: "\u5207",
+	0x0000F99C: "\u5217",
+	0x0000F9DD: "\u5229",
+	0x0002F81F: "\u34df",
+	0x0000F9FF: "\u523a",
+	0x0002F820: "\u523b",
+	0x0002F821: "\u5246",
+	0x0002F822: "\u5272",
+	0x0002F823: "\u5277",
+	0x0000F9C7: "\u5289",
+	0x0002F9D9: "\U00020804",
+	0x000030AB: "\u529b",
+	0x0000F98A: "\u529b",
+	0x00002F12: "\u529b",
+	0x0000F99D: "\u52a3",
+	0x0002F824: "\u3515",
+	0x0002F992: "\u52b3",
+	0x0000FA76: "\u52c7",
+	0x0002F825: "\u52c7",
+	0x0000FA33: "\u52c9",
+	0x0002F826: "\u52c9",
+	0x0000F952: "\u52d2",
+	0x0000F92F: "\u52de",
+	0x0000FA34: "\u52e4",
+	0x0002F827: "\u52e4",
+	0x0000F97F: "\u52f5",
+	0x00002F13: "\u52f9",
+	0x0000FA77: "\u52fa",
+	0x0002F828: "\u52fa",
+	0x0002F829: "\u5305",
+	0x0002F82A: "\u5306",
+	0x0002F9DD: "\U000208de",
+	0x00002F14: "\u5315",
+	0x0000F963: "\u5317",
+	0x0002F82B: "\u5317",
+	0x00002F15: "\u531a",
+	0x00002F16: "\u5338",
+	0x0000F9EB: "\u533f",
+	0x00002F17: "\u5341",
+	0x00003038: "\u5341",
+	0x0000303A: "\u5345",
+	0x0002F82C: "\u5349",
+	0x00000FD6: "\u534d",
+	0x00000FD5: "\u5350",
+	0x0000FA35: "\u5351",
+	0x0002F82D: "\u5351",
+	0x0002F82E: "\u535a",
+	0x000030C8: "\u535c",
+	0x00002F18: "\u535c",
+	0x00002F19: "\u5369",
+	0x00002E8B: "\u353e",
+	0x0002F82F: "\u5373",
+	0x0000F91C: "\u5375",
+	0x0002F830: "\u537d",
+	0x0002F831: "\u537f",
+	0x0002F832: "\u537f",
+	0x0002F833: "\u537f",
+	0x00002F1A: "\u5382",
+	0x0002F834: "\U00020a2c",
+	0x00002F1B: "\u53b6",
+	0x0000F96B: "\u53c3",
+	0x00002F1C: "\u53c8",
+	0x0002F836: "\u53ca",
+	0x0002F837: "\u53df",
+	0x0002F838: "\U00020b63",
+	0x000030ED: "\u53e3",
+	0x00002F1D: "\u53e3",
+	0x000056D7: "\u53e3",
+	0x00002F1E: "\u53e3",
+	0x0000F906: "\u53e5",
+	0x0002F839: "\u53eb",
+	0x0002F83A: "\u53f1",
+	0x0002F83B: "\u5406",
+	0x0000F9DE: "\u540f",
+	0x0000F9ED: "\u541d",
+	0x0002F83D: "\u5438",
+	0x0000F980: "\u5442",
+	0x0002F83E: "\u5448",
+	0x0002F83F: "\u5468",
+	0x0002F83C: "\u549e",
+	0x0002F840: "\u54a2",
+	0x0000F99E: "\u54bd",
+	0x0000439B: "\u3588",
+	0x0002F841: "\u54f6",
+	0x0002F842: "\u5510",
+	0x0002F843: "\u5553",
+	0x0000555F: "\u5553",
+	0x0000FA79: "\u5555",
+	0x0002F844: "\u5563",
+	0x0002F845: "\u5584",
+	0x0002F846: "\u5584",
+	0x0000F90B: "\u5587",
+	0x0000FA7A: "\u5599",
+	0x0002F847: "\u5599",
+	0x0000FA36: "\u559d",
+	0x0000FA78: "\u559d",
+	0x0002F848: "\u55ab",
+	0x0002F849: "\u55b3",
+	0x0000FA0D: "\u55c0",
+	0x0002F84A: "\u55c2",
+	0x0000FA7B: "\u55e2",
+	0x0000FA37: "\u5606",
+	0x0002F84C: "\u5606",
+	0x0002F84E: "\u5651",
+	0x0002F84F: "\u5674",
+	0x0000FA38: "\u5668",
+	0x0000F9A9: "\u56f9",
+	0x0002F84B: "\u5716",
+	0x0002F84D: "\u5717",
+	0x00002F1F: "\u571f",
+	0x000058EB: "\u571f",
+	0x00002F20: "\u571f",
+	0x0002F855: "\u578b",
+	0x0002F852: "\u57ce",
+	0x000039B3: "\u363d",
+	0x0002F853: "\u57f4",
+	0x0002F854: "\u580d",
+	0x0002F857: "\u5831",
+	0x0002F856: "\u5832",
+	0x0000FA39: "\u5840",
+	0x0000FA10: "\u585a",
+	0x0000FA7C: "\u585a",
+	0x0000F96C: "\u585e",
+	0x0000586B: "\u5861",
+	0x000058FF: "\u58ab",
+	0x0002F858: "\u58ac",
+	0x0000FA7D: "\u58b3",
+	0x0000F94A: "\u58d8",
+	0x0000F942: "\u58df",
+	0x0002F859: "\U000214e4",
+	0x0002F851: "\u58ee",
+	0x0002F85A: "\u58f2",
+	0x0002F85B: "\u58f7",
+	0x00002F21: "\u5902",
+	0x0002F85C: "\u5906",
+	0x00002F22: "\u590a",
+	0x000030BF: "\u5915",
+	0x00002F23: "\u5915",
+	0x0002F85D: "\u591a",
+	0x0002F85E: "\u5922",
+	0x00002F24: "\u5927",
+	0x0000FA7E: "\u5944",
+	0x0000F90C: "\u5948",
+	0x0000F909: "\u5951",
+	0x0000FA7F: "\u5954",
+	0x0002F85F: "\u5962",
+	0x0000F981: "\u5973",
+	0x00002F25: "\u5973",
+	0x0002F860: "\U000216a8",
+	0x0002F861: "\U000216ea",
+	0x0002F865: "\u59d8",
+	0x0002F862: "\u59ec",
+	0x0002F863: "\u5a1b",
+	0x0002F864: "\u5a27",
+	0x0000FA80: "\u5a62",
+	0x0002F866: "\u5a66",
+	0x00005B00: "\u5aaf",
+	0x0002F867: "\u36ee",
+	0x0002F868: "\u36fc",
+	0x0002F986: "\u5ab5",
+	0x0002F869: "\u5b08",
+	0x0000FA81: "\u5b28",
+	0x0002F86A: "\u5b3e",
+	0x0002F86B: "\u5b3e",
+	0x00002F26: "\u5b50",
+	0x00002F27: "\u5b80",
+	0x0000FA04: "\u5b85",
+	0x0002F86C: "\U000219c8",
+	0x0002F86D: "\u5bc3",
+	0x0002F86E: "\u5bd8",
+	0x0000F95F: "\u5be7",
+	0x0000F9AA: "\u5be7",
+	0x0002F86F: "\u5be7",
+	0x0000F9BC: "\u5bee",
+	0x0002F870: "\u5bf3",
+	0x0002F871: "\U00021b18",
+	0x00002F28: "\u5bf8",
+	0x0002F872: "\u5bff",
+	0x0002F873: "\u5c06",
+	0x00002F29: "\u5c0f",
+	0x0002F875: "\u5c22",
+	0x00002E90: "\u5c22",
+	0x00002F2A: "\u5c22",
+	0x00002E8F: "\u5c23",
+	0x0002F876: "\u3781",
+	0x00002F2B: "\u5c38",
+	0x0000F9BD: "\u5c3f",
+	0x0002F877: "\u5c60",
+	0x0000F94B: "\u5c62",
+	0x0000FA3B: "\u5c64",
+	0x0000F9DF: "\u5c65",
+	0x0000FA3C: "\u5c6e",
+	0x0002F878: "\u5c6e",
+	0x00002F2C: "\u5c6e",
+	0x0002F8F8: "\U00021d0b",
+	0x00002F2D: "\u5c71",
+	0x0002F879: "\u5cc0",
+	0x0002F87A: "\u5c8d",
+	0x0002F87B: "\U00021de4",
+	0x0002F87D: "\U00021de6",
+	0x0000F9D5: "\u5d19",
+	0x0002F87C: "\u5d43",
+	0x0000F921: "\u5d50",
+	0x0002F87F: "\u5d6b",
+	0x0002F87E: "\u5d6e",
+	0x0002F880: "\u5d7c",
+	0x0002F9F4: "\u5db2",
+	0x0000F9AB: "\u5dba",
+	0x00002F2E: "\u5ddb",
+	0x0002F882: "\u5de2",
+	0x000030A8: "\u5de5",
+	0x00002F2F: "\u5de5",
+	0x00002F30: "\u5df1",
+	0x00002E92: "\u5df3",
+	0x0002F883: "\u382f",
+	0x0002F884: "\u5dfd",
+	0x00002F31: "\u5dfe",
+	0x00005E32: "\u5e21",
+	0x0002F885: "\u5e28",
+	0x0002F886: "\u5e3d",
+	0x0002F887: "\u5e69",
+	0x0002F888: "\u3862",
+	0x0002F889: "\U00022183",
+	0x00002F32: "\u5e72",
+	0x0000F98E: "\u5e74",
+	0x0002F939: "\U0002219f",
+	0x00002E93: "\u5e7a",
+	0x00002F33: "\u5e7a",
+	0x00002F34: "\u5e7f",
+	0x0000FA01: "\u5ea6",
+	0x0002F88A: "\u387c",
+	0x0002F88B: "\u5eb0",
+	0x0002F88C: "\u5eb3",
+	0x0002F88D: "\u5eb6",
+	0x0000F928: "\u5eca",
+	0x0002F88E: "\u5eca",
+	0x0000F9A2: "\u5ec9",
+	0x0000FA82: "\u5ed2",
+	0x0000FA0B: "\u5ed3",
+	0x0000FA83: "\u5ed9",
+	0x0000F982: "\u5eec",
+	0x00002F35: "\u5ef4",
+	0x0002F890: "\u5efe",
+	0x00002F36: "\u5efe",
+	0x0002F891: "\U00022331",
+	0x0002F892: "\U00022331",
+	0x0000F943: "\u5f04",
+	0x00002F37: "\u5f0b",
+	0x00002F38: "\u5f13",
+	0x0002F894: "\u5f22",
+	0x0002F895: "\u5f22",
+	0x00002F39: "\u5f50",
+	0x00002E94: "\u5f51",
+	0x0002F874: "\u5f53",
+	0x0002F896: "\u38c7",
+	0x00002F3A: "\u5f61",
+	0x0002F899: "\u5f62",
+	0x0000FA84: "\u5f69",
+	0x0002F89A: "\u5f6b",
+	0x00002F3B: "\u5f73",
+	0x0000F9D8: "\u5f8b",
+	0x0002F89B: "\u38e3",
+	0x0002F89C: "\u5f9a",
+	0x0000F966: "\u5fa9",
+	0x0000FA85: "\u5fad",
+	0x00002F3C: "\u5fc3",
+	0x00002E96: "\u5fc4",
+	0x00002E97: "\u38fa",
+	0x0002F89D: "\u5fcd",
+	0x0002F89E: "\u5fd7",
+	0x0000F9A3: "\u5ff5",
+	0x0002F89F: "\u5ff9",
+	0x0000F960: "\u6012",
+	0x0000F9AC: "\u601c",
+	0x0000FA6B: "\u6075",
+	0x0002F8A2: "\u391c",
+	0x0002F8A1: "\u393a",
+	0x0002F8A0: "\u6081",
+	0x0000FA3D: "\u6094",
+	0x0002F8A3: "\u6094",
+	0x0002F8A5: "\u60c7",
+	0x0000FA86: "\u60d8",
+	0x0000F9B9: "\u60e1",
+	0x0002F8A4: "\U000226d4",
+	0x0000FA88: "\u6108",
+	0x0000FA3E: "\u6168",
+	0x0000F9D9: "\u6144",
+	0x0002F8A6: "\u6148",
+	0x0002F8A7: "\u614c",
+	0x0002F8A9: "\u614c",
+	0x0000FA87: "\u614e",
+	0x0002F8A8: "\u614e",
+	0x0000FA8A: "\u6160",
+	0x0002F8AA: "\u617a",
+	0x0000FA3F: "\u618e",
+	0x0000FA89: "\u618e",
+	0x0002F8AB: "\u618e",
+	0x0000F98F: "\u6190",
+	0x0002F8AD: "\u61a4",
+	0x0002F8AE: "\u61af",
+	0x0002F8AC: "\u61b2",
+	0x0000FAD0: "\U00022844",
+	0x0000FACF: "\U0002284a",
+	0x0002F8AF: "\u61de",
+	0x0000FA40: "\u61f2",
+	0x0000FA8B: "\u61f2",
+	0x0002F8B0: "\u61f2",
+	0x0000F90D: "\u61f6",
+	0x0002F8B1: "\u61f6",
+	0x0000F990: "\u6200",
+	0x00002F3D: "\u6208",
+	0x0002F8B2: "\u6210",
+	0x0002F8B3: "\u621b",
+	0x0000F9D2: "\u622e",
+	0x0000FA8C: "\u6234",
+	0x00002F3E: "\u6236",
+	0x00006238: "\u6236",
+	0x00002F3F: "\u624b",
+	0x00002E98: "\u624c",
+	0x0002F8B4: "\u625d",
+	0x0002F8B5: "\u62b1",
+	0x0000F925: "\u62c9",
+	0x0000F95B: "\u62cf",
+	0x0000FA02: "\u62d3",
+	0x0002F8B6: "\u62d4",
+	0x0002F8BA: "\u62fc",
+	0x0000F973: "\u62fe",
+	0x0002F8B8: "\U00022b0c",
+	0x0002F8B9: "\u633d",
+	0x0002F8B7: "\u6350",
+	0x0002F8BB: "\u6368",
+	0x0000F9A4: "\u637b",
+	0x0002F8BC: "\u6383",
+	0x0000F975: "\u63a0",
+	0x0002F8C1: "\u63a9",
+	0x0000FA8D: "\u63c4",
+	0x0002F8BD: "\u63e4",
+	0x0000FA8F: "\u6452",
+	0x0002F8BE: "\U00022bf1",
+	0x0000FA8E: "\u641c",
+	0x0002F8BF: "\u6422",
+	0x0002F8C0: "\u63c5",
+	0x0002F8C3: "\u6469",
+	0x0002F8C6: "\u6477",
+	0x0002F8C4: "\u647e",
+	0x0002F8C2: "\u3a2e",
+	0x00006409: "\u3a41",
+	0x0000F991: "\u649a",
+	0x0002F8C5: "\u649d",
+	0x0000F930: "\u64c4",
+	0x0002F8C7: "\u3a6c",
+	0x00002F40: "\u652f",
+	0x00002F41: "\u6534",
+	0x00002E99: "\u6535",
+	0x0000FA41: "\u654f",
+	0x0002F8C8: "\u654f",
+	0x0000FA90: "\u6556",
+	0x0002F8C9: "\u656c",
+	0x0000F969: "\u6578",
+	0x0002F8CA: "\U0002300a",
+	0x00002F42: "\u6587",
+	0x00002EEB: "\u6589",
+	0x00002F43: "\u6597",
+	0x0000F9BE: "\u6599",
+	0x00002F44: "\u65a4",
+	0x00002F45: "\u65b9",
+	0x0000F983: "\u65c5",
+	0x00002F46: "\u65e0",
+	0x00002E9B: "\u65e1",
+	0x0000FA42: "\u65e2",
+	0x0002F8CB: "\u65e3",
+	0x00002F47: "\u65e5",
+	0x0000F9E0: "\u6613",
+	0x000066F6: "\u3ada",
+	0x0002F8D1: "\u3ae4",
+	0x0002F8CD: "\u6649",
+	0x00006669: "\u665a",
+	0x0000FA12: "\u6674",
+	0x0000FA91: "\u6674",
+	0x0000FA43: "\u6691",
+	0x0002F8CF: "\u6691",
+	0x0000F9C5: "\u6688",
+	0x0002F8D0: "\u3b08",
+	0x0002F8D5: "\u669c",
+	0x0000FA06: "\u66b4",
+	0x0000F98B: "\u66c6",
+	0x0002F8CE: "\u3b19",
+	0x0002F897: "\U000232b8",
+	0x00002F48: "\u66f0",
+	0x0000F901: "\u66f4",
+	0x0002F8CC: "\u66f8",
+	0x00002F49: "\u6708",
+	0x0002F980: "\U0002335f",
+	0x000080A6: "\u670c",
+	0x000080D0: "\u670f",
+	0x000080CA: "\u6710",
+	0x00008101: "\u6713",
+	0x000080F6: "\u3b35",
+	0x0000F929: "\u6717",
+	0x0000FA92: "\u6717",
+	0x0002F8D8: "\u6717",
+	0x00008127: "\u6718",
+	0x0000FA93: "\u671b",
+	0x0002F8D9: "\u671b",
+	0x00005E50: "\u3b3a",
+	0x00004420: "\u3b3b",
+	0x0002F989: "\U00023393",
+	0x000081A7: "\u6723",
+	0x0002F98A: "\U0002339c",
+	0x00002F4A: "\u6728",
+	0x0000F9E1: "\u674e",
+	0x0002F8DC: "\u6753",
+	0x0000FA94: "\u6756",
+	0x0002F8DB: "\u675e",
+	0x0002F8DD: "\U000233c3",
+	0x000067FF: "\u676e",
+	0x0000F9C8: "\u677b",
+	0x0002F8E0: "\u6785",
+	0x0000F9F4: "\u6797",
+	0x0002F8DE: "\u3b49",
+	0x0000FAD1: "\U000233d5",
+	0x0000F9C9: "\u67f3",
+	0x0002F8DF: "\u67fa",
+	0x0000F9DA: "\u6817",
+	0x0002F8E5: "\u681f",
+	0x0002F8E1: "\u6852",
+	0x0002F8E3: "\U0002346d",
+	0x0000F97A: "\u6881",
+	0x0000FA44: "\u6885",
+	0x0002F8E2: "\u6885",
+	0x0002F8E4: "\u688e",
+	0x0000F9E2: "\u68a8",
+	0x0002F8E6: "\u6914",
+	0x0002F8E8: "\u6942",
+	0x0000FAD2: "\u3b9d",
+	0x0002F8E7: "\u3b9d",
+	0x000069E9: "\u3ba3",
+	0x00006A27: "\u699d",
+	0x0002F8E9: "\u69a3",
+	0x0002F8EA: "\u69ea",
+	0x0000F914: "\u6a02",
+	0x0000F95C: "\u6a02",
+	0x0000F9BF: "\u6a02",
+	0x0000F94C: "\u6a13",
+	0x0002F8EC: "\U000236a3",
+	0x0002F8EB: "\u6aa8",
+	0x0000F931: "\u6ad3",
+	0x0002F8ED: "\u6adb",
+	0x0000F91D: "\u6b04",
+	0x0002F8EE: "\u3c18",
+	0x00002F4B: "\u6b20",
+	0x0002F8EF: "\u6b21",
+	0x0002F8F0: "\U000238a7",
+	0x0002F8F1: "\u6b54",
+	0x0002F8F2: "\u3c4e",
+	0x00002F4C: "\u6b62",
+	0x00002EED: "\u6b6f",
+	0x0002F8F3: "\u6b72",
+	0x0000F98C: "\u6b77",
+	0x0000FA95: "\u6b79",
+	0x00002F4D: "\u6b79",
+	0x00002E9E: "\u6b7a",
+	0x0002F8F4: "\u6b9f",
+	0x0000F9A5: "\u6bae",
+	0x00002F4E: "\u6bb3",
+	0x0000F970: "\u6bba",
+	0x0000FA96: "\u6bba",
+	0x0002F8F5: "\u6bba",
+	0x0002F8F6: "\u6bbb",
+	0x0002F8F7: "\U00023a8d",
+	0x00002F4F: "\u6bcb",
+	0x00002E9F: "\u6bcd",
+	0x0002F8F9: "\U00023afa",
+	0x00002F50: "\u6bd4",
+	0x00002F51: "\u6bdb",
+	0x00002F52: "\u6c0f",
+	0x00002EA0: "\u6c11",
+	0x00002F53: "\u6c14",
+	0x00002F54: "\u6c34",
+	0x00002EA1: "\u6c35",
+	0x00002EA2: "\u6c3a",
+	0x0002F8FA: "\u6c4e",
+	0x0002F8FE: "\u6c67",
+	0x0000F972: "\u6c88",
+	0x0002F8FC: "\u6cbf",
+	0x0000F968: "\u6ccc",
+	0x0002F8FD: "\u6ccd",
+	0x0000F9E3: "\u6ce5",
+	0x0002F8FB: "\U00023cbc",
+	0x0000F915: "\u6d1b",
+	0x0000FA05: "\u6d1e",
+	0x0002F907: "\u6d34",
+	0x0002F900: "\u6d3e",
+	0x0000F9CA: "\u6d41",
+	0x0000FA97: "\u6d41",
+	0x0002F902: "\u6d41",
+	0x0002F8FF: "\u6d16",
+	0x0002F903: "\u6d69",
+	0x0000F92A: "\u6d6a",
+	0x0000FA45: "\u6d77",
+	0x0002F901: "\u6d77",
+	0x0002F904: "\u6d78",
+	0x0002F905: "\u6d85",
+	0x0002F906: "\U00023d1e",
+	0x0000F9F5: "\u6dcb",
+	0x0000F94D: "\u6dda",
+	0x0000F9D6: "\u6dea",
+	0x0002F90E: "\u6df9",
+	0x0000FA46: "\u6e1a",
+	0x0002F908: "\u6e2f",
+	0x0002F909: "\u6e6e",
+	0x00006F59: "\u6e88",
+	0x0000FA99: "\u6ecb",
+	0x0002F90B: "\u6ecb",
+	0x0000F9CB: "\u6e9c",
+	0x0000F9EC: "\u6eba",
+	0x0002F90C: "\u6ec7",
+	0x0000F904: "\u6ed1",
+	0x0000FA98: "\u6edb",
+	0x0002F90A: "\u3d33",
+	0x0000F94E: "\u6f0f",
+	0x0000FA47: "\u6f22",
+	0x0000FA9A: "\u6f22",
+	0x0000F992: "\u6f23",
+	0x0002F90D: "\U00023ed1",
+	0x0002F90F: "\u6f6e",
+	0x0002F910: "\U00023f5e",
+	0x0002F911: "\U00023f8e",
+	0x0002F912: "\u6fc6",
+	0x0000F922: "\u6feb",
+	0x0000F984: "\u6ffe",
+	0x0002F915: "\u701b",
+	0x0000FA9B: "\u701e",
+	0x0002F914: "\u701e",
+	0x0002F913: "\u7039",
+	0x0002F917: "\u704a",
+	0x0002F916: "\u3d96",
+	0x00002F55: "\u706b",
+	0x00002EA3: "\u706c",
+	0x0002F835: "\u7070",
+	0x0002F919: "\u7077",
+	0x0002F918: "\u707d",
+	0x0000F9FB: "\u7099",
+	0x0002F91A: "\u70ad",
+	0x0000F99F: "\u70c8",
+	0x0000F916: "\u70d9",
+	0x0000FA48: "\u716e",
+	0x0000FA9C: "\u716e",
+	0x0002F91D: "\U00024263",
+	0x0002F91C: "\u7145",
+	0x0000F993: "\u7149",
+	0x0000FA6C: "\U000242ee",
+	0x0002F91E: "\u719c",
+	0x0000F9C0: "\u71ce",
+	0x0000F9EE: "\u71d0",
+	0x0002F91F: "\U000243ab",
+	0x0000F932: "\u7210",
+	0x0000F91E: "\u721b",
+	0x0002F920: "\u7228",
+	0x00002F56: "\u722a",
+	0x0000FA49: "\u722b",
+	0x00002EA4: "\u722b",
+	0x0000FA9E: "\u7235",
+	0x0002F921: "\u7235",
+	0x00002F57: "\u7236",
+	0x00002F58: "\u723b",
+	0x00002EA6: "\u4e2c",
+	0x00002F59: "\u723f",
+	0x00002F5A: "\u7247",
+	0x0002F922: "\u7250",
+	0x00002F5B: "\u7259",
+	0x0002F923: "\U00024608",
+	0x00002F5C: "\u725b",
+	0x0000F946: "\u7262",
+	0x0002F924: "\u7280",
+	0x0002F925: "\u7295",
+	0x00002F5D: "\u72ac",
+	0x00002EA8: "\u72ad",
+	0x0000FA9F: "\u72af",
+	0x0000F9FA: "\u72c0",
+	0x0002F926: "\U00024735",
+	0x0000F92B: "\u72fc",
+	0x0000FA16: "\u732a",
+	0x0000FAA0: "\u732a",
+	0x0002F927: "\U00024814",
+	0x0000F9A7: "\u7375",
+	0x0002F928: "\u737a",
+	0x00002F5E: "\u7384",
+	0x0000F961: "\u7387",
+	0x0000F9DB: "\u7387",
+	0x00002F5F: "\u7389",
+	0x0002F929: "\u738b",
+	0x0002F92A: "\u3eac",
+	0x0002F92B: "\u73a5",
+	0x0000F9AD: "\u73b2",
+	0x0002F92C: "\u3eb8",
+	0x0002F92D: "\u3eb8",
+	0x0000F917: "\u73de",
+	0x0000F9CC: "\u7409",
+	0x0000F9E4: "\u7406",
+	0x0000FA4A: "\u7422",
+	0x0002F92E: "\u7447",
+	0x0002F92F: "\u745c",
+	0x0000F9AE: "\u7469",
+	0x0000FAA1: "\u7471",
+	0x0002F930: "\u7471",
+	0x0002F931: "\u7485",
+	0x0000F994: "\u7489",
+	0x0000F9EF: "\u7498",
+	0x0002F932: "\u74ca",
+	0x00002F60: "\u74dc",
+	0x00002F61: "\u74e6",
+	0x0002F933: "\u3f1b",
+	0x0000FAA2: "\u7506",
+	0x00002F62: "\u7518",
+	0x00002F63: "\u751f",
+	0x0002F934: "\u7524",
+	0x00002F64: "\u7528",
+	0x00002F65: "\u7530",
+	0x0000FAA3: "\u753b",
+	0x0002F936: "\u753e",
+	0x0002F935: "\U00024c36",
+	0x0000F9CD: "\u7559",
+	0x0000F976: "\u7565",
+	0x0000F962: "\u7570",
+	0x0002F938: "\u7570",
+	0x0002F937: "\U00024c92",
+	0x00002F66: "\u758b",
+	0x00002F67: "\u7592",
+	0x0000F9E5: "\u75e2",
+	0x0002F93A: "\u7610",
+	0x0000FAA5: "\u761f",
+	0x0000FAA4: "\u761d",
+	0x0000F9C1: "\u7642",
+	0x0000F90E: "\u7669",
+	0x00002F68: "\u7676",
+	0x00002F69: "\u767d",
+	0x0002F93B: "\U00024fa1",
+	0x0002F93C: "\U00024fb8",
+	0x00002F6A: "\u76ae",
+	0x00002F6B: "\u76bf",
+	0x0002F93D: "\U00025044",
+	0x0002F93E: "\u3ffc",
+	0x0000FA17: "\u76ca",
+	0x0000FAA6: "\u76ca",
+	0x0000FAA7: "\u76db",
+	0x0000F933: "\u76e7",
+	0x0002F93F: "\u4008",
+	0x00002F6C: "\u76ee",
+	0x0000FAA8: "\u76f4",
+	0x0002F940: "\u76f4",
+	0x0002F942: "\U000250f2",
+	0x0002F941: "\U000250f3",
+	0x0000F96D: "\u7701",
+	0x0000FAD3: "\u4018",
+	0x0002F943: "\U00025119",
+	0x0002F945: "\u771e",
+	0x0002F946: "\u771f",
+	0x0002F947: "\u771f",
+	0x0002F944: "\U00025133",
+	0x0000FAAA: "\u7740",
+	0x0000FAA9: "\u774a",
+	0x0002F948: "\u774a",
+	0x00009FC3: "\u4039",
+	0x0000FAD4: "\u4039",
+	0x0002F949: "\u4039",
+	0x00006663: "\u403f",
+	0x0002F94B: "\u4046",
+	0x0002F94A: "\u778b",
+	0x0000FAD5: "\U00025249",
+	0x0000FA9D: "\u77a7",
+	0x00002F6D: "\u77db",
+	0x00002F6E: "\u77e2",
+	0x00002F6F: "\u77f3",
+	0x0002F94C: "\u4096",
+	0x0002F94D: "\U0002541d",
+	0x0000784F: "\u7814",
+	0x0002F94E: "\u784e",
+	0x0000F9CE: "\u786b",
+	0x0000F93B: "\u788c",
+	0x0002F94F: "\u788c",
+	0x0000FA4B: "\u7891",
+	0x0000F947: "\u78ca",
+	0x0000FAAB: "\u78cc",
+	0x0002F950: "\u78cc",
+	0x0000F964: "\u78fb",
+	0x0002F951: "\u40e3",
+	0x0000F985: "\u792a",
+	0x00002F70: "\u793a",
+	0x00002EAD: "\u793b",
+	0x0000FA18: "\u793c",
+	0x0000FA4C: "\u793e",
+	0x0000FA4E: "\u7948",
+	0x0000FA4D: "\u7949",
+	0x0002F952: "\U00025626",
+	0x0000FA4F: "\u7950",
+	0x0000FA50: "\u7956",
+	0x0002F953: "\u7956",
+	0x0000FA51: "\u795d",
+	0x0000FA19: "\u795e",
+	0x0000FA1A: "\u7965",
+	0x0000FA61: "\u8996",
+	0x0000FAB8: "\u8996",
+	0x0000F93C: "\u797f",
+	0x0002F954: "\U0002569a",
+	0x0000FA52: "\u798d",
+	0x0000FA53: "\u798e",
+	0x0000FA1B: "\u798f",
+	0x0002F956: "\u798f",
+	0x0002F955: "\U000256c5",
+	0x0000F9B6: "\u79ae",
+	0x00002F71: "\u79b8",
+	0x00002F72: "\u79be",
+	0x0000F995: "\u79ca",
+	0x0002F958: "\u412f",
+	0x0002F957: "\u79eb",
+	0x0000F956: "\u7a1c",
+	0x0002F95A: "\u7a4a",
+	0x0000FA54: "\u7a40",
+	0x0002F959: "\u7a40",
+	0x0002F95B: "\u7a4f",
+	0x00002F73: "\u7a74",
+	0x0000FA55: "\u7a81",
+	0x0002F95C: "\U0002597c",
+	0x0000FAAC: "\u7ab1",
+	0x0000F9F7: "\u7acb",
+	0x00002F74: "\u7acb",
+	0x00002EEF: "\u7adc",
+	0x0002F95D: "\U00025aa7",
+	0x0002F95E: "\U00025aa7",
+	0x0002F95F: "\u7aee",
+	0x00002F75: "\u7af9",
+	0x0000F9F8: "\u7b20",
+	0x0000FA56: "\u7bc0",
+	0x0000FAAD: "\u7bc0",
+	0x0002F960: "\u4202",
+	0x0002F961: "\U00025bab",
+	0x0002F962: "\u7bc6",
+	0x0002F964: "\u4227",
+	0x0002F963: "\u7bc9",
+	0x0002F965: "\U00025c80",
+	0x0000FAD6: "\U00025cd0",
+	0x0000F9A6: "\u7c3e",
+	0x0000F944: "\u7c60",
+	0x00002F76: "\u7c73",
+	0x0000FAAE: "\u7c7b",
+	0x0000F9F9: "\u7c92",
+	0x0000FA1D: "\u7cbe",
+	0x0002F966: "\u7cd2",
+	0x0000FA03: "\u7cd6",
+	0x0002F968: "\u7ce8",
+	0x0002F967: "\u42a0",
+	0x0002F969: "\u7ce3",
+	0x0000F97B: "\u7ce7",
+	0x00002F77: "\u7cf8",
+	0x00002EAF: "\u7cf9",
+	0x0002F96B: "\U00025f86",
+	0x0002F96A: "\u7d00",
+	0x0000F9CF: "\u7d10",
+	0x0000F96A: "\u7d22",
+	0x0000F94F: "\u7d2f",
+	0x00007D76: "\u7d55",
+	0x0002F96C: "\u7d63",
+	0x0000FAAF: "\u7d5b",
+	0x0000F93D: "\u7da0",
+	0x0000F957: "\u7dbe",
+	0x0002F96E: "\u7dc7",
+	0x0000F996: "\u7df4",
+	0x0000FA57: "\u7df4",
+	0x0000FAB0: "\u7df4",
+	0x0002F96F: "\u7e02",
+	0x0002F96D: "\u4301",
+	0x0000FA58: "\u7e09",
+	0x0000F950: "\u7e37",
+	0x0000FA59: "\u7e41",
+	0x0002F970: "\u7e45",
+	0x0002F898: "\U000261da",
+	0x0002F971: "\u4334",
+	0x00002F78: "\u7f36",
+	0x0002F972: "\U00026228",
+	0x0000FAB1: "\u7f3e",
+	0x0002F973: "\U00026247",
+	0x00002F79: "\u7f51",
+	0x00002EAB: "\u7f52",
+	0x00002EB2: "\u7f52",
+	0x00002EB1: "\u7f53",
+	0x0002F974: "\u4359",
+	0x0000FA5A: "\u7f72",
+	0x0002F975: "\U000262d9",
+	0x0000F9E6: "\u7f79",
+	0x0002F976: "\u7f7a",
+	0x0000F90F: "\u7f85",
+	0x0002F977: "\U0002633e",
+	0x00002F7A: "\u7f8a",
+	0x0002F978: "\u7f95",
+	0x0000F9AF: "\u7f9a",
+	0x0000FA1E: "\u7fbd",
+	0x00002F7B: "\u7fbd",
+	0x0002F979: "\u7ffa",
+	0x0000F934: "\u8001",
+	0x00002F7C: "\u8001",
+	0x00002EB9: "\u8002",
+	0x0000FA5B: "\u8005",
+	0x0000FAB2: "\u8005",
+	0x0002F97A: "\u8005",
+	0x00002F7D: "\u800c",
+	0x0002F97B: "\U000264da",
+	0x00002F7E: "\u8012",
+	0x0002F97C: "\U00026523",
+	0x00002F7F: "\u8033",
+	0x0000F9B0: "\u8046",
+	0x0002F97D: "\u8060",
+	0x0002F97E: "\U000265a8",
+	0x0000F997: "\u806f",
+	0x0002F97F: "\u8070",
+	0x0000F945: "\u807e",
+	0x00002F80: "\u807f",
+	0x00002EBA: "\u8080",
+	0x00002F81: "\u8089",
+	0x0000F953: "\u808b",
+	0x0002F8D6: "\u80ad",
+	0x0002F982: "\u80b2",
+	0x0002F981: "\u43d5",
+	0x0002F8D7: "\u43d9",
+	0x00008141: "\u80fc",
+	0x0002F983: "\u8103",
+	0x0002F985: "\u813e",
+	0x0002F984: "\u440b",
+	0x0002F8DA: "\u6721",
+	0x0002F987: "\U000267a7",
+	0x0002F988: "\U000267b5",
+	0x00006726: "\u4443",
+	0x0000F926: "\u81d8",
+	0x00002F82: "\u81e3",
+	0x0000F9F6: "\u81e8",
+	0x00002F83: "\u81ea",
+	0x0000FA5C: "\u81ed",
+	0x00002F84: "\u81f3",
+	0x00002F85: "\u81fc",
+	0x0002F893: "\u8201",
+	0x0002F98B: "\u8201",
+	0x0002F98C: "\u8204",
+	0x00002F86: "\u820c",
+	0x0000FA6D: "\u8218",
+	0x00002F87: "\u821b",
+	0x00002F88: "\u821f",
+	0x0002F98E: "\u446b",
+	0x00002F89: "\u826e",
+	0x0000F97C: "\u826f",
+	0x00002F8A: "\u8272",
+	0x00002F8B: "\u8278",
+	0x0000FA5D: "\u8279",
+	0x0000FA5E: "\u8279",
+	0x00002EBE: "\u8279",
+	0x00002EBF: "\u8279",
+	0x00002EC0: "\u8279",
+	0x0002F990: "\u828b",
+	0x0002F98F: "\u8291",
+	0x0002F991: "\u829d",
+	0x0002F993: "\u82b1",
+	0x0002F994: "\u82b3",
+	0x0002F995: "\u82bd",
+	0x0000F974: "\u82e5",
+	0x0002F998: "\u82e5",
+	0x0002F996: "\u82e6",
+	0x0002F997: "\U00026b3c",
+	0x0000F9FE: "\u8336",
+	0x0000FAB3: "\u8352",
+	0x0002F99A: "\u8363",
+	0x0002F999: "\u831d",
+	0x0002F99C: "\u8323",
+	0x0002F99D: "\u83bd",
+	0x0002F9A0: "\u8353",
+	0x0000F93E: "\u83c9",
+	0x0002F9A1: "\u83ca",
+	0x0002F9A2: "\u83cc",
+	0x0002F9A3: "\u83dc",
+	0x0002F99E: "\u83e7",
+	0x0000FAB4: "\u83ef",
+	0x0000F958: "\u83f1",
+	0x0000FA5F: "\u8457",
+	0x0002F99F: "\u8457",
+	0x0002F9A4: "\U00026c36",
+	0x0002F99B: "\u83ad",
+	0x0000F918: "\u843d",
+	0x0000F96E: "\u8449",
+	0x0000853F: "\u848d",
+	0x0002F9A6: "\U00026cd5",
+	0x0002F9A5: "\U00026d6b",
+	0x0000F999: "\u84ee",
+	0x0002F9A8: "\u84f1",
+	0x0002F9A9: "\u84f3",
+	0x0000F9C2: "\u84fc",
+	0x0002F9AA: "\u8516",
+	0x0002F9A7: "\u452b",
+	0x0002F9AC: "\u8564",
+	0x0002F9AD: "\U00026f2c",
+	0x0000F923: "\u85cd",
+	0x0002F9AE: "\u455d",
+	0x0002F9B0: "\U00026fb1",
+	0x0002F9AF: "\u4561",
+	0x0000F9F0: "\u85fa",
+	0x0000F935: "\u8606",
+	0x0002F9B2: "\u456b",
+	0x0000FA20: "\u8612",
+	0x0000F91F: "\u862d",
+	0x0002F9B1: "\U000270d2",
+	0x00008641: "\u8637",
+	0x0000F910: "\u863f",
+	0x00002F8C: "\u864d",
+	0x00002EC1: "\u864e",
+	0x0002F9B3: "\u8650",
+	0x0000F936: "\u865c",
+	0x0002F9B4: "\u865c",
+	0x0002F9B5: "\u8667",
+	0x0002F9B6: "\u8669",
+	0x00002F8D: "\u866b",
+	0x0002F9B7: "\u86a9",
+	0x0002F9B8: "\u8688",
+	0x0002F9BA: "\u86e2",
+	0x0002F9B9: "\u870e",
+	0x0002F9BC: "\u8728",
+	0x0002F9BD: "\u876b",
+	0x0002F9C0: "\u87e1",
+	0x0000FAB5: "\u8779",
+	0x0002F9BB: "\u8779",
+	0x0002F9BE: "\u8786",
+	0x0002F9BF: "\u45d7",
+	0x0002F9AB: "\U000273ca",
+	0x0000F911: "\u87ba",
+	0x0002F9C1: "\u8801",
+	0x0002F9C2: "\u45f9",
+	0x0000F927: "\u881f",
+	0x00002F8E: "\u8840",
+	0x0000FA08: "\u884c",
+	0x00002F8F: "\u884c",
+	0x0002F9C3: "\u8860",
+	0x0002F9C4: "\u8863",
+	0x00002F90: "\u8863",
+	0x00002EC2: "\u8864",
+	0x0000F9A0: "\u88c2",
+	0x0002F9C5: "\U00027667",
+	0x0000F9E7: "\u88cf",
+	0x0002F9C6: "\u88d7",
+	0x0002F9C7: "\u88de",
+	0x0000F9E8: "\u88e1",
+	0x0000F912: "\u88f8",
+	0x0002F9C9: "\u88fa",
+	0x0002F9C8: "\u4635",
+	0x0000FA60: "\u8910",
+	0x0000FAB6: "\u8941",
+	0x0000F924: "\u8964",
+	0x00002F91: "\u897e",
+	0x00002EC4: "\u897f",
+	0x00002EC3: "\u8980",
+	0x0000FAB7: "\u8986",
+	0x0000FA0A: "\u898b",
+	0x00002F92: "\u898b",
+	0x0002F9CB: "\U000278ae",
+	0x00002EC5: "\u89c1",
+	0x00002F93: "\u89d2",
+	0x00002F94: "\u8a00",
+	0x0002F9CC: "\U00027966",
+	0x00008A7D: "\u8a2e",
+	0x00008A1E: "\u46b6",
+	0x0002F9CD: "\u46be",
+	0x0002F9CE: "\u46c7",
+	0x0002F9CF: "\u8aa0",
+	0x0000F96F: "\u8aaa",
+	0x0000F9A1: "\u8aaa",
+	0x0000FAB9: "\u8abf",
+	0x0000FABB: "\u8acb",
+	0x0000F97D: "\u8ad2",
+	0x0000F941: "\u8ad6",
+	0x0000FABE: "\u8aed",
+	0x0002F9D0: "\u8aed",
+	0x0000FA22: "\u8af8",
+	0x0000FABA: "\u8af8",
+	0x0000F95D: "\u8afe",
+	0x0000FABD: "\u8afe",
+	0x0000FA62: "\u8b01",
+	0x0000FABC: "\u8b01",
+	0x0000FA63: "\u8b39",
+	0x0000FABF: "\u8b39",
+	0x0000F9FC: "\u8b58",
+	0x0000F95A: "\u8b80",
+	0x00008B8F: "\u8b86",
+	0x0000FAC0: "\u8b8a",
+	0x0002F9D1: "\u8b8a",
+	0x00002EC8: "\u8ba0",
+	0x00002F95: "\u8c37",
+	0x00002F96: "\u8c46",
+	0x0000F900: "\u8c48",
+	0x0002F9D2: "\u8c55",
+	0x00002F97: "\u8c55",
+	0x00008C63: "\u8c5c",
+	0x00002F98: "\u8c78",
+	0x0002F9D3: "\U00027ca8",
+	0x00002F99: "\u8c9d",
+	0x0002F9D4: "\u8cab",
+	0x0002F9D5: "\u8cc1",
+	0x0000F948: "\u8cc2",
+	0x0000F903: "\u8cc8",
+	0x0000FA64: "\u8cd3",
+	0x0000FA65: "\u8d08",
+	0x0000FAC1: "\u8d08",
+	0x0002F9D6: "\u8d1b",
+	0x00002EC9: "\u8d1d",
+	0x00002F9A: "\u8d64",
+	0x00002F9B: "\u8d70",
+	0x0002F9D7: "\u8d77",
+	0x00008D86: "\u8d7f",
+	0x0000FAD7: "\U00027ed3",
+	0x0002F9D8: "\U00027f2f",
+	0x00002F9C: "\u8db3",
+	0x0002F9DA: "\u8dcb",
+	0x0002F9DB: "\u8dbc",
+	0x00008DFA: "\u8de5",
+	0x0000F937: "\u8def",
+	0x0002F9DC: "\u8df0",
+	0x00008E9B: "\u8e97",
+	0x00002F9D: "\u8eab",
+	0x0000F902: "\u8eca",
+	0x00002F9E: "\u8eca",
+	0x0002F9DE: "\u8ed4",
+	0x00008F27: "\u8eff",
+	0x0000F998: "\u8f26",
+	0x0000F9D7: "\u8f2a",
+	0x0000FAC2: "\u8f38",
+	0x0002F9DF: "\u8f38",
+	0x0000FA07: "\u8f3b",
+	0x0000F98D: "\u8f62",
+	0x00002ECB: "\u8f66",
+	0x00002F9F: "\u8f9b",
+	0x0002F98D: "\u8f9e",
+	0x0000F971: "\u8fb0",
+	0x00002FA0: "\u8fb0",
+	0x00002FA1: "\u8fb5",
+	0x0000FA66: "\u8fb6",
+	0x00002ECC: "\u8fb6",
+	0x00002ECD: "\u8fb6",
+	0x0002F881: "\u5de1",
+	0x0000F99A: "\u9023",
+	0x0000FA25: "\u9038",
+	0x0000FA67: "\u9038",
+	0x0000FAC3: "\u9072",
+	0x0000F9C3: "\u907c",
+	0x0002F9E0: "\U000285d2",
+	0x0002F9E1: "\U000285ed",
+	0x0000F913: "\u908f",
+	0x00002FA2: "\u9091",
+	0x0002F9E2: "\u9094",
+	0x0000F92C: "\u90ce",
+	0x000090DE: "\u90ce",
+	0x0000FA2E: "\u90ce",
+	0x0002F9E3: "\u90f1",
+	0x0000FA26: "\u90fd",
+	0x0002F9E5: "\U0002872e",
+	0x0002F9E4: "\u9111",
+	0x0002F9E6: "\u911b",
+	0x00002FA3: "\u9149",
+	0x0000F919: "\u916a",
+	0x0000FAC4: "\u9199",
+	0x0000F9B7: "\u91b4",
+	0x00002FA4: "\u91c6",
+	0x0000F9E9: "\u91cc",
+	0x00002FA5: "\u91cc",
+	0x0000F97E: "\u91cf",
+	0x0000F90A: "\u91d1",
+	0x00002FA6: "\u91d1",
+	0x0000F9B1: "\u9234",
+	0x0002F9E7: "\u9238",
+	0x0000FAC5: "\u9276",
+	0x0002F9E8: "\u92d7",
+	0x0002F9E9: "\u92d8",
+	0x0002F9EA: "\u927c",
+	0x0000F93F: "\u9304",
+	0x0000F99B: "\u934a",
+	0x000093AE: "\u93ad",
+	0x0002F9EB: "\u93f9",
+	0x0002F9EC: "\u9415",
+	0x0002F9ED: "\U00028bfa",
+	0x00002ED0: "\u9485",
+	0x00002ED1: "\u9577",
+	0x00002FA7: "\u9577",
+	0x00002ED2: "\u9578",
+	0x00002ED3: "\u957f",
+	0x00002FA8: "\u9580",
+	0x0002F9EE: "\u958b",
+	0x0002F9EF: "\u4995",
+	0x0000F986: "\u95ad",
+	0x0002F9F0: "\u95b7",
+	0x0002F9F1: "\U00028d77",
+	0x00002ED4: "\u95e8",
+	0x00002FA9: "\u961c",
+	0x00002ECF: "\u961d",
+	0x00002ED6: "\u961d",
+	0x0000F9C6: "\u962e",
+	0x0000F951: "\u964b",
+	0x0000FA09: "\u964d",
+	0x0000F959: "\u9675",
+	0x0000F9D3: "\u9678",
+	0x0000FAC6: "\u967c",
+	0x0000F9DC: "\u9686",
+	0x0000F9F1: "\u96a3",
+	0x0002F9F2: "\u49e6",
+	0x00002FAA: "\u96b6",
+	0x0000FA2F: "\u96b7",
+	0x000096B8: "\u96b7",
+	0x0000F9B8: "\u96b7",
+	0x00002FAB: "\u96b9",
+	0x0002F9F3: "\u96c3",
+	0x0000F9EA: "\u96e2",
+	0x0000FA68: "\u96e3",
+	0x0000FAC7: "\u96e3",
+	0x00002FAC: "\u96e8",
+	0x0000F9B2: "\u96f6",
+	0x0000F949: "\u96f7",
+	0x0002F9F5: "\u9723",
+	0x0002F9F6: "\U00029145",
+	0x0000F938: "\u9732",
+	0x0000F9B3: "\u9748",
+	0x00002FAD: "\u9751",
+	0x00002ED8: "\u9752",
+	0x0000FA1C: "\u9756",
+	0x0000FAC8: "\u9756",
+	0x0002F81C: "\U000291df",
+	0x00002FAE: "\u975e",
+	0x00002FAF: "\u9762",
+	0x0002F9F7: "\U0002921a",
+	0x00002FB0: "\u9769",
+	0x0002F9F8: "\u4a6e",
+	0x0002F9F9: "\u4a76",
+	0x00002FB1: "\u97cb",
+	0x0000FAC9: "\u97db",
+	0x0002F9FA: "\u97e0",
+	0x00002ED9: "\u97e6",
+	0x00002FB2: "\u97ed",
+	0x0002F9FB: "\U0002940a",
+	0x00002FB3: "\u97f3",
+	0x0000FA69: "\u97ff",
+	0x0000FACA: "\u97ff",
+	0x00002FB4: "\u9801",
+	0x0002F9FC: "\u4ab2",
+	0x0000FACB: "\u980b",
+	0x0002F9FE: "\u980b",
+	0x0002F9FF: "\u980b",
+	0x0000F9B4: "\u9818",
+	0x0002FA00: "\u9829",
+	0x0002F9FD: "\U00029496",
+	0x0000FA6A: "\u983b",
+	0x0000FACC: "\u983b",
+	0x0000F9D0: "\u985e",
+	0x00002EDA: "\u9875",
+	0x00002FB5: "\u98a8",
+	0x0002FA01: "\U000295b6",
+	0x00002EDB: "\u98ce",
+	0x00002FB6: "\u98db",
+	0x00002EDC: "\u98de",
+	0x00002EDD: "\u98df",
+	0x00002FB7: "\u98df",
+	0x00002EDF: "\u98e0",
+	0x0002FA02: "\u98e2",
+	0x0000FA2A: "\u98ef",
+	0x0000FA2B: "\u98fc",
+	0x0002FA03: "\u4b33",
+	0x0000FA2C: "\u9928",
+	0x0002FA04: "\u9929",
+	0x00002EE0: "\u9963",
+	0x00002FB8: "\u9996",
+	0x00002FB9: "\u9999",
+	0x0002FA05: "\u99a7",
+	0x00002FBA: "\u99ac",
+	0x0002FA06: "\u99c2",
+	0x0000F91A: "\u99f1",
+	0x0002FA07: "\u99fe",
+	0x0000F987: "\u9a6a",
+	0x00002EE2: "\u9a6c",
+	0x00002FBB: "\u9aa8",
+	0x0002FA08: "\u4bce",
+	0x00002FBC: "\u9ad8",
+	0x00002FBD: "\u9adf",
+	0x0002FA09: "\U00029b30",
+	0x0000FACD: "\u9b12",
+	0x0002FA0A: "\u9b12",
+	0x00002FBE: "\u9b25",
+	0x00002FBF: "\u9b2f",
+	0x00002FC0: "\u9b32",
+	0x00002FC1: "\u9b3c",
+	0x00002EE4: "\u9b3c",
+	0x00002FC2: "\u9b5a",
+	0x0000F939: "\u9b6f",
+	0x0002FA0B: "\u9c40",
+	0x0000F9F2: "\u9c57",
+	0x00002EE5: "\u9c7c",
+	0x00002FC3: "\u9ce5",
+	0x0002FA0C: "\u9cfd",
+	0x0002FA0D: "\u4cce",
+	0x0002FA0F: "\u9d67",
+	0x0002FA0E: "\u4ced",
+	0x0002FA10: "\U0002a0ce",
+	0x0000FA2D: "\u9db4",
+	0x0002FA12: "\U0002a105",
+	0x0002FA11: "\u4cf8",
+	0x0000F93A: "\u9dfa",
+	0x0002FA13: "\U0002a20e",
+	0x0000F920: "\u9e1e",
+	0x00009E43: "\u9e42",
+	0x00002FC4: "\u9e75",
+	0x0000F940: "\u9e7f",
+	0x00002FC5: "\u9e7f",
+	0x0002FA14: "\U0002a291",
+	0x0000F988: "\u9e97",
+	0x0000F9F3: "\u9e9f",
+	0x00002FC6: "\u9ea5",
+	0x00002EE8: "\u9ea6",
+	0x0002FA15: "\u9ebb",
+	0x00002FC7: "\u9ebb",
+	0x0002F88F: "\U0002a392",
+	0x00002FC8: "\u9ec3",
+	0x00002EE9: "\u9ec4",
+	0x00002FC9: "\u9ecd",
+	0x0000F989: "\u9ece",
+	0x0002FA16: "\u4d56",
+	0x00002FCA: "\u9ed1",
+	0x00009ED2: "\u9ed1",
+	0x0000FA3A: "\u58a8",
+	0x0002FA17: "\u9ef9",
+	0x00002FCB: "\u9ef9",
+	0x00002FCC: "\u9efd",
+	0x0002FA19: "\u9f05",
+	0x0002FA18: "\u9efe",
+	0x00002FCD: "\u9f0e",
+	0x0002FA1A: "\u9f0f",
+	0x00002FCE: "\u9f13",
+	0x0002FA1B: "\u9f16",
+	0x00002FCF: "\u9f20",
+	0x0002FA1C: "\u9f3b",
+	0x00002FD0: "\u9f3b",
+	0x0000FAD8: "\u9f43",
+	0x00002FD1: "\u9f4a",
+	0x00002EEC: "\u9f50",
+	0x00002FD2: "\u9f52",
+	0x0002FA1D: "\U0002a600",
+	0x00002EEE: "\u9f7f",
+	0x0000F9C4: "\u9f8d",
+	0x00002FD3: "\u9f8d",
+	0x0000FAD9: "\u9f8e",
+	0x00002EF0: "\u9f99",
+	0x0000F907: "\u9f9c",
+	0x0000F908: "\u9f9c",
+	0x0000FACE: "\u9f9c",
+	0x00002FD4: "\u9f9c",
+	0x00002EF3: "\u9f9f",
+	0x00002FD5: "\u9fa0",
+}
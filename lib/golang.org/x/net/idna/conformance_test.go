@@ -0,0 +1,239 @@
+// Copyright 2016 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package idna
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"unicode"
+)
+
+// conformanceFile is IdnaTestV2.txt of the Unicode version below, from
+// https://www.unicode.org/Public/idna/.
+const (
+	conformanceFile    = "testdata/IdnaTestV2.txt"
+	conformanceVersion = "16.0.0"
+)
+
+// newerCodePoints holds, for the Unicode version of the tables, the code
+// points assigned since the version of the conformance file. The file expects
+// them to be disallowed as unassigned (V7).
+var newerCodePoints = map[string]*unicode.RangeTable{
+	"17.0.0": {R32: []unicode.Range32{{Lo: 0x323b0, Hi: 0x3347f, Stride: 1}}}, // CJK Extension J
+}
+
+// newer reports whether s, or the U-labels of s, hold code points assigned
+// after the version of the conformance file.
+func newer(s string) bool {
+	rt := newerCodePoints[UnicodeVersion]
+	if rt == nil {
+		return false
+	}
+	if u, err := Punycode.ToUnicode(s); err == nil {
+		s += u
+	}
+	for _, r := range s {
+		if unicode.Is(rt, r) {
+			return true
+		}
+	}
+	return false
+}
+
+// compareVersions compares the dotted Unicode versions a and b numerically,
+// so that "9.0.0" is older than "16.0.0".
+func compareVersions(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var x, y int
+		if i < len(as) {
+			x, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			y, _ = strconv.Atoi(bs[i])
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+var escapeRe = regexp.MustCompile(`\\u([0-9A-Fa-f]{4})|\\x\{([0-9A-Fa-f]+)\}`)
+
+func unescapeTest(s string) string {
+	s = strings.TrimSpace(s)
+	if s == `""` {
+		return ""
+	}
+	return escapeRe.ReplaceAllStringFunc(s, func(e string) string {
+		m := escapeRe.FindStringSubmatch(e)
+		hex := m[1] + m[2]
+		r, _ := strconv.ParseUint(hex, 16, 32)
+		return string(rune(r))
+	})
+}
+
+// conformanceTest is a line of IdnaTestV2.txt, with the blank columns filled
+// in as the file header describes. A non-empty status means an error is
+// expected, in which case the value is not checked.
+type conformanceTest struct {
+	line                    int
+	source                  string
+	toUnicode, toUnicodeErr string
+	toASCIIN, toASCIINErr   string
+	toASCIIT, toASCIITErr   string
+}
+
+func readConformanceTests(t *testing.T) []conformanceTest {
+	f, err := os.Open(conformanceFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var tests []conformanceTest
+	s := bufio.NewScanner(f)
+	for n := 1; s.Scan(); n++ {
+		line := s.Text()
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		c := strings.Split(line, ";")
+		if len(c) != 7 {
+			t.Fatalf("%s:%d: %d columns, want 7", conformanceFile, n, len(c))
+		}
+		for i := range c {
+			c[i] = strings.TrimSpace(c[i])
+		}
+		ct := conformanceTest{line: n, source: unescapeTest(c[0])}
+		ct.toUnicode, ct.toUnicodeErr = ct.source, c[2]
+		if c[1] != "" {
+			ct.toUnicode = unescapeTest(c[1])
+		}
+		ct.toASCIIN, ct.toASCIINErr = ct.toUnicode, ct.toUnicodeErr
+		if c[3] != "" {
+			ct.toASCIIN = unescapeTest(c[3])
+		}
+		if c[4] != "" {
+			ct.toASCIINErr = c[4]
+		}
+		ct.toASCIIT, ct.toASCIITErr = ct.toASCIIN, ct.toASCIINErr
+		if c[5] != "" {
+			ct.toASCIIT = unescapeTest(c[5])
+		}
+		if c[6] != "" {
+			ct.toASCIITErr = c[6]
+		}
+		tests = append(tests, ct)
+	}
+	if err := s.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return tests
+}
+
+// hasStatus reports whether the status list, e.g. "[B1, V6]", holds a code
+// other than those of the flags the profile leaves off.
+func hasStatus(status string, ignore ...string) bool {
+	status = strings.Trim(status, "[]")
+	if status == "" {
+		return false
+	}
+next:
+	for _, code := range strings.Split(status, ",") {
+		code = strings.TrimSpace(code)
+		for _, prefix := range ignore {
+			if strings.HasPrefix(code, prefix) {
+				continue next
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func TestConformance(t *testing.T) {
+	if compareVersions(UnicodeVersion, conformanceVersion) < 0 {
+		t.Skipf("the tables are for Unicode %s, the conformance file is for %s", UnicodeVersion, conformanceVersion)
+	}
+
+	// The UTS #46 flags of IdnaTestV2.txt: all checks on, and DNS lengths
+	// verified by toASCII only.
+	toUnicode := New(MapForLookup(), BidiRule(), Transitional(false))
+	toASCIIN := New(MapForLookup(), BidiRule(), VerifyDNSLength(true), Transitional(false))
+	toASCIIT := New(MapForLookup(), BidiRule(), VerifyDNSLength(true), Transitional(true))
+
+	tests := readConformanceTests(t)
+	if len(tests) < 6000 {
+		t.Fatalf("read %d tests, want the whole file", len(tests))
+	}
+	for _, ct := range tests {
+		if newer(ct.source) {
+			continue
+		}
+		for _, c := range []struct {
+			name    string
+			profile *Profile
+			convert func(*Profile, string) (string, error)
+			want    string
+			status  string
+			ignore  []string
+		}{
+			// toUnicode does not verify DNS lengths, so it ignores the
+			// empty label codes.
+			{"toUnicode", toUnicode, (*Profile).ToUnicode, ct.toUnicode, ct.toUnicodeErr, []string{"A4", "X4_2"}},
+			{"toAsciiN", toASCIIN, (*Profile).ToASCII, ct.toASCIIN, ct.toASCIINErr, nil},
+			{"toAsciiT", toASCIIT, (*Profile).ToASCII, ct.toASCIIT, ct.toASCIITErr, nil},
+		} {
+			got, err := c.convert(c.profile, ct.source)
+			wantErr := hasStatus(c.status, c.ignore...)
+			switch {
+			case wantErr && err == nil:
+				t.Errorf("%s:%d: %s(%+q) = %+q, want error %s", conformanceFile, ct.line, c.name, ct.source, got, c.status)
+			case !wantErr && err != nil:
+				t.Errorf("%s:%d: %s(%+q): unexpected error %v", conformanceFile, ct.line, c.name, ct.source, err)
+			case !wantErr && got != c.want:
+				t.Errorf("%s:%d: %s(%+q) = %+q, want %+q", conformanceFile, ct.line, c.name, ct.source, got, c.want)
+			}
+			if err != nil {
+				var le LabelError
+				var re RuneError
+				if !errors.As(err, &le) && !errors.As(err, &re) {
+					t.Errorf("%s:%d: %s(%+q): error %v is neither a LabelError nor a RuneError", conformanceFile, ct.line, c.name, ct.source, err)
+				}
+			}
+		}
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	for _, tc := range []struct {
+		a, b string
+		want int
+	}{
+		{"16.0.0", "16.0.0", 0},
+		{"9.0.0", "16.0.0", -1},
+		{"15.0.0", "16.0.0", -1},
+		{"17.0.0", "16.0.0", 1},
+		{"16.0.1", "16.0.0", 1},
+		{"16.0", "16.0.0", 0},
+	} {
+		if got := compareVersions(tc.a, tc.b); got != tc.want {
+			t.Errorf("compareVersions(%q, %q) = %d, want %d", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,205 @@
+// Package gsbdb reads the hash prefixes of a Safe Browsing v4 client database
+// (gsb_v4.db), the sqlite database the GSB analyses of testData/test-source
+// were run against.
+//
+// The prefixes are in the hash_prefix table, one row per prefix and threat
+// list:
+//
+//	hash_prefix(value BLOB, threat_type, platform_type, threat_entry_type, ...)
+//
+// Databases are opened read-only. They are usually in WAL mode, so the -wal
+// and -shm files next to the database must be kept with it.
+package gsbdb
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+
+	"../focal"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// DefaultPath is where the analyses expect the database.
+const DefaultPath = "./gsb_v4.db"
+
+// AnyPlatform is the platform of the lists the analyses use by default, the
+// only platform readSQLite used to select.
+const AnyPlatform = "ANY_PLATFORM"
+
+// Filter selects threat lists. An empty field matches any value.
+type Filter struct {
+	ThreatType      string // e.g. MALWARE, SOCIAL_ENGINEERING
+	PlatformType    string // e.g. ANY_PLATFORM, WINDOWS
+	ThreatEntryType string // e.g. URL
+}
+
+func (f Filter) where() (string, []interface{}) {
+	var conds []string
+	var args []interface{}
+	for _, c := range []struct{ column, value string }{
+		{"threat_type", f.ThreatType},
+		{"platform_type", f.PlatformType},
+		{"threat_entry_type", f.ThreatEntryType},
+	} {
+		if c.value != "" {
+			conds = append(conds, c.column+" = ?")
+			args = append(args, c.value)
+		}
+	}
+	if len(conds) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(conds, " AND "), args
+}
+
+// match reports whether f selects the list l.
+func (f Filter) match(l List) bool {
+	return (f.ThreatType == "" || f.ThreatType == l.ThreatType) &&
+		(f.PlatformType == "" || f.PlatformType == l.PlatformType) &&
+		(f.ThreatEntryType == "" || f.ThreatEntryType == l.ThreatEntryType)
+}
+
+// List is a threat list of the database.
+type List struct {
+	ThreatType      string
+	PlatformType    string
+	ThreatEntryType string
+	Prefixes        int
+}
+
+// DB is an open Safe Browsing database.
+type DB struct {
+	path string
+	db   *sql.DB
+}
+
+// Open opens the database at path read-only.
+func Open(path string) (*DB, error) {
+	// The driver would create a missing database, so check for it first.
+	if _, err := os.Stat(path); err != nil {
+		return nil, err
+	}
+	db, err := sql.Open("sqlite3", "file:"+(&url.URL{Path: path}).EscapedPath()+"?mode=ro")
+	if err != nil {
+		return nil, fmt.Errorf("gsbdb: %s: %v", path, err)
+	}
+	var n int
+	err = db.QueryRow("SELECT count(*) FROM sqlite_master WHERE type = 'table' AND name = 'hash_prefix'").Scan(&n)
+	if err == nil && n == 0 {
+		err = errors.New("no hash_prefix table")
+	}
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("gsbdb: %s: %v", path, err)
+	}
+	return &DB{path, db}, nil
+}
+
+// Close closes the database.
+func (db *DB) Close() error {
+	return db.db.Close()
+}
+
+// Lists returns the threat lists of the database, with their number of
+// prefixes.
+func (db *DB) Lists() ([]List, error) {
+	rows, err := db.db.Query("SELECT threat_type, platform_type, threat_entry_type, count(*) FROM hash_prefix" +
+		" GROUP BY threat_type, platform_type, threat_entry_type ORDER BY threat_type, platform_type, threat_entry_type")
+	if err != nil {
+		return nil, fmt.Errorf("gsbdb: %s: %v", db.path, err)
+	}
+	defer rows.Close()
+
+	var lists []List
+	for rows.Next() {
+		var l List
+		if err := rows.Scan(&l.ThreatType, &l.PlatformType, &l.ThreatEntryType, &l.Prefixes); err != nil {
+			return nil, fmt.Errorf("gsbdb: %s: %v", db.path, err)
+		}
+		lists = append(lists, l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("gsbdb: %s: %v", db.path, err)
+	}
+	return lists, nil
+}
+
+// Prefixes calls fn with each prefix of the lists selected by f, as the rows
+// are read. A prefix that is in several lists is passed once per list. It
+// stops at the first error of fn and returns it.
+func (db *DB) Prefixes(f Filter, fn func(focal.HashPrefix) error) error {
+	where, args := f.where()
+	rows, err := db.db.Query("SELECT value FROM hash_prefix"+where, args...)
+	if err != nil {
+		return fmt.Errorf("gsbdb: %s: %v", db.path, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var value []byte
+		if err := rows.Scan(&value); err != nil {
+			return fmt.Errorf("gsbdb: %s: %v", db.path, err)
+		}
+		h := focal.HashPrefix(value)
+		if !h.IsValid() {
+			return fmt.Errorf("gsbdb: %s: invalid %d-byte hash prefix %x", db.path, len(value), value)
+		}
+		if err := fn(h); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("gsbdb: %s: %v", db.path, err)
+	}
+	return nil
+}
+
+// HashSet returns the set of the prefixes of the lists selected by f.
+//
+// A focal.HashSet cannot hold a prefix of another, but lists may overlap: a
+// list may have the 4-byte prefix of a longer prefix of another list. The
+// shorter prefix matches every hash the longer one does, so the longer one is
+// dropped. Within a list, such prefixes are an error, as in
+// HashPrefixes.Validate.
+func (db *DB) HashSet(f Filter) (*focal.HashSet, error) {
+	lists, err := db.Lists()
+	if err != nil {
+		return nil, err
+	}
+	var all focal.HashPrefixes
+	for _, l := range lists {
+		if !f.match(l) {
+			continue
+		}
+		hs := new(focal.HashSet)
+		err := db.Prefixes(Filter{l.ThreatType, l.PlatformType, l.ThreatEntryType}, func(h focal.HashPrefix) error {
+			if err := hs.Add(h); err != nil {
+				return fmt.Errorf("gsbdb: %s: %s/%s/%s: prefix %x: %v", db.path, l.ThreatType, l.PlatformType, l.ThreatEntryType, string(h), err)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, hs.Export()...)
+	}
+
+	// Adding the shortest prefixes first, a prefix is never a prefix of one
+	// already in the set.
+	sort.SliceStable(all, func(i, j int) bool { return len(all[i]) < len(all[j]) })
+	hs := new(focal.HashSet)
+	for _, h := range all {
+		if hs.Lookup(h) > 0 {
+			continue
+		}
+		if err := hs.Add(h); err != nil {
+			return nil, fmt.Errorf("gsbdb: %s: prefix %x: %v", db.path, string(h), err)
+		}
+	}
+	return hs, nil
+}
@@ -0,0 +1,214 @@
+package gsbdb
+
+import (
+	"database/sql"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"../focal"
+)
+
+var testRows = []struct {
+	value                                     string
+	threatType, platformType, threatEntryType string
+}{
+	{"\x00\x00\x00\x01", "MALWARE", "ANY_PLATFORM", "URL"},
+	{"\x00\x00\x00\x02\x00", "MALWARE", "ANY_PLATFORM", "URL"},
+	{"\x00\x00\x00\x01", "SOCIAL_ENGINEERING", "ANY_PLATFORM", "URL"},
+	{"\x88\x98\x1e\x62", "SOCIAL_ENGINEERING", "ANY_PLATFORM", "URL"},
+	{"\xff\xff\xff\xff", "MALWARE", "WINDOWS", "URL"},
+	{"\xff\xff\xff\xfe", "MALWARE", "WINDOWS", "EXECUTABLE"},
+}
+
+// createTestDB creates a WAL mode database whose rows are still in the -wal
+// file when it returns: the writer stays open until the test ends.
+func createTestDB(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "gsb_v4.db")
+	w, err := sql.Open("sqlite3", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { w.Close() })
+	w.SetMaxOpenConns(1)
+
+	for _, q := range []string{
+		"PRAGMA journal_mode = WAL",
+		"PRAGMA wal_autocheckpoint = 0",
+		"CREATE TABLE hash_prefix (value BLOB, cue CHARACTER(4), threat_type CHARACTER VARYING(128), platform_type CHARACTER VARYING(128), threat_entry_type CHARACTER VARYING(128), timestamp TIMESTAMP, negative_expires_at TIMESTAMP, PRIMARY KEY (value, threat_type, platform_type, threat_entry_type))",
+	} {
+		if _, err := w.Exec(q); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, r := range testRows {
+		if _, err := w.Exec("INSERT INTO hash_prefix (value, threat_type, platform_type, threat_entry_type) VALUES (?, ?, ?, ?)",
+			[]byte(r.value), r.threatType, r.platformType, r.threatEntryType); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if fi, err := os.Stat(path + "-wal"); err != nil || fi.Size() == 0 {
+		t.Fatalf("the rows are not in the -wal file: %v", err)
+	}
+	return path
+}
+
+func TestPrefixes(t *testing.T) {
+	path := createTestDB(t)
+	db, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	vectors := []struct {
+		filter Filter
+		want   []string
+	}{
+		{Filter{}, []string{"\x00\x00\x00\x01", "\x00\x00\x00\x01", "\x00\x00\x00\x02\x00", "\x88\x98\x1e\x62", "\xff\xff\xff\xfe", "\xff\xff\xff\xff"}},
+		{Filter{PlatformType: AnyPlatform}, []string{"\x00\x00\x00\x01", "\x00\x00\x00\x01", "\x00\x00\x00\x02\x00", "\x88\x98\x1e\x62"}},
+		{Filter{ThreatType: "MALWARE", PlatformType: AnyPlatform}, []string{"\x00\x00\x00\x01", "\x00\x00\x00\x02\x00"}},
+		{Filter{ThreatEntryType: "EXECUTABLE"}, []string{"\xff\xff\xff\xfe"}},
+		{Filter{ThreatType: "UNWANTED_SOFTWARE"}, nil},
+	}
+	for _, v := range vectors {
+		var got []string
+		err := db.Prefixes(v.filter, func(h focal.HashPrefix) error {
+			got = append(got, string(h))
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("Prefixes(%+v): %v", v.filter, err)
+		}
+		var sorted focal.HashPrefixes
+		for _, s := range got {
+			sorted = append(sorted, focal.HashPrefix(s))
+		}
+		sorted.Sort()
+		got = nil
+		for _, h := range sorted {
+			got = append(got, string(h))
+		}
+		if !reflect.DeepEqual(got, v.want) {
+			t.Errorf("Prefixes(%+v) = %x, want %x", v.filter, got, v.want)
+		}
+	}
+
+	hs, err := db.HashSet(Filter{PlatformType: AnyPlatform})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hs.Len() != 3 {
+		t.Errorf("HashSet has %d prefixes, want 3 unique ones", hs.Len())
+	}
+	if hs.Lookup(focal.HashFromPattern("google.com/")) != 4 {
+		t.Error("HashSet does not hold the prefix of google.com/")
+	}
+
+	stop := errors.New("stop")
+	n := 0
+	err = db.Prefixes(Filter{}, func(focal.HashPrefix) error {
+		n++
+		return stop
+	})
+	if err != stop || n != 1 {
+		t.Errorf("Prefixes returned %v after %d prefixes, want the error of fn after 1", err, n)
+	}
+
+	// Lists may overlap across lengths: the shorter prefix is kept, whichever
+	// list comes first. Within a list, it is an error.
+	w, err := sql.Open("sqlite3", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+	for _, q := range []string{
+		"INSERT INTO hash_prefix (value, threat_type, platform_type, threat_entry_type) VALUES (x'ffffffff00', 'SOCIAL_ENGINEERING', 'WINDOWS', 'URL')",
+		"INSERT INTO hash_prefix (value, threat_type, platform_type, threat_entry_type) VALUES (x'00000002', 'SOCIAL_ENGINEERING', 'ANY_PLATFORM', 'URL')",
+	} {
+		if _, err := w.Exec(q); err != nil {
+			t.Fatal(err)
+		}
+	}
+	hs, err = db.HashSet(Filter{ThreatEntryType: "URL"})
+	if err != nil {
+		t.Fatalf("HashSet of lists overlapping across lengths: %v", err)
+	}
+	want := focal.HashPrefixes{"\x00\x00\x00\x01", "\x00\x00\x00\x02", "\x88\x98\x1e\x62", "\xff\xff\xff\xff"}
+	if got := hs.Export(); !reflect.DeepEqual(got, want) {
+		t.Errorf("HashSet of lists overlapping across lengths = %x, want %x", got, want)
+	}
+	if _, err := w.Exec("INSERT INTO hash_prefix (value, threat_type, platform_type, threat_entry_type) VALUES (x'ffffffff0102', 'MALWARE', 'WINDOWS', 'URL')"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.HashSet(Filter{ThreatEntryType: "URL"}); err == nil {
+		t.Error("HashSet of a list with overlapping prefixes succeeded")
+	}
+}
+
+func TestLists(t *testing.T) {
+	db, err := Open(createTestDB(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	got, err := db.Lists()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []List{
+		{"MALWARE", "ANY_PLATFORM", "URL", 2},
+		{"MALWARE", "WINDOWS", "EXECUTABLE", 1},
+		{"MALWARE", "WINDOWS", "URL", 1},
+		{"SOCIAL_ENGINEERING", "ANY_PLATFORM", "URL", 2},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Lists() = %+v, want %+v", got, want)
+	}
+}
+
+func TestOpenErrors(t *testing.T) {
+	dir := t.TempDir()
+	missing := filepath.Join(dir, "missing.db")
+	if _, err := Open(missing); err == nil {
+		t.Error("Open of a missing database succeeded")
+	}
+	if _, err := os.Stat(missing); !os.IsNotExist(err) {
+		t.Error("Open created the missing database")
+	}
+
+	other := filepath.Join(dir, "other.db")
+	w, err := sql.Open("sqlite3", other)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+	if _, err := w.Exec("CREATE TABLE full_hash (value BLOB)"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Open(other); err == nil {
+		t.Error("Open of a database without hash_prefix succeeded")
+	}
+
+	path := createTestDB(t)
+	w2, err := sql.Open("sqlite3", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w2.Close()
+	if _, err := w2.Exec("INSERT INTO hash_prefix (value, threat_type, platform_type, threat_entry_type) VALUES (x'0102', 'MALWARE', 'LINUX', 'URL')"); err != nil {
+		t.Fatal(err)
+	}
+	db, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if err := db.Prefixes(Filter{PlatformType: "LINUX"}, func(focal.HashPrefix) error { return nil }); err == nil {
+		t.Error("Prefixes accepted a 2-byte prefix")
+	}
+}
@@ -0,0 +1,233 @@
+// Package homograph finds internationalized hostnames that pass for other
+// hostnames: labels that mix scripts, labels made of characters confusable
+// with ASCII ones, and hostnames confusable with the domains of a brand list.
+//
+// Confusability is decided with the skeletons of UTS #39: two strings are
+// confusable if their skeletons are equal. See
+// https://www.unicode.org/reports/tr39/.
+package homograph
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"../focal"
+	"../github.com/mtibben/confusables"
+)
+
+// Host is the analysis of a hostname.
+type Host struct {
+	Host     string `json:"host"`     // hostname with A-labels, as blacklisted
+	Unicode  string `json:"unicode"`  // hostname with U-labels, as displayed
+	Skeleton string `json:"skeleton"` // UTS #39 skeleton of Unicode
+
+	// Scripts are the scripts of the labels of Unicode, e.g. Latin or
+	// Cyrillic. Characters common to all scripts, such as digits, are left
+	// out.
+	Scripts []string `json:"scripts,omitempty"`
+
+	// MixedScript holds the U-labels that mix scripts, such as a Latin
+	// label with a Cyrillic 'а'. Chinese, Japanese and Korean labels may mix
+	// Han with Bopomofo, with Hiragana and Katakana or with Hangul.
+	MixedScript []string `json:"mixedScript,omitempty"`
+
+	// Confusable holds the U-labels whose skeleton is all ASCII, i.e.
+	// labels that can pass for an ASCII label.
+	Confusable []string `json:"confusable,omitempty"`
+
+	// Collisions are the brand domains Unicode is confusable with.
+	Collisions []Collision `json:"collisions,omitempty"`
+
+	// Error is set if the hostname is not a valid IDNA hostname. The other
+	// fields are then computed from the labels that could be decoded.
+	Error string `json:"error,omitempty"`
+}
+
+// IDN reports whether h has U-labels.
+func (h *Host) IDN() bool {
+	return h.Unicode != h.Host
+}
+
+// Flagged reports whether h mixes scripts, has confusable labels or collides
+// with a brand domain.
+func (h *Host) Flagged() bool {
+	return len(h.MixedScript) > 0 || len(h.Confusable) > 0 || len(h.Collisions) > 0
+}
+
+// Analyze decodes the A-labels of host and looks for mixed-script and
+// confusable labels. Collisions with brand domains are found by
+// Brands.Collisions.
+func Analyze(host string) *Host {
+	h := &Host{Host: strings.ToLower(host)}
+	u, err := focal.IDNAProfile.ToUnicode(h.Host)
+	if err != nil {
+		h.Error = err.Error()
+	}
+	h.Unicode = u
+	h.Skeleton = Skeleton(u)
+
+	scripts := make(map[string]bool)
+	for _, label := range strings.Split(u, ".") {
+		if isASCII(label) {
+			for _, r := range label {
+				if script := scriptOf(r); script != "" {
+					scripts[script] = true
+				}
+			}
+			continue
+		}
+		set, mixed := labelScripts(label)
+		for script := range set {
+			scripts[script] = true
+		}
+		if mixed {
+			h.MixedScript = append(h.MixedScript, label)
+		}
+		if isASCII(Skeleton(label)) {
+			h.Confusable = append(h.Confusable, label)
+		}
+	}
+	for script := range scripts {
+		h.Scripts = append(h.Scripts, script)
+	}
+	sort.Strings(h.Scripts)
+	return h
+}
+
+// Skeleton returns the UTS #39 skeleton of a hostname. The hostname is
+// lowercased first, since hostnames are case-insensitive.
+func Skeleton(host string) string {
+	return confusables.Skeleton(strings.ToLower(host))
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= 0x80 {
+			return false
+		}
+	}
+	return true
+}
+
+// scriptOf returns the script of r, or "" for the characters of the Common
+// and Inherited scripts and unassigned ones.
+func scriptOf(r rune) string {
+	if r < 0x80 {
+		if 'a' <= r && r <= 'z' || 'A' <= r && r <= 'Z' {
+			return "Latin"
+		}
+		return ""
+	}
+	for name, table := range unicode.Scripts {
+		if unicode.Is(table, r) {
+			if name == "Common" || name == "Inherited" {
+				return ""
+			}
+			return name
+		}
+	}
+	return ""
+}
+
+// augmented are the script sets of UTS #39 section 5.1 that the CJK scripts
+// stand for: a label may mix Han and Hiragana because both are Japanese.
+var augmented = map[string][]string{
+	"Han":      {"Han", "Han with Bopomofo", "Japanese", "Korean"},
+	"Hiragana": {"Hiragana", "Japanese"},
+	"Katakana": {"Katakana", "Japanese"},
+	"Hangul":   {"Hangul", "Korean"},
+	"Bopomofo": {"Bopomofo", "Han with Bopomofo"},
+}
+
+// labelScripts returns the scripts of label and reports whether it mixes
+// scripts, i.e. whether the intersection of the augmented script sets of its
+// characters is empty.
+func labelScripts(label string) (scripts map[string]bool, mixed bool) {
+	scripts = make(map[string]bool)
+	var resolved map[string]bool // nil is the set of all scripts
+	for _, r := range label {
+		script := scriptOf(r)
+		if script == "" {
+			continue
+		}
+		scripts[script] = true
+		set := map[string]bool{script: true}
+		for _, s := range augmented[script] {
+			set[s] = true
+		}
+		if resolved == nil {
+			resolved = set
+			continue
+		}
+		for s := range resolved {
+			if !set[s] {
+				delete(resolved, s)
+			}
+		}
+	}
+	return scripts, resolved != nil && len(resolved) == 0
+}
+
+// Brand is a domain that phishing hosts imitate, e.g. from the Alexa top
+// sites.
+type Brand struct {
+	Domain string `json:"domain"`         // with A-labels
+	Rank   int    `json:"rank,omitempty"` // rank in the brand list
+}
+
+// Collision is a brand domain that a host suffix is confusable with.
+type Collision struct {
+	Brand
+	Suffix string `json:"suffix"` // suffix of the host, with A-labels
+}
+
+// Brands indexes brand domains by skeleton.
+type Brands struct {
+	bySkeleton map[string][]Brand
+}
+
+// NewBrands indexes brands. The domains are lowercased and converted to
+// A-labels; it fails on the first domain that is not a valid hostname.
+func NewBrands(brands []Brand) (*Brands, error) {
+	b := &Brands{bySkeleton: make(map[string][]Brand)}
+	for _, brand := range brands {
+		domain, err := focal.IDNAProfile.ToASCII(strings.ToLower(brand.Domain))
+		if err != nil {
+			return nil, fmt.Errorf("homograph: brand %q: %v", brand.Domain, err)
+		}
+		u, _ := focal.IDNAProfile.ToUnicode(domain)
+		brand.Domain = domain
+		skeleton := Skeleton(u)
+		b.bySkeleton[skeleton] = append(b.bySkeleton[skeleton], brand)
+	}
+	return b, nil
+}
+
+// Len returns the number of distinct brand skeletons.
+func (b *Brands) Len() int {
+	return len(b.bySkeleton)
+}
+
+// Collisions returns the brand domains that a suffix of h (of two labels or
+// more, as "www.pаypal.com" and "pаypal.com") is confusable with, without
+// being that domain. It also records them in h.Collisions.
+func (b *Brands) Collisions(h *Host) []Collision {
+	alabels := strings.Split(h.Host, ".")
+	ulabels := strings.Split(h.Unicode, ".")
+	if len(alabels) != len(ulabels) {
+		return nil
+	}
+	var collisions []Collision
+	for i := 0; i+1 < len(ulabels); i++ {
+		suffix := strings.Join(alabels[i:], ".")
+		for _, brand := range b.bySkeleton[Skeleton(strings.Join(ulabels[i:], "."))] {
+			if brand.Domain != suffix {
+				collisions = append(collisions, Collision{brand, suffix})
+			}
+		}
+	}
+	h.Collisions = collisions
+	return collisions
+}
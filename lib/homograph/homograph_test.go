@@ -0,0 +1,79 @@
+package homograph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAnalyze(t *testing.T) {
+	vectors := []struct {
+		host        string
+		unicode     string
+		scripts     []string
+		mixedScript []string
+		confusable  []string
+	}{
+		{"www.google.com", "www.google.com", []string{"Latin"}, nil, nil},
+		{"xn--pypal-4ve.com", "pаypal.com", []string{"Cyrillic", "Latin"}, []string{"pаypal"}, []string{"pаypal"}},
+		{"WWW.XN--PYPAL-4VE.COM", "www.pаypal.com", []string{"Cyrillic", "Latin"}, []string{"pаypal"}, []string{"pаypal"}},
+		{"xn--80ak6aa92e.com", "аррӏе.com", []string{"Cyrillic", "Latin"}, nil, []string{"аррӏе"}},
+		{"xn--ggle-55da.com", "gооgle.com", []string{"Cyrillic", "Latin"}, []string{"gооgle"}, []string{"gооgle"}},
+		{"xn--bcher-kva.de", "bücher.de", []string{"Latin"}, nil, nil},
+		{"xn--38jv62nb2a.jp", "日本ご.jp", []string{"Han", "Hiragana", "Latin"}, nil, nil},
+		{"xn--p1acf.xn--p1ai", "рус.рф", []string{"Cyrillic"}, nil, []string{"рус"}},
+	}
+	for _, v := range vectors {
+		h := Analyze(v.host)
+		if h.Error != "" {
+			t.Errorf("Analyze(%q): unexpected error %s", v.host, h.Error)
+		}
+		if h.Unicode != v.unicode {
+			t.Errorf("Analyze(%q).Unicode = %+q, want %+q", v.host, h.Unicode, v.unicode)
+		}
+		if !reflect.DeepEqual(h.Scripts, v.scripts) {
+			t.Errorf("Analyze(%q).Scripts = %q, want %q", v.host, h.Scripts, v.scripts)
+		}
+		if !reflect.DeepEqual(h.MixedScript, v.mixedScript) {
+			t.Errorf("Analyze(%q).MixedScript = %+q, want %+q", v.host, h.MixedScript, v.mixedScript)
+		}
+		if !reflect.DeepEqual(h.Confusable, v.confusable) {
+			t.Errorf("Analyze(%q).Confusable = %+q, want %+q", v.host, h.Confusable, v.confusable)
+		}
+	}
+
+	if h := Analyze("xn--a.com"); h.Error == "" {
+		t.Error("Analyze accepted an invalid A-label")
+	}
+}
+
+func TestCollisions(t *testing.T) {
+	brands, err := NewBrands([]Brand{{"paypal.com", 1}, {"Apple.com", 2}, {"google.com", 3}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	vectors := []struct {
+		host string
+		want []Collision
+	}{
+		{"www.paypal.com", nil},
+		{"paypal.com.example.org", nil},
+		{"www.xn--pypal-4ve.com", []Collision{{Brand{"paypal.com", 1}, "xn--pypal-4ve.com"}}},
+		{"paypa1.com", []Collision{{Brand{"paypal.com", 1}, "paypa1.com"}}},
+		{"login.xn--pple-43d.com", []Collision{{Brand{"apple.com", 2}, "xn--pple-43d.com"}}},
+		{"xn--ggle-55da.com", []Collision{{Brand{"google.com", 3}, "xn--ggle-55da.com"}}},
+		{"xn--bcher-kva.de", nil},
+	}
+	for _, v := range vectors {
+		h := Analyze(v.host)
+		if got := brands.Collisions(h); !reflect.DeepEqual(got, v.want) {
+			t.Errorf("Collisions(%q) = %+v, want %+v", v.host, got, v.want)
+		}
+		if h.Flagged() != (len(v.want) > 0 || len(h.MixedScript) > 0 || len(h.Confusable) > 0) {
+			t.Errorf("Analyze(%q).Flagged() = %v", v.host, h.Flagged())
+		}
+	}
+
+	if _, err := NewBrands([]Brand{{Domain: "a\u0378b.com"}}); err == nil {
+		t.Error("NewBrands accepted an invalid domain")
+	}
+}
@@ -0,0 +1,203 @@
+// Package lines reads the text inputs of the analyses, such as URL feeds and
+// prefix lists, line by line.
+//
+// Inputs are files, or the standard input for "-", and may be compressed
+// with gzip or bzip2: Open tells them by their magic bytes, not their names.
+// Lines can be of any length, end with "\n" or "\r\n", and the last one needs
+// no line ending. Lines that are not text are reported as malformed; lines
+// that are not valid UTF-8, such as the Latin-1 URLs of old feeds, are only
+// warned about, since the canonicalizer escapes their bytes.
+package lines
+
+import (
+	"bufio"
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"unicode/utf8"
+)
+
+// Stdin is the path of the standard input.
+const Stdin = "-"
+
+var (
+	gzipMagic  = []byte{0x1f, 0x8b}
+	bzip2Magic = []byte("BZh")
+	// bzip2Block is the magic of the first block of a bzip2 stream (the BCD
+	// digits of pi), after "BZh" and the block size.
+	bzip2Block = []byte{0x31, 0x41, 0x59, 0x26, 0x53, 0x59}
+)
+
+type readCloser struct {
+	io.Reader
+	closers []io.Closer
+}
+
+func (rc *readCloser) Close() error {
+	var err error
+	for _, c := range rc.closers {
+		if cerr := c.Close(); err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
+
+// Open opens the input at path, or the standard input if path is "-", and
+// decompresses it if it is compressed with gzip or bzip2.
+func Open(path string) (io.ReadCloser, error) {
+	var file *os.File
+	if path == Stdin {
+		file = os.Stdin
+	} else {
+		var err error
+		if file, err = os.Open(path); err != nil {
+			return nil, err
+		}
+	}
+	rc := &readCloser{}
+	if file != os.Stdin {
+		rc.closers = append(rc.closers, file)
+	}
+
+	br := bufio.NewReaderSize(file, 64*1024)
+	magic, _ := br.Peek(len(bzip2Magic) + 1 + len(bzip2Block))
+	switch {
+	case bytes.HasPrefix(magic, gzipMagic):
+		zr, err := gzip.NewReader(br)
+		if err != nil {
+			rc.Close()
+			return nil, fmt.Errorf("%s: %v", path, err)
+		}
+		rc.Reader = zr
+		rc.closers = append(rc.closers, zr)
+	case len(magic) == 10 && bytes.HasPrefix(magic, bzip2Magic) && '1' <= magic[3] && magic[3] <= '9' && bytes.Equal(magic[4:], bzip2Block):
+		rc.Reader = bzip2.NewReader(br)
+	default:
+		rc.Reader = br
+	}
+	return rc, nil
+}
+
+// ReadFile returns the contents of the input at path, as Open reads it.
+func ReadFile(path string) ([]byte, error) {
+	rc, err := Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	data, err := ioutil.ReadAll(rc)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return data, nil
+}
+
+// MalformedError is a line that is not text: it holds control characters
+// other than tabs. Reader.Warning reports the lines that are not valid UTF-8
+// with it too.
+type MalformedError struct {
+	Line   int
+	Reason string
+}
+
+func (e *MalformedError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Reason)
+}
+
+// Reader reads the lines of an input.
+type Reader struct {
+	r         *bufio.Reader
+	line      int
+	text      string
+	malformed *MalformedError
+	warning   *MalformedError
+	err       error
+}
+
+// NewReader returns a Reader reading the lines of r.
+func NewReader(r io.Reader) *Reader {
+	br, ok := r.(*bufio.Reader)
+	if !ok {
+		br = bufio.NewReaderSize(r, 64*1024)
+	}
+	return &Reader{r: br}
+}
+
+// Scan advances to the next line, which Text returns. It returns false at the
+// end of the input or on an error, which Err returns.
+func (r *Reader) Scan() bool {
+	if r.err != nil {
+		return false
+	}
+	b, err := r.r.ReadBytes('\n')
+	if err != nil && (err != io.EOF || len(b) == 0) {
+		if err != io.EOF {
+			r.err = err
+		}
+		r.text, r.malformed, r.warning = "", nil, nil
+		return false
+	}
+	r.line++
+	b = bytes.TrimSuffix(b, []byte("\n"))
+	b = bytes.TrimSuffix(b, []byte("\r"))
+	if r.line == 1 {
+		b = bytes.TrimPrefix(b, []byte("\ufeff"))
+	}
+	r.text = string(b)
+	r.malformed, r.warning = nil, nil
+	if reason := malformed(r.text); reason != "" {
+		r.malformed = &MalformedError{Line: r.line, Reason: reason}
+	}
+	if !utf8.ValidString(r.text) {
+		r.warning = &MalformedError{Line: r.line, Reason: "invalid UTF-8"}
+	}
+	return true
+}
+
+// Text returns the current line, without its line ending.
+func (r *Reader) Text() string {
+	return r.text
+}
+
+// Line returns the number of the current line, from 1.
+func (r *Reader) Line() int {
+	return r.line
+}
+
+// Malformed returns a *MalformedError if the current line is not text, and
+// nil otherwise.
+func (r *Reader) Malformed() error {
+	if r.malformed == nil {
+		return nil
+	}
+	return r.malformed
+}
+
+// Warning returns a *MalformedError if the current line is not valid UTF-8,
+// and nil otherwise. Unlike a malformed line, such a line may still be a URL.
+func (r *Reader) Warning() error {
+	if r.warning == nil {
+		return nil
+	}
+	return r.warning
+}
+
+// Err returns the first error of the input, if any.
+func (r *Reader) Err() error {
+	return r.err
+}
+
+// malformed returns why s is not a line of text, or "".
+func malformed(s string) string {
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c < 0x20 && c != '\t' || c == 0x7f {
+			return fmt.Sprintf("control character %U at byte %d", rune(c), i)
+		}
+	}
+	return ""
+}
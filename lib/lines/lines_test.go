@@ -0,0 +1,108 @@
+package lines
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// readAll returns the lines of r, and the numbers of the malformed ones and
+// of those with a warning.
+func readAll(t *testing.T, r *Reader) (lines []string, malformed, warned []int) {
+	t.Helper()
+	for r.Scan() {
+		lines = append(lines, r.Text())
+		if err := r.Malformed(); err != nil {
+			var me *MalformedError
+			if !errors.As(err, &me) || me.Line != r.Line() {
+				t.Errorf("line %d: Malformed() = %v", r.Line(), err)
+			}
+			malformed = append(malformed, r.Line())
+		}
+		if err := r.Warning(); err != nil {
+			var me *MalformedError
+			if !errors.As(err, &me) || me.Line != r.Line() {
+				t.Errorf("line %d: Warning() = %v", r.Line(), err)
+			}
+			warned = append(warned, r.Line())
+		}
+	}
+	if err := r.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return lines, malformed, warned
+}
+
+func TestReader(t *testing.T) {
+	long := "http://a.com/" + strings.Repeat("x", 1<<20)
+	input := "\ufeffhttp://a.com/\r\n" + long + "\n\nbad\x00line\n\xff\xfe\nhttp://tab\t.com/\nhttp://a.com/caf\xe9.html\nlast"
+	lines, malformed, warned := readAll(t, NewReader(strings.NewReader(input)))
+	want := []string{"http://a.com/", long, "", "bad\x00line", "\xff\xfe", "http://tab\t.com/", "http://a.com/caf\xe9.html", "last"}
+	if !reflect.DeepEqual(lines, want) {
+		t.Errorf("got %d lines, want %d", len(lines), len(want))
+		for i := range lines {
+			if i < len(want) && lines[i] != want[i] {
+				t.Errorf("line %d: got %.40q, want %.40q", i+1, lines[i], want[i])
+			}
+		}
+	}
+	if !reflect.DeepEqual(malformed, []int{4}) {
+		t.Errorf("malformed lines %v, want [4]", malformed)
+	}
+	// Invalid UTF-8, such as Latin-1, is only a warning.
+	if !reflect.DeepEqual(warned, []int{5, 7}) {
+		t.Errorf("warned lines %v, want [5 7]", warned)
+	}
+
+	if lines, _, _ := readAll(t, NewReader(strings.NewReader(""))); len(lines) != 0 {
+		t.Errorf("empty input: got %q", lines)
+	}
+}
+
+func TestOpen(t *testing.T) {
+	want := []string{"http://a.com/", "http://b.com/x", "", "http://c.com/"}
+	for _, path := range []string{"testdata/urls.txt", "testdata/urls.txt.gz", "testdata/urls.txt.bz2"} {
+		rc, err := Open(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		lines, _, _ := readAll(t, NewReader(rc))
+		rc.Close()
+		if !reflect.DeepEqual(lines, want) {
+			t.Errorf("%s: got %q, want %q", path, lines, want)
+		}
+	}
+
+	if _, err := Open("testdata/missing.txt"); err == nil {
+		t.Error("Open succeeded on a missing file")
+	}
+}
+
+func TestOpenStdin(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/urls.txt.gz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		w.Write(data)
+		w.Close()
+	}()
+	stdin := os.Stdin
+	os.Stdin = r
+	defer func() { os.Stdin = stdin }()
+
+	got, err := ReadFile(Stdin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "http://a.com/\r\nhttp://b.com/x\n\nhttp://c.com/"; string(got) != want {
+		t.Errorf("ReadFile(%q) = %q, want %q", Stdin, got, want)
+	}
+}
@@ -0,0 +1,446 @@
+package oprf
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"math"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Entry is an item of a release-json blacklist (see testData/release-json):
+// a canonicalized URL and, for blacklists with metadata, its type code
+// (0: others, 1: phishing, 2: malware).
+type Entry struct {
+	U string          `json:"u"`
+	M json.RawMessage `json:"m,omitempty"`
+}
+
+// ReadEntries reads a release-json blacklist, i.e., [{"u": ..., "m": ...}].
+func ReadEntries(path string) ([]Entry, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEntries(data)
+}
+
+// ParseEntries parses a release-json blacklist.
+func ParseEntries(data []byte) ([]Entry, error) {
+	var entries []Entry
+	if err := json.Unmarshal(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf")), &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// MarshalEntries encodes entries as a release-json blacklist, byte for byte
+// as JSON.stringify(...).replace(/\//g, '\\/') does in the converters of
+// testData/scripts-converting.
+func MarshalEntries(entries []Entry) []byte {
+	var b bytes.Buffer
+	b.WriteByte('[')
+	for i, e := range entries {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(`{"u":`)
+		writeJSString(&b, e.U)
+		if e.M != nil {
+			b.WriteString(`,"m":`)
+			b.Write(e.M)
+		}
+		b.WriteByte('}')
+	}
+	b.WriteByte(']')
+	return bytes.ReplaceAll(b.Bytes(), []byte("/"), []byte(`\/`))
+}
+
+// Blacklist is a published FOCAL blacklist, {"s": [...], "m": [...]}.
+//
+// S holds the 32-bit prefixes clients test first. M holds the t1 token of
+// every entry and, for blacklists with metadata, Meta holds the metadata of
+// the same entry encrypted with its t2 token.
+type Blacklist struct {
+	S    []uint32
+	M    []string
+	Meta []string
+}
+
+// WithMeta reports whether the blacklist carries metadata.
+func (bl *Blacklist) WithMeta() bool {
+	return bl.Meta != nil
+}
+
+// Prefix returns the 32-bit prefix FOCAL uses for u: the first four bytes of
+// sha256(u) as a little-endian uint32, i.e., new Uint32Array(hash)[0].
+func Prefix(u string) uint32 {
+	h := sha256.Sum256([]byte(u))
+	return binary.LittleEndian.Uint32(h[:4])
+}
+
+// Build computes the published blacklist of entries with the OPRF kind. The
+// blacklist carries metadata if the first entry has one, as in
+// buildSecBlackList.js. The tokens are computed on all CPUs.
+func Build(entries []Entry, key *Key, kind Kind) (*Blacklist, error) {
+	withMeta := len(entries) > 0 && entries[0].M != nil
+
+	bl := &Blacklist{
+		S: make([]uint32, len(entries)),
+		M: make([]string, len(entries)),
+	}
+	if withMeta {
+		bl.Meta = make([]string, len(entries))
+	}
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		buildErr error
+	)
+	next := make(chan int)
+	for w := 0; w < runtime.NumCPU(); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				e := entries[i]
+				t, err := kind.Tokens(key, e.U, withMeta)
+				if err != nil {
+					errOnce.Do(func() { buildErr = err })
+					continue
+				}
+				bl.S[i] = Prefix(e.U)
+				bl.M[i] = t.T1
+				if withMeta {
+					bl.Meta[i] = EncryptMeta(e.M, t.T2)
+				}
+			}
+		}()
+	}
+	for i := range entries {
+		next <- i
+	}
+	close(next)
+	wg.Wait()
+
+	if buildErr != nil {
+		return nil, buildErr
+	}
+	return bl, nil
+}
+
+// EncryptMeta encrypts the metadata m of an entry as {"t": m} with the token
+// t2, as strxor in buildSecBlackList.js does. m is written as JSON.stringify
+// writes it once parsed, e.g. 1.0 as 1 and "\u0041" as "A".
+func EncryptMeta(m json.RawMessage, t2 string) string {
+	// JSON.stringify drops undefined members.
+	val := "{}"
+	if len(m) > 0 {
+		if b, err := stringifyJSON(m); err == nil {
+			val = `{"t":` + string(b) + `}`
+		}
+	}
+	return xorString(val, t2)
+}
+
+// stringifyJSON returns the JSON value data as JSON.stringify(JSON.parse(data))
+// does: without spaces, with numbers as JS numbers and strings as
+// writeJSString writes them, and with the members of objects in the order of
+// JS, the array index keys first.
+func stringifyJSON(data []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var b bytes.Buffer
+	if err := writeJSValue(&b, dec); err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, errors.New("oprf: invalid JSON value")
+	}
+	return b.Bytes(), nil
+}
+
+// writeJSValue writes the next value of dec as JSON.stringify does.
+func writeJSValue(b *bytes.Buffer, dec *json.Decoder) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	switch v := tok.(type) {
+	case json.Delim:
+		if v == '[' {
+			b.WriteByte('[')
+			for i := 0; dec.More(); i++ {
+				if i > 0 {
+					b.WriteByte(',')
+				}
+				if err := writeJSValue(b, dec); err != nil {
+					return err
+				}
+			}
+			b.WriteByte(']')
+			_, err := dec.Token()
+			return err
+		}
+		return writeJSObject(b, dec)
+	case string:
+		writeJSString(b, v)
+	case json.Number:
+		f, err := strconv.ParseFloat(string(v), 64)
+		if err != nil && !math.IsInf(f, 0) {
+			return err
+		}
+		b.WriteString(formatJSNumber(f))
+	case bool:
+		b.WriteString(strconv.FormatBool(v))
+	case nil:
+		b.WriteString("null")
+	}
+	return nil
+}
+
+// writeJSObject writes the members of an object of dec, whose opening brace
+// was read. As in JS, a duplicate key keeps the place of its first occurrence
+// and the last value.
+func writeJSObject(b *bytes.Buffer, dec *json.Decoder) error {
+	var keys []string
+	values := make(map[string][]byte)
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key := tok.(string)
+		var v bytes.Buffer
+		if err := writeJSValue(&v, dec); err != nil {
+			return err
+		}
+		if _, ok := values[key]; !ok {
+			keys = append(keys, key)
+		}
+		values[key] = v.Bytes()
+	}
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+	sort.SliceStable(keys, func(i, j int) bool {
+		ii, iok := arrayIndex(keys[i])
+		ji, jok := arrayIndex(keys[j])
+		if iok && jok {
+			return ii < ji
+		}
+		return iok && !jok
+	})
+	b.WriteByte('{')
+	for i, key := range keys {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		writeJSString(b, key)
+		b.WriteByte(':')
+		b.Write(values[key])
+	}
+	b.WriteByte('}')
+	return nil
+}
+
+// arrayIndex reports whether key is an array index, which JS orders before
+// the other keys of an object, and returns it.
+func arrayIndex(key string) (uint32, bool) {
+	if key == "" || len(key) > 1 && key[0] == '0' {
+		return 0, false
+	}
+	n, err := strconv.ParseUint(key, 10, 32)
+	if err != nil || n == math.MaxUint32 {
+		return 0, false
+	}
+	return uint32(n), true
+}
+
+// formatJSNumber formats f as Number.prototype.toString does: integers
+// below 1e21 without exponent, other numbers with the shortest digits that
+// round trip, and with an exponent below 1e-6.
+func formatJSNumber(f float64) string {
+	switch {
+	case math.IsInf(f, 0) || math.IsNaN(f):
+		return "null"
+	case f == 0:
+		return "0"
+	case f < 0:
+		return "-" + formatJSNumber(-f)
+	}
+	// d.ddde±x, with k digits and n = x+1 digits before the point.
+	e := strconv.FormatFloat(f, 'e', -1, 64)
+	mant, exp := e[:strings.IndexByte(e, 'e')], e[strings.IndexByte(e, 'e')+1:]
+	digits := strings.Replace(mant, ".", "", 1)
+	x, _ := strconv.Atoi(exp)
+	k, n := len(digits), x+1
+	switch {
+	case k <= n && n <= 21:
+		return digits + strings.Repeat("0", n-k)
+	case 0 < n && n <= 21:
+		return digits[:n] + "." + digits[n:]
+	case -6 < n && n <= 0:
+		return "0." + strings.Repeat("0", -n) + digits
+	}
+	sign := "+"
+	if x < 0 {
+		sign, x = "-", -x
+	}
+	if k == 1 {
+		return digits + "e" + sign + strconv.Itoa(x)
+	}
+	return digits[:1] + "." + digits[1:] + "e" + sign + strconv.Itoa(x)
+}
+
+// DecryptMeta reverses EncryptMeta and returns the metadata m.
+func DecryptMeta(enc, t2 string) (json.RawMessage, error) {
+	var val struct {
+		T json.RawMessage `json:"t"`
+	}
+	if err := json.Unmarshal([]byte(xorString(enc, t2)), &val); err != nil {
+		return nil, err
+	}
+	return val.T, nil
+}
+
+// xorString XORs the UTF-8 bytes of s with the repeated bytes of mask and
+// decodes the result as UTF-8, as the strxor functions of FOCAL do.
+func xorString(s, mask string) string {
+	b := []byte(s)
+	for i := range b {
+		b[i] ^= mask[i%len(mask)]
+	}
+	return decodeUTF8(b)
+}
+
+// decodeUTF8 decodes b as Buffer.toString('utf8') does in Node: each maximal
+// subpart of an invalid sequence becomes one U+FFFD (the WHATWG UTF-8
+// decoder), where strings.ToValidUTF8 would replace a whole run of invalid
+// bytes with one.
+func decodeUTF8(b []byte) string {
+	var out strings.Builder
+	out.Grow(len(b))
+	for i := 0; i < len(b); {
+		c := b[i]
+		if c < utf8.RuneSelf {
+			out.WriteByte(c)
+			i++
+			continue
+		}
+		var need int
+		lo, hi := byte(0x80), byte(0xBF)
+		switch {
+		case c >= 0xC2 && c <= 0xDF:
+			need = 1
+		case c >= 0xE0 && c <= 0xEF:
+			need = 2
+			if c == 0xE0 {
+				lo = 0xA0
+			} else if c == 0xED {
+				hi = 0x9F
+			}
+		case c >= 0xF0 && c <= 0xF4:
+			need = 3
+			if c == 0xF0 {
+				lo = 0x90
+			} else if c == 0xF4 {
+				hi = 0x8F
+			}
+		default:
+			out.WriteRune(utf8.RuneError)
+			i++
+			continue
+		}
+		j := i + 1
+		for ; j <= i+need; j++ {
+			if j == len(b) || b[j] < lo || b[j] > hi {
+				break
+			}
+			lo, hi = 0x80, 0xBF
+		}
+		if j <= i+need {
+			// The byte at j, if any, starts the next sequence.
+			out.WriteRune(utf8.RuneError)
+		} else {
+			out.Write(b[i:j])
+		}
+		i = j
+	}
+	return out.String()
+}
+
+// MarshalJSON encodes the blacklist byte for byte as JSON.stringify does in
+// buildSecBlackList.js.
+func (bl *Blacklist) MarshalJSON() ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteString(`{"s":[`)
+	for i, s := range bl.S {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(strconv.FormatUint(uint64(s), 10))
+	}
+	b.WriteString(`],"m":[`)
+	for i, t1 := range bl.M {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		if bl.WithMeta() {
+			b.WriteByte('[')
+			writeJSString(&b, t1)
+			b.WriteByte(',')
+			writeJSString(&b, bl.Meta[i])
+			b.WriteByte(']')
+		} else {
+			writeJSString(&b, t1)
+		}
+	}
+	b.WriteString(`]}`)
+	return b.Bytes(), nil
+}
+
+// writeJSString writes s as a JSON string literal the way JSON.stringify
+// does, which differs from encoding/json in the characters it escapes.
+func writeJSString(b *bytes.Buffer, s string) {
+	const hexDigits = "0123456789abcdef"
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\b':
+			b.WriteString(`\b`)
+		case '\f':
+			b.WriteString(`\f`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				b.WriteString(`\u00`)
+				b.WriteByte(hexDigits[r>>4])
+				b.WriteByte(hexDigits[r&0xf])
+				continue
+			}
+			var buf [utf8.UTFMax]byte
+			b.Write(buf[:utf8.EncodeRune(buf[:], r)])
+		}
+	}
+	b.WriteByte('"')
+}
@@ -0,0 +1,368 @@
+package oprf
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"../focal"
+)
+
+// Paths of the blacklist endpoints of a FOCAL server, as in
+// extension/js/config.js.
+const (
+	PathMeta = "/api/meta"
+	PathData = "/api/data"
+)
+
+// DefaultTypes are the names of the metadata type codes, as g_arrType in
+// extension/js/background.js.
+var DefaultTypes = []string{"Phishing"}
+
+// Meta is the manifest a FOCAL server publishes for a blacklist at
+// /api/meta (see web/routes/api.js).
+type Meta struct {
+	Source   string `json:"source"`
+	Version  string `json:"version"`
+	URL      string `json:"url"`
+	SecType  Kind   `json:"sectype"`
+	WithMeta bool   `json:"withmeta"`
+	E        string `json:"e"`
+	N        string `json:"n"`
+	Num      int    `json:"num"`
+}
+
+// UnmarshalJSON decodes a published blacklist, where the items of "m" are
+// either t1 tokens or [t1, encrypted metadata] pairs.
+func (bl *Blacklist) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		S []uint32          `json:"s"`
+		M []json.RawMessage `json:"m"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	*bl = Blacklist{S: raw.S, M: make([]string, len(raw.M))}
+	for i, m := range raw.M {
+		if len(m) > 0 && m[0] == '[' {
+			var pair []string
+			if err := json.Unmarshal(m, &pair); err != nil || len(pair) != 2 {
+				return fmt.Errorf("oprf: invalid blacklist item %d", i)
+			}
+			if bl.Meta == nil {
+				bl.Meta = make([]string, len(raw.M))
+			}
+			bl.M[i], bl.Meta[i] = pair[0], pair[1]
+			continue
+		}
+		if err := json.Unmarshal(m, &bl.M[i]); err != nil {
+			return fmt.Errorf("oprf: invalid blacklist item %d", i)
+		}
+	}
+	return nil
+}
+
+// Result is a blacklist hit, as returned by checkRecords in
+// extension/js/background.js. Type is only set for blacklists with metadata.
+type Result struct {
+	URL    string `json:"url"`
+	Type   string `json:"type,omitempty"`
+	Source string `json:"source"`
+}
+
+// source is a subscribed blacklist.
+type source struct {
+	meta     Meta
+	n, e     *big.Int
+	prefixes *focal.HashSet
+	tokens   map[string]string // t1 -> encrypted metadata
+}
+
+// Client checks URLs against published FOCAL blacklists. It only learns
+// whether a URL is blacklisted by running the OPRF with the server of a
+// blacklist, for the URL patterns whose 32-bit prefix is in the blacklist.
+type Client struct {
+	// HTTPClient is used to reach the servers; http.DefaultClient if nil.
+	HTTPClient *http.Client
+	// Types names the metadata type codes; DefaultTypes if nil.
+	Types []string
+	// Whitelist holds URL patterns that are never reported.
+	Whitelist map[string]bool
+
+	sources []*source
+}
+
+// AddSource subscribes the client to a blacklist. The OPRF requests are sent
+// to meta.URL, the server that published it.
+func (c *Client) AddSource(meta Meta, bl *Blacklist) error {
+	s := &source{
+		meta:   meta,
+		tokens: make(map[string]string, len(bl.M)),
+	}
+	switch meta.SecType {
+	case RSA:
+		var ok1, ok2 bool
+		s.n, ok1 = new(big.Int).SetString(meta.N, 10)
+		s.e, ok2 = new(big.Int).SetString(meta.E, 10)
+		if !ok1 || !ok2 {
+			return fmt.Errorf("oprf: invalid RSA key for %s", meta.Source)
+		}
+	case EC:
+	default:
+		return fmt.Errorf("oprf: unknown OPRF type %q", string(meta.SecType))
+	}
+	if meta.WithMeta != bl.WithMeta() && len(bl.M) > 0 {
+		return fmt.Errorf("oprf: withmeta of %s does not match its blacklist", meta.Source)
+	}
+
+	phs := make(focal.HashPrefixes, len(bl.S))
+	for i, p := range bl.S {
+		phs[i] = focal.HashPrefixFromUint32LE(p)
+	}
+	s.prefixes = focal.NewHashSet(phs)
+	for i, t1 := range bl.M {
+		s.tokens[t1] = ""
+		if bl.WithMeta() {
+			s.tokens[t1] = bl.Meta[i]
+		}
+	}
+
+	// A newer version of a source replaces the old one.
+	for i, old := range c.sources {
+		if old.meta.Source == meta.Source {
+			c.sources[i] = s
+			return nil
+		}
+	}
+	c.sources = append(c.sources, s)
+	return nil
+}
+
+// Subscribe downloads the primary blacklist of a FOCAL server and adds it to
+// the client, as updateViaUrl in extension/js/option.js does.
+func (c *Client) Subscribe(serverURL string) (*Meta, error) {
+	serverURL = strings.TrimSuffix(serverURL, "/")
+	var meta Meta
+	if err := c.getJSON(serverURL+PathMeta, &meta); err != nil {
+		return nil, err
+	}
+	var bl Blacklist
+	if err := c.getJSON(serverURL+PathData+"?version="+url.QueryEscape(meta.Version), &bl); err != nil {
+		return nil, err
+	}
+	if err := c.AddSource(meta, &bl); err != nil {
+		return nil, err
+	}
+	return &meta, nil
+}
+
+// CheckURL decomposes the URL into its patterns and reports the first one
+// that is blacklisted, or nil if none is.
+func (c *Client) CheckURL(rawURL string) (*Result, error) {
+	patterns, err := focal.GeneratePatterns(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range patterns {
+		if c.Whitelist[p] {
+			return nil, nil
+		}
+		res, err := c.CheckRecords(p)
+		if err != nil || res != nil {
+			return res, err
+		}
+	}
+	return nil, nil
+}
+
+// CheckRecords checks a single URL pattern against all sources.
+func (c *Client) CheckRecords(pattern string) (*Result, error) {
+	hash := focal.HashFromPattern(pattern)
+	for _, s := range c.sources {
+		if s.prefixes.Lookup(hash) == 0 {
+			continue
+		}
+
+		t, err := c.tokens(s, pattern)
+		if err != nil {
+			return nil, err
+		}
+		enc, ok := s.tokens[t.T1]
+		if !ok {
+			continue
+		}
+		res := &Result{URL: pattern, Source: s.meta.Source}
+		if s.meta.WithMeta {
+			m, err := DecryptMeta(enc, t.T2)
+			if err != nil {
+				return nil, err
+			}
+			res.Type = c.typeName(m)
+		}
+		return res, nil
+	}
+	return nil, nil
+}
+
+func (c *Client) typeName(m json.RawMessage) string {
+	types := c.Types
+	if types == nil {
+		types = DefaultTypes
+	}
+	i, err := strconv.Atoi(string(m))
+	if err != nil || i < 0 || i >= len(types) {
+		return ""
+	}
+	return types[i]
+}
+
+// tokens runs the blinded OPRF of the source for u.
+func (c *Client) tokens(s *source, u string) (Token, error) {
+	if s.meta.SecType == RSA {
+		return c.rsaTokens(s, u)
+	}
+	return c.ecTokens(s, u)
+}
+
+func (c *Client) rsaTokens(s *source, u string) (Token, error) {
+	suffixes := []string{"*1"}
+	if s.meta.WithMeta {
+		suffixes = append(suffixes, "*2")
+	}
+
+	var (
+		req = make(map[string]string)
+		rs  []*big.Int
+	)
+	for i, suffix := range suffixes {
+		r, err := randomCoprime(s.n)
+		if err != nil {
+			return Token{}, err
+		}
+		rs = append(rs, r)
+		x := new(big.Int).Exp(r, s.e, s.n)
+		x.Mul(x, rsaHash(u, suffix)).Mod(x, s.n)
+		req["x"+strconv.Itoa(i+1)] = x.String()
+	}
+
+	var resp struct {
+		Y1 string `json:"y1"`
+		Y2 string `json:"y2"`
+	}
+	if err := c.postJSON(s.meta.URL+PathRSA, req, &resp); err != nil {
+		return Token{}, err
+	}
+
+	var t Token
+	for i, y := range []string{resp.Y1, resp.Y2}[:len(rs)] {
+		sig, ok := new(big.Int).SetString(y, 16)
+		if !ok {
+			return Token{}, errors.New("oprf: invalid RSA response")
+		}
+		sig.Mul(sig, new(big.Int).ModInverse(rs[i], s.n)).Mod(sig, s.n)
+		if i == 0 {
+			t.T1 = RSAFinalize(sig)
+		} else {
+			t.T2 = RSAFinalize(sig)
+		}
+	}
+	return t, nil
+}
+
+func (c *Client) ecTokens(s *source, u string) (Token, error) {
+	px, py, err := HashToCurve([]byte(u))
+	if err != nil {
+		return Token{}, err
+	}
+	order := p256.Params().N
+	r, err := randomCoprime(order)
+	if err != nil {
+		return Token{}, err
+	}
+	bx, by := scalarMult(r, px, py)
+
+	req := map[string]interface{}{"x": EncodePoint(bx, by), "withmeta": s.meta.WithMeta}
+	var resp struct {
+		Y1 string `json:"y1"`
+		Y2 string `json:"y2"`
+	}
+	if err := c.postJSON(s.meta.URL+PathEC, req, &resp); err != nil {
+		return Token{}, err
+	}
+
+	rInv := new(big.Int).ModInverse(r, order)
+	var t Token
+	for i, y := range []string{resp.Y1, resp.Y2} {
+		if i == 1 && !s.meta.WithMeta {
+			break
+		}
+		sx, sy, err := DecodePoint(y)
+		if err != nil {
+			return Token{}, err
+		}
+		tok := hex.EncodeToString(CompressPoint(scalarMult(rInv, sx, sy)))
+		if i == 0 {
+			t.T1 = tok
+		} else {
+			t.T2 = tok
+		}
+	}
+	return t, nil
+}
+
+// randomCoprime returns a random blinding factor in [1, n) coprime to n.
+func randomCoprime(n *big.Int) (*big.Int, error) {
+	one := big.NewInt(1)
+	for {
+		r, err := rand.Int(rand.Reader, n)
+		if err != nil {
+			return nil, err
+		}
+		if r.Sign() > 0 && new(big.Int).GCD(nil, nil, r, n).Cmp(one) == 0 {
+			return r, nil
+		}
+	}
+}
+
+func (c *Client) httpClient() *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+	return http.DefaultClient
+}
+
+func (c *Client) getJSON(url string, v interface{}) error {
+	resp, err := c.httpClient().Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("oprf: GET %s: %s", url, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+func (c *Client) postJSON(url string, req, v interface{}) error {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	resp, err := c.httpClient().Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("oprf: POST %s: %s", url, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
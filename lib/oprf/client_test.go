@@ -0,0 +1,102 @@
+package oprf
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+// newTestServer serves a FOCAL server that publishes the test blacklist list
+// built with the OPRF kind.
+func newTestServer(t *testing.T, list string, kind Kind) *httptest.Server {
+	key := loadTestKey(t)
+	entries, err := ReadEntries(filepath.Join("testdata", list+".json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	bl, err := Build(entries, key, kind)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mux := http.NewServeMux()
+	srv := httptest.NewServer(mux)
+	mux.HandleFunc(PathMeta, func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(Meta{
+			Source:   "test-" + list,
+			Version:  "1",
+			URL:      srv.URL,
+			SecType:  kind,
+			WithMeta: bl.WithMeta(),
+			E:        key.E.String(),
+			N:        key.N.String(),
+			Num:      len(entries),
+		})
+	})
+	mux.HandleFunc(PathData, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("version") != "1" {
+			http.NotFound(w, r)
+			return
+		}
+		json.NewEncoder(w).Encode(bl)
+	})
+	mux.Handle("/oprf/", NewHandler(key))
+	return srv
+}
+
+func TestClient(t *testing.T) {
+	vectors := []struct {
+		url  string
+		want *Result
+	}{
+		{"http://amazon.co.uk.security-check.ga/login/index.php?x=1", &Result{URL: "amazon.co.uk.security-check.ga/", Type: "Phishing", Source: "test-meta"}},
+		{"https://unsafe.ppsb.com/", &Result{URL: "unsafe.ppsb.com/", Type: "Malware", Source: "test-meta"}},
+		{"tonyyeo.com", &Result{URL: "tonyyeo.com/", Type: "Others", Source: "test-meta"}},
+		{"http://extragiftforall.000webhostapp.com/Aa/index.php#top", &Result{URL: "extragiftforall.000webhostapp.com/Aa/index.php", Source: "test-nometa"}},
+		{"http://extragiftforall.000webhostapp.com/Aa/", nil},
+		{"http://www.google.com/", nil},
+	}
+
+	for _, kind := range []Kind{RSA, EC} {
+		c := &Client{Types: []string{"Others", "Phishing", "Malware"}}
+		for _, list := range []string{"meta", "nometa"} {
+			srv := newTestServer(t, list, kind)
+			defer srv.Close()
+			if _, err := c.Subscribe(srv.URL + "/"); err != nil {
+				t.Fatalf("Subscribe(%s, %s): %v", list, kind, err)
+			}
+		}
+
+		for i, v := range vectors {
+			got, err := c.CheckURL(v.url)
+			if err != nil {
+				t.Errorf("test %d, %s, CheckURL(%q): %v", i, kind, v.url, err)
+				continue
+			}
+			if (got == nil) != (v.want == nil) || got != nil && *got != *v.want {
+				t.Errorf("test %d, %s, CheckURL(%q) = %+v, want %+v", i, kind, v.url, got, v.want)
+			}
+		}
+	}
+}
+
+func TestClientDefaultTypes(t *testing.T) {
+	srv := newTestServer(t, "meta", EC)
+	defer srv.Close()
+
+	c := &Client{Whitelist: map[string]bool{"tonyyeo.com/": true}}
+	if _, err := c.Subscribe(srv.URL); err != nil {
+		t.Fatal(err)
+	}
+
+	// Like g_arrType in background.js, only type 0 has a name by default.
+	got, err := c.CheckURL("http://amazon.co.uk.security-check.ga/")
+	if err != nil || got == nil || got.Type != "" {
+		t.Errorf("CheckURL = %+v, %v, want a hit without a type", got, err)
+	}
+	if got, err := c.CheckURL("http://tonyyeo.com/"); err != nil || got != nil {
+		t.Errorf("CheckURL of a whitelisted URL = %+v, %v, want nil", got, err)
+	}
+}
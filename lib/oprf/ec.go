@@ -0,0 +1,87 @@
+package oprf
+
+import (
+	"crypto/elliptic"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"math/big"
+)
+
+// maxHashToCurveTries bounds the try-and-increment loop of HashToCurve. It is
+// the bound of web/libs/ecoprf.js; a try fails with probability about 1/2.
+const maxHashToCurveTries = 200
+
+var p256 = elliptic.P256()
+
+// HashToCurve maps seed to a P-256 point the way sjcl-based ecoprf.js does:
+// the i-th try hashes the previous digest (the seed for the first try) and a
+// little-endian 32-bit counter, and uses the digest as the x coordinate of a
+// point with an even y.
+func HashToCurve(seed []byte) (x, y *big.Int, err error) {
+	h := sha256.New()
+	for i := 0; i < maxHashToCurveTries; i++ {
+		h.Reset()
+		h.Write(seed)
+		// Only the low byte of the counter is set, as in ecoprf.js.
+		h.Write([]byte{byte(i), 0, 0, 0})
+		digest := h.Sum(nil)
+
+		// ecoprf.js also tries the 0x03 tag, but it only flips the sign of y
+		// and so it never succeeds when the 0x02 tag fails.
+		if x, y := decompressPoint(digest, 0x02); x != nil {
+			return x, y, nil
+		}
+		seed = digest
+	}
+	return nil, nil, errors.New("oprf: hash to curve failed")
+}
+
+// decompressPoint returns the point with the x coordinate xb (big-endian) and
+// the y parity of the SEC1 tag, or nil if there is no such point.
+func decompressPoint(xb []byte, tag byte) (x, y *big.Int) {
+	params := p256.Params()
+	x = new(big.Int).SetBytes(xb)
+	x.Mod(x, params.P)
+
+	// y^2 = x^3 - 3x + b (mod p)
+	rh := new(big.Int).Exp(x, big.NewInt(3), params.P)
+	threeX := new(big.Int).Lsh(x, 1)
+	threeX.Add(threeX, x)
+	rh.Sub(rh, threeX)
+	rh.Add(rh, params.B)
+	rh.Mod(rh, params.P)
+
+	// modsqrt(z) for p = 3 mod 4 is z^((p+1)/4)
+	e := new(big.Int).Add(params.P, big.NewInt(1))
+	e.Rsh(e, 2)
+	y = new(big.Int).Exp(rh, e, params.P)
+	if y.Bit(0) != uint(tag&1) {
+		y.Sub(params.P, y)
+	}
+	if !p256.IsOnCurve(x, y) {
+		return nil, nil
+	}
+	return x, y
+}
+
+// scalarMult returns k*(x, y). The scalar is reduced modulo the group order
+// first, as sjcl does not require it to be smaller.
+func scalarMult(k, x, y *big.Int) (*big.Int, *big.Int) {
+	params := p256.Params()
+	s := new(big.Int).Mod(k, params.N)
+	return p256.ScalarMult(x, y, s.FillBytes(make([]byte, (params.N.BitLen()+7)/8)))
+}
+
+// CompressPoint returns the SEC1 compressed form of a point, i.e., the y
+// parity tag followed by the 32-byte x coordinate.
+func CompressPoint(x, y *big.Int) []byte {
+	b := make([]byte, 33)
+	b[0] = 0x02 | byte(y.Bit(0))
+	x.FillBytes(b[1:])
+	return b
+}
+
+func ecToken(k, x, y *big.Int) string {
+	return hex.EncodeToString(CompressPoint(scalarMult(k, x, y)))
+}
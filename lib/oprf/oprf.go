@@ -0,0 +1,142 @@
+// Package oprf implements the oblivious PRF (OPRF) tokens FOCAL uses to
+// publish blacklists: the RSA blind-signature based OPRF and the P-256 EC
+// OPRF of web/buildSecBlackList.js, web/routes/oprf.js and
+// extension/js/oprf.js.
+//
+// For a canonicalized URL u, the server computes two tokens: t1 indexes the
+// published blacklist and t2 masks the metadata of blacklists with metadata.
+// Both are derived with the server's secret key, so a client can only obtain
+// them by running the OPRF with the server.
+package oprf
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+)
+
+// Kind selects the OPRF used to compute the tokens of a blacklist.
+type Kind string
+
+const (
+	// RSA is the RSA blind-signature based OPRF:
+	//	t = sha256(sha256(u + suffix)^d mod n).
+	RSA Kind = "rsa"
+	// EC is the P-256 OPRF: t = compress(k * hashToCurve(u)).
+	EC Kind = "ec"
+)
+
+// Token holds the OPRF outputs of a URL as lowercase hex strings. T2 is only
+// computed for blacklists with metadata.
+type Token struct {
+	T1 string
+	T2 string
+}
+
+// Key holds the server secrets of web/config/default.json.
+type Key struct {
+	N *big.Int // RSA modulus
+	E *big.Int // RSA public exponent
+	D *big.Int // RSA private exponent
+
+	K1 *big.Int // EC scalar of t1
+	K2 *big.Int // EC scalar of t2
+}
+
+// serverConfig mirrors the "server" section of web/config/default.json.
+type serverConfig struct {
+	N   string `json:"n"`
+	E   string `json:"e"`
+	D   string `json:"d"`
+	SK1 string `json:"sk1"`
+	SK2 string `json:"sk2"`
+}
+
+// LoadKey reads the OPRF secrets from a FOCAL server config file.
+func LoadKey(path string) (*Key, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseKey(data)
+}
+
+// ParseKey parses the OPRF secrets from the contents of a FOCAL server config
+// file. The RSA values are decimal strings and the EC scalars are base64
+// encoded big-endian integers.
+func ParseKey(data []byte) (*Key, error) {
+	// The configs are written by Windows editors and may carry a UTF-8 BOM.
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+
+	var config struct {
+		Server serverConfig `json:"server"`
+	}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, err
+	}
+	sc := config.Server
+
+	k := new(Key)
+	var ok bool
+	for _, v := range []struct {
+		name string
+		s    string
+		dst  **big.Int
+	}{{"n", sc.N, &k.N}, {"e", sc.E, &k.E}, {"d", sc.D, &k.D}} {
+		if v.s == "" {
+			continue
+		}
+		if *v.dst, ok = new(big.Int).SetString(v.s, 10); !ok {
+			return nil, fmt.Errorf("oprf: invalid %s in config", v.name)
+		}
+	}
+	for _, v := range []struct {
+		name string
+		s    string
+		dst  **big.Int
+	}{{"sk1", sc.SK1, &k.K1}, {"sk2", sc.SK2, &k.K2}} {
+		if v.s == "" {
+			continue
+		}
+		b, err := base64.StdEncoding.DecodeString(v.s)
+		if err != nil {
+			return nil, fmt.Errorf("oprf: invalid %s in config: %v", v.name, err)
+		}
+		*v.dst = new(big.Int).SetBytes(b)
+	}
+	return k, nil
+}
+
+// Tokens computes the OPRF tokens of the URL u with the server key. T2 is only
+// computed if withMeta is set.
+func (kind Kind) Tokens(key *Key, u string, withMeta bool) (Token, error) {
+	switch kind {
+	case RSA:
+		if key.N == nil || key.D == nil {
+			return Token{}, errors.New("oprf: missing RSA key")
+		}
+		t := Token{T1: rsaToken(key, u, "*1")}
+		if withMeta {
+			t.T2 = rsaToken(key, u, "*2")
+		}
+		return t, nil
+	case EC:
+		if key.K1 == nil || (withMeta && key.K2 == nil) {
+			return Token{}, errors.New("oprf: missing EC key")
+		}
+		x, y, err := HashToCurve([]byte(u))
+		if err != nil {
+			return Token{}, err
+		}
+		t := Token{T1: ecToken(key.K1, x, y)}
+		if withMeta {
+			t.T2 = ecToken(key.K2, x, y)
+		}
+		return t, nil
+	}
+	return Token{}, fmt.Errorf("oprf: unknown OPRF type %q", string(kind))
+}
@@ -0,0 +1,154 @@
+package oprf
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+// The expected values below were produced by web/buildSecBlackList.js with
+// the keys of web/config/default.json.
+const testConfig = "../../web/config/default.json"
+
+func loadTestKey(t *testing.T) *Key {
+	key, err := LoadKey(testConfig)
+	if err != nil {
+		t.Fatalf("LoadKey(%q): %v", testConfig, err)
+	}
+	return key
+}
+
+func TestTokens(t *testing.T) {
+	key := loadTestKey(t)
+
+	vectors := []struct {
+		url    string
+		prefix uint32
+		rsa    Token
+		ec     Token
+	}{{
+		url:    "unsafe.ppsb.com/",
+		prefix: 3981947842,
+		rsa: Token{
+			T1: "2e371324425449ad4afac01192158a5c2b012f9c12ff7dadca53d58688be5883",
+			T2: "62834d7e60af3ce8d14acc7506e7bd0d6fc3ee82503fa0a2c868568c56f26c82",
+		},
+		ec: Token{
+			T1: "02a339f20e426bb4a8718a47507e35f096bdc71793f97641899e329b24fd5705ee",
+			T2: "03c230c2f43d232ae8227b0999d3a00004d89a568f2391dde0a288d3718680b6c8",
+		},
+	}, {
+		url:    "www.xn--mlat-zra.com/a b",
+		prefix: 2509386158,
+		rsa: Token{
+			T1: "364548ba18ab68767e480132ea3d71432055639ee0d9823de7af544cfa5baf54",
+			T2: "407378a3bfceae82342fcd35cca266b5e935fbc3e957ce0900095daef0285abf",
+		},
+		ec: Token{
+			T1: "02d366e12fadfce740fd2aab84393529d4dac4975c275a56f79a73d3daf428ef1d",
+			T2: "029cd91453c8e2d4632493f897964d459394838acac9ce000c4b9f241b1c9a832f",
+		},
+	}, {
+		url:    "extragiftforall.000webhostapp.com/Buat%20nanti%20sc%20ml/moskov.php",
+		prefix: 3247692365,
+		rsa: Token{
+			T1: "299f877affd3a1dc833098c7fbf951f934cb833df37e84d4199bf117e1313b3d",
+			T2: "e9c4bee45afb64c8df048922c034e1ed4bb93aed1e8f98f15cb50a4aedc01ac2",
+		},
+		ec: Token{
+			T1: "030536dd574d0d55f744832acba1b745cc0bcb8809611ed9b6d7de87f68ff19e96",
+			T2: "0390a10244fc3450ff6dd3c372b202641d3777c0f2b1a65198fcd74d9c610021f6",
+		},
+	}}
+
+	for i, v := range vectors {
+		if p := Prefix(v.url); p != v.prefix {
+			t.Errorf("test %d, Prefix(%q) = %d, want %d", i, v.url, p, v.prefix)
+		}
+		for _, kind := range []Kind{RSA, EC} {
+			want := v.rsa
+			if kind == EC {
+				want = v.ec
+			}
+			got, err := kind.Tokens(key, v.url, true)
+			if err != nil {
+				t.Errorf("test %d, %s.Tokens(%q): %v", i, kind, v.url, err)
+				continue
+			}
+			if got != want {
+				t.Errorf("test %d, %s.Tokens(%q) = %+v, want %+v", i, kind, v.url, got, want)
+			}
+			got, _ = kind.Tokens(key, v.url, false)
+			if got != (Token{T1: want.T1}) {
+				t.Errorf("test %d, %s.Tokens(%q) without meta = %+v, want T1 only", i, kind, v.url, got)
+			}
+		}
+	}
+}
+
+func TestBuild(t *testing.T) {
+	key := loadTestKey(t)
+
+	// The encrypted metadata of escape.json holds <, > and invalid UTF-8,
+	// which JSON.stringify writes raw and Buffer.toString replaces. The
+	// metadata of normalize.json is not written as JSON.stringify writes it.
+	for _, list := range []string{"meta", "nometa", "escape", "normalize"} {
+		entries, err := ReadEntries(filepath.Join("testdata", list+".json"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, kind := range []Kind{RSA, EC} {
+			golden := filepath.Join("testdata", list+"."+string(kind)+".json")
+			want, err := ioutil.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			bl, err := Build(entries, key, kind)
+			if err != nil {
+				t.Errorf("Build(%s, %s): %v", list, kind, err)
+				continue
+			}
+			got, _ := bl.MarshalJSON()
+			if !bytes.Equal(got, want) {
+				t.Errorf("Build(%s, %s):\ngot  %s\nwant %s", list, kind, got, want)
+			}
+
+			if bl.WithMeta() != (list != "nometa") {
+				t.Errorf("Build(%s, %s).WithMeta() = %v", list, kind, bl.WithMeta())
+			}
+			for i := range bl.Meta {
+				tok, _ := kind.Tokens(key, entries[i].U, true)
+				if strings.ContainsRune(bl.Meta[i], utf8.RuneError) {
+					continue // the replaced bytes are lost, in FOCAL too
+				}
+				meta, _ := stringifyJSON(entries[i].M)
+				m, err := DecryptMeta(bl.Meta[i], tok.T2)
+				if err != nil || !bytes.Equal(m, meta) {
+					t.Errorf("DecryptMeta(%q) = %s, %v, want %s", bl.Meta[i], m, err, meta)
+				}
+			}
+		}
+	}
+}
+
+func TestMarshalEntries(t *testing.T) {
+	for _, list := range []string{"meta", "nometa"} {
+		path := filepath.Join("testdata", list+".json")
+		want, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		entries, err := ParseEntries(want)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want = bytes.TrimSpace(want)
+		if got := MarshalEntries(entries); !bytes.Equal(got, want) {
+			t.Errorf("MarshalEntries(%s):\ngot  %s\nwant %s", list, got, want)
+		}
+	}
+}
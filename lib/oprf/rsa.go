@@ -0,0 +1,25 @@
+package oprf
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"math/big"
+)
+
+// rsaHash returns sha256(u + suffix) as an integer, the value the client
+// blinds and the server signs. The suffix is "*1" for t1 and "*2" for t2.
+func rsaHash(u, suffix string) *big.Int {
+	h := sha256.Sum256([]byte(u + suffix))
+	return new(big.Int).SetBytes(h[:])
+}
+
+// RSAFinalize turns an (unblinded) RSA signature into a token. The signature
+// is hashed in its decimal form, as bigInt.toString() returns it.
+func RSAFinalize(sig *big.Int) string {
+	h := sha256.Sum256([]byte(sig.String()))
+	return hex.EncodeToString(h[:])
+}
+
+func rsaToken(key *Key, u, suffix string) string {
+	return RSAFinalize(new(big.Int).Exp(rsaHash(u, suffix), key.D, key.N))
+}
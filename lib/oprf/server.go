@@ -0,0 +1,228 @@
+package oprf
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+)
+
+// maxRequestSize is the request body limit of express.json().
+const maxRequestSize = 100 << 10
+
+// Paths of the OPRF endpoints, as in extension/js/config.js.
+const (
+	PathRSA = "/oprf/rsa"
+	PathEC  = "/oprf/ec"
+)
+
+// RSAEvaluate signs the blinded value x with the server key, i.e., returns
+// x^d mod n. It rejects values outside of [0, n).
+func RSAEvaluate(key *Key, x *big.Int) (*big.Int, error) {
+	if key.N == nil || key.D == nil {
+		return nil, errors.New("oprf: missing RSA key")
+	}
+	if x.Sign() < 0 || x.Cmp(key.N) >= 0 {
+		return nil, errors.New("oprf: value out of range")
+	}
+	return new(big.Int).Exp(x, key.D, key.N), nil
+}
+
+// ECEvaluate multiplies the blinded point (x, y) by the scalar k. It rejects
+// points that are not on the curve.
+func ECEvaluate(k, x, y *big.Int) (*big.Int, *big.Int, error) {
+	if k == nil {
+		return nil, nil, errors.New("oprf: missing EC key")
+	}
+	if !p256.IsOnCurve(x, y) {
+		return nil, nil, errors.New("oprf: point is not on the curve")
+	}
+	x, y = scalarMult(k, x, y)
+	return x, y, nil
+}
+
+// EncodePoint encodes a point as sjcl's point.toBits() does, i.e., the
+// 32-byte x and y coordinates, in base64.
+func EncodePoint(x, y *big.Int) string {
+	b := make([]byte, 64)
+	x.FillBytes(b[:32])
+	y.FillBytes(b[32:])
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+// DecodePoint reverses EncodePoint. It rejects points that are not on the
+// curve.
+func DecodePoint(s string) (x, y *big.Int, err error) {
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, nil, fmt.Errorf("oprf: invalid point: %v", err)
+	}
+	if len(b) != 64 {
+		return nil, nil, errors.New("oprf: invalid point length")
+	}
+	x = new(big.Int).SetBytes(b[:32])
+	y = new(big.Int).SetBytes(b[32:])
+	if !p256.IsOnCurve(x, y) {
+		return nil, nil, errors.New("oprf: point is not on the curve")
+	}
+	return x, y, nil
+}
+
+// rsaRequest is the body posted by oprf.rsa in extension/js/oprf.js. The
+// blinded values are decimal big integers, serialized as strings.
+type rsaRequest struct {
+	X1 json.RawMessage `json:"x1"`
+	X2 json.RawMessage `json:"x2"`
+}
+
+// rsaResponse holds the signatures as hex strings, or null for missing
+// values.
+type rsaResponse struct {
+	Y1 *string `json:"y1"`
+	Y2 *string `json:"y2"`
+}
+
+// ecRequest is the body posted by oprf.ec in extension/js/oprf.js.
+type ecRequest struct {
+	X        string      `json:"x"`
+	WithMeta interface{} `json:"withmeta"`
+}
+
+type ecResponse struct {
+	Y1 string `json:"y1"`
+	Y2 string `json:"y2,omitempty"`
+}
+
+// NewHandler returns an HTTP handler that serves the OPRF endpoints of
+// web/routes/oprf.js with the server key. Requests are evaluated
+// concurrently.
+func NewHandler(key *Key) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(PathRSA, func(w http.ResponseWriter, r *http.Request) {
+		var req rsaRequest
+		if !decodeRequest(w, r, &req) {
+			return
+		}
+		var resp rsaResponse
+		for _, v := range []struct {
+			x json.RawMessage
+			y **string
+		}{{req.X1, &resp.Y1}, {req.X2, &resp.Y2}} {
+			x, err := parseBigInt(v.x)
+			if err != nil {
+				writeError(w, http.StatusBadRequest, err)
+				return
+			}
+			if x == nil {
+				continue
+			}
+			y, err := RSAEvaluate(key, x)
+			if err != nil {
+				writeError(w, http.StatusBadRequest, err)
+				return
+			}
+			s := y.Text(16)
+			*v.y = &s
+		}
+		writeJSON(w, http.StatusOK, resp)
+	})
+	mux.HandleFunc(PathEC, func(w http.ResponseWriter, r *http.Request) {
+		var req ecRequest
+		if !decodeRequest(w, r, &req) {
+			return
+		}
+		x, y, err := DecodePoint(req.X)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		var resp ecResponse
+		x1, y1, err := ECEvaluate(key.K1, x, y)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err)
+			return
+		}
+		resp.Y1 = EncodePoint(x1, y1)
+		if truthy(req.WithMeta) {
+			x2, y2, err := ECEvaluate(key.K2, x, y)
+			if err != nil {
+				writeError(w, http.StatusInternalServerError, err)
+				return
+			}
+			resp.Y2 = EncodePoint(x2, y2)
+		}
+		writeJSON(w, http.StatusOK, resp)
+	})
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The extension posts from its own origin, see web/app.js.
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Access-Control-Allow-Headers", "Origin, X-Requested-With, Content-Type, Accept")
+		switch r.Method {
+		case http.MethodOptions:
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodPost:
+			mux.ServeHTTP(w, r)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, errors.New("oprf: method not allowed"))
+		}
+	})
+}
+
+func decodeRequest(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	r.Body = http.MaxBytesReader(w, r.Body, maxRequestSize)
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("oprf: invalid request: %v", err))
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, code int, err error) {
+	writeJSON(w, code, struct {
+		Error string `json:"error"`
+	}{err.Error()})
+}
+
+// parseBigInt parses a decimal big integer given as a JSON string or number.
+// It returns nil for missing or null values, which bigInt.js maps to null.
+func parseBigInt(raw json.RawMessage) (*big.Int, error) {
+	s := strings.TrimSpace(string(raw))
+	if s == "" || s == "null" {
+		return nil, nil
+	}
+	if strings.HasPrefix(s, `"`) {
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return nil, err
+		}
+	}
+	x, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, fmt.Errorf("oprf: invalid integer %.32q", s)
+	}
+	return x, nil
+}
+
+// truthy reports whether a JSON value is truthy in JavaScript.
+func truthy(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	case float64:
+		return v != 0
+	case string:
+		return v != ""
+	}
+	return true
+}
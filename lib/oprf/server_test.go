@@ -0,0 +1,166 @@
+package oprf
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func post(t *testing.T, url string, body string, v interface{}) int {
+	resp, err := http.Post(url, "application/json", strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if v != nil {
+		json.NewDecoder(resp.Body).Decode(v)
+	}
+	return resp.StatusCode
+}
+
+// The expected responses were produced by web/routes/oprf.js.
+func TestHandlerWire(t *testing.T) {
+	srv := httptest.NewServer(NewHandler(loadTestKey(t)))
+	defer srv.Close()
+
+	var rsaResp map[string]*string
+	if code := post(t, srv.URL+PathRSA, `{"x1":"123456789"}`, &rsaResp); code != http.StatusOK {
+		t.Fatalf("POST %s = %d", PathRSA, code)
+	}
+	wantY1 := "57262ad8bee1fc72bad639a272fefbd7e70e15de7888ae99a7ac38d939a85849b581f945515ed3ee77a1c0699240bc1c180ae86b8c923f72901810b80c5e136ad693ff36ae9be1b4b2ec9ac08f0d5eec6f7dc40e34020b51980081a6e0110ab0003413d00b875422d627bb5dcfa4f3ef69432249c2b085ce3e88bbb8fdcc9c4d"
+	if y1, ok := rsaResp["y1"]; !ok || y1 == nil || *y1 != wantY1 {
+		t.Errorf("POST %s: y1 = %v, want %s", PathRSA, y1, wantY1)
+	}
+	if y2, ok := rsaResp["y2"]; !ok || y2 != nil {
+		t.Errorf("POST %s: y2 = %v, want null", PathRSA, y2)
+	}
+
+	var ecResp map[string]string
+	x := "jlM7b6C/e0YluzBmfAH7YH75+LioD+9bMAYocDGHsqNz6x294DMYNm0Gn4Om9ZAAU8c2M8sEGyHFXhqGwfQAtA=="
+	if code := post(t, srv.URL+PathEC, `{"x":"`+x+`","withmeta":true}`, &ecResp); code != http.StatusOK {
+		t.Fatalf("POST %s = %d", PathEC, code)
+	}
+	want := map[string]string{
+		"y1": "eUis1r1ipRXs0ErlycEzqyE5YwAlEB4xd7bX6/wP7R0hEefdCX6Rq3eD/g77ASJjRs2nLEbfZOIhhaLflG6LJQ==",
+		"y2": "6tRsvI/WMYuYfpeZTRkRNzME3P33oQFNMvEZtb2a3dGzKsYb8UI0FAKfDA7soupro7Oo/+HNR6TWvP0ZXGkAzw==",
+	}
+	for k, v := range want {
+		if ecResp[k] != v {
+			t.Errorf("POST %s: %s = %q, want %q", PathEC, k, ecResp[k], v)
+		}
+	}
+
+	ecResp = nil
+	post(t, srv.URL+PathEC, `{"x":"`+x+`","withmeta":false}`, &ecResp)
+	if _, ok := ecResp["y2"]; ok || ecResp["y1"] != want["y1"] {
+		t.Errorf("POST %s without meta = %v, want y1 only", PathEC, ecResp)
+	}
+}
+
+func TestHandlerRejects(t *testing.T) {
+	key := loadTestKey(t)
+	srv := httptest.NewServer(NewHandler(key))
+	defer srv.Close()
+
+	offCurve := EncodePoint(big.NewInt(1), big.NewInt(1))
+	vectors := []struct {
+		path string
+		body string
+	}{
+		{PathRSA, `{"x1":"` + key.N.String() + `"}`},
+		{PathRSA, `{"x1":"-1"}`},
+		{PathRSA, `{"x1":"12","x2":"0x12"}`},
+		{PathRSA, `{"x1":`},
+		{PathEC, `{"x":"` + offCurve + `"}`},
+		{PathEC, `{"x":"AAAA"}`},
+		{PathEC, `{"x":"not base64"}`},
+		{PathEC, `{}`},
+	}
+	for i, v := range vectors {
+		var resp map[string]interface{}
+		if code := post(t, srv.URL+v.path, v.body, &resp); code != http.StatusBadRequest || resp["error"] == nil {
+			t.Errorf("test %d, POST %s %s = %d %v, want 400 with an error", i, v.path, v.body, code, resp)
+		}
+	}
+}
+
+// TestHandlerBlinded runs the blinded protocols of extension/js/oprf.js
+// concurrently and checks that the unblinded tokens are the builder's.
+func TestHandlerBlinded(t *testing.T) {
+	key := loadTestKey(t)
+	srv := httptest.NewServer(NewHandler(key))
+	defer srv.Close()
+
+	const u = "unsafe.ppsb.com/"
+	want, _ := RSA.Tokens(key, u, false)
+	wantEC, _ := EC.Tokens(key, u, false)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			// RSA: x = h * r^e mod n, s = y / r mod n.
+			r, _ := rand.Int(rand.Reader, key.N)
+			x := new(big.Int).Exp(r, key.E, key.N)
+			x.Mul(x, rsaHash(u, "*1")).Mod(x, key.N)
+			var rsaResp struct{ Y1 string }
+			post(t, srv.URL+PathRSA, `{"x1":"`+x.String()+`"}`, &rsaResp)
+			s, _ := new(big.Int).SetString(rsaResp.Y1, 16)
+			s.Mul(s, new(big.Int).ModInverse(r, key.N)).Mod(s, key.N)
+			if got := RSAFinalize(s); got != want.T1 {
+				t.Errorf("blinded RSA token = %s, want %s", got, want.T1)
+			}
+
+			// EC: X = r * P, S = Y / r.
+			px, py, _ := HashToCurve([]byte(u))
+			r, _ = rand.Int(rand.Reader, p256.Params().N)
+			bx, by := scalarMult(r, px, py)
+			var ecResp struct{ Y1 string }
+			body, _ := json.Marshal(map[string]interface{}{"x": EncodePoint(bx, by), "withmeta": false})
+			post(t, srv.URL+PathEC, string(body), &ecResp)
+			sx, sy, err := DecodePoint(ecResp.Y1)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			sx, sy = scalarMult(new(big.Int).ModInverse(r, p256.Params().N), sx, sy)
+			if got := hex.EncodeToString(CompressPoint(sx, sy)); got != wantEC.T1 {
+				t.Errorf("blinded EC token = %s, want %s", got, wantEC.T1)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestHandlerMethod(t *testing.T) {
+	srv := httptest.NewServer(NewHandler(loadTestKey(t)))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + PathRSA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("GET %s = %d, want %d", PathRSA, resp.StatusCode, http.StatusMethodNotAllowed)
+	}
+
+	req, _ := http.NewRequest(http.MethodOptions, srv.URL+PathEC, bytes.NewReader(nil))
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if got := resp.Header.Get("Access-Control-Allow-Origin"); got != "*" {
+		t.Errorf("OPTIONS %s: Access-Control-Allow-Origin = %q, want *", PathEC, got)
+	}
+}
@@ -0,0 +1,213 @@
+// Package pipeline canonicalizes, decomposes and hashes the URLs of a feed,
+// one URL per line, on several goroutines.
+//
+// It streams: lines are read in batches, processed by the workers and handed
+// back in input order, and the number of batches in flight is bounded, so
+// that reading waits for the consumer (back-pressure). Memory is thus bounded
+// by the batches in flight, whatever the size of the feed; what the consumer
+// keeps, such as the unique URLs, is up to it.
+package pipeline
+
+import (
+	"io"
+	"runtime"
+	"sync"
+	"time"
+
+	"../focal"
+	"../lines"
+)
+
+// Record is the processing of a line of a feed.
+type Record struct {
+	Line      int                // line number, from 1
+	URL       string             // the line
+	Canonical string             // focal.CanonicalURL of URL
+	Patterns  []string           // focal.GeneratePatterns of Canonical
+	Hashes    []focal.HashPrefix // full hashes of Patterns
+	Err       error              // why the URL is rejected, see focal.RejectReason, or a *lines.MalformedError
+	Warning   error              // why the line is suspect though processed, see lines.Reader.Warning
+}
+
+func (rec *Record) process() {
+	if rec.Err != nil {
+		return
+	}
+	rec.Canonical, rec.Err = focal.CanonicalURL(rec.URL)
+	if rec.Err != nil {
+		return
+	}
+	rec.Patterns, rec.Err = focal.GeneratePatterns(rec.Canonical)
+	if rec.Err != nil {
+		rec.Canonical = ""
+		return
+	}
+	rec.Hashes = make([]focal.HashPrefix, len(rec.Patterns))
+	for i, p := range rec.Patterns {
+		rec.Hashes[i] = focal.HashFromPattern(p)
+	}
+}
+
+// Stats counts the lines a run has processed.
+type Stats struct {
+	Lines    int           // lines read, empty ones included
+	URLs     int           // non-empty lines
+	Rejected int           // URLs with an error
+	Warned   int           // URLs with a warning, rejected or not
+	Elapsed  time.Duration // since the start of the run
+}
+
+// Rate returns the number of URLs processed per second.
+func (s Stats) Rate() float64 {
+	if s.Elapsed <= 0 {
+		return 0
+	}
+	return float64(s.URLs) / s.Elapsed.Seconds()
+}
+
+// Options configures a run. The zero value runs a worker per CPU.
+type Options struct {
+	// Workers is the number of goroutines processing URLs (default
+	// runtime.NumCPU()).
+	Workers int
+	// BatchSize is the number of lines a worker processes at once (default
+	// 1024).
+	BatchSize int
+	// MaxLines stops the run after that many lines (default all).
+	MaxLines int
+	// Progress, if set, is called with the stats of the run every
+	// ProgressInterval (default 10s), on the goroutine that calls emit.
+	Progress         func(Stats)
+	ProgressInterval time.Duration
+}
+
+type batch struct {
+	seq     int
+	records []Record
+}
+
+// Run reads the URLs of r, one per line (see lines.Reader), and calls emit
+// with the record of each non-empty line, in the order of the lines, on the
+// calling goroutine. emit may keep the records. Rejected URLs and malformed
+// lines are emitted too, with Err set; lines that are not valid UTF-8 are
+// processed, with Warning set.
+//
+// Run stops at the first error of emit or of r, and returns it.
+func Run(r io.Reader, opts Options, emit func(*Record) error) (Stats, error) {
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = 1024
+	}
+	interval := opts.ProgressInterval
+	if interval <= 0 {
+		interval = 10 * time.Second
+	}
+
+	// A token is taken for each batch read and given back once it is
+	// emitted; results can then never hold more than inFlight batches.
+	inFlight := 2 * workers
+	tokens := make(chan struct{}, inFlight)
+	jobs := make(chan *batch, workers)
+	results := make(chan *batch, inFlight)
+	stop := make(chan struct{})
+
+	start := time.Now()
+	var stats Stats
+	var numLines int
+	var readErr error
+	go func() {
+		defer close(jobs)
+		send := func(b *batch) bool {
+			select {
+			case tokens <- struct{}{}:
+			case <-stop:
+				return false
+			}
+			jobs <- b
+			return true
+		}
+		lr := lines.NewReader(r)
+		b := &batch{}
+		for (opts.MaxLines <= 0 || lr.Line() < opts.MaxLines) && lr.Scan() {
+			numLines = lr.Line()
+			if lr.Text() == "" {
+				continue
+			}
+			b.records = append(b.records, Record{Line: lr.Line(), URL: lr.Text(), Err: lr.Malformed(), Warning: lr.Warning()})
+			if len(b.records) == batchSize {
+				if !send(b) {
+					return
+				}
+				b = &batch{seq: b.seq + 1}
+			}
+		}
+		readErr = lr.Err()
+		if len(b.records) > 0 {
+			send(b)
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for b := range jobs {
+				for i := range b.records {
+					b.records[i].process()
+				}
+				results <- b
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	var err error
+	pending := make(map[int]*batch)
+	next := 0
+	last := start
+	for b := range results {
+		if err != nil {
+			continue // drain
+		}
+		pending[b.seq] = b
+		for b, ok := pending[next]; ok && err == nil; b, ok = pending[next] {
+			delete(pending, next)
+			next++
+			<-tokens
+			for i := range b.records {
+				rec := &b.records[i]
+				stats.Lines = rec.Line
+				stats.URLs++
+				if rec.Err != nil {
+					stats.Rejected++
+				}
+				if rec.Warning != nil {
+					stats.Warned++
+				}
+				if err = emit(rec); err != nil {
+					close(stop)
+					break
+				}
+			}
+		}
+		if opts.Progress != nil && time.Since(last) >= interval {
+			last = time.Now()
+			stats.Elapsed = last.Sub(start)
+			opts.Progress(stats)
+		}
+	}
+	stats.Elapsed = time.Since(start)
+	if err == nil {
+		stats.Lines = numLines
+		err = readErr
+	}
+	return stats, err
+}
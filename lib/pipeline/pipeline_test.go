@@ -0,0 +1,190 @@
+package pipeline
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+
+	"../focal"
+	"../lines"
+)
+
+const releaseGlob = "../../testData/release-json/*.json"
+
+// sequential processes the lines of input one by one, as the pipeline must.
+func sequential(input string) []Record {
+	var records []Record
+	for i, line := range strings.Split(input, "\n") {
+		line = strings.TrimSuffix(line, "\r")
+		if line == "" {
+			continue
+		}
+		rec := Record{Line: i + 1, URL: line}
+		rec.Canonical, rec.Err = focal.CanonicalURL(line)
+		if rec.Err == nil {
+			rec.Patterns, _ = focal.GeneratePatterns(rec.Canonical)
+			for _, p := range rec.Patterns {
+				rec.Hashes = append(rec.Hashes, focal.HashFromPattern(p))
+			}
+		}
+		records = append(records, rec)
+	}
+	return records
+}
+
+func TestRun(t *testing.T) {
+	var lines []string
+	for i := 0; i < 100; i++ {
+		lines = append(lines, fmt.Sprintf("http://a%d.example.com/%d/x.html?q=%d", i, i, i))
+		switch i % 10 {
+		case 3:
+			lines = append(lines, "")
+		case 5:
+			lines = append(lines, "mailto:x@example.com")
+		case 7:
+			lines = append(lines, "http://b.example.com/\r")
+		}
+	}
+	input := strings.Join(lines, "\n") + "\n\n"
+	want := sequential(input)
+
+	for _, opts := range []Options{{}, {Workers: 1, BatchSize: 1}, {Workers: 3, BatchSize: 2}, {Workers: 8, BatchSize: 7}} {
+		var got []Record
+		stats, err := Run(strings.NewReader(input), opts, func(rec *Record) error {
+			got = append(got, *rec)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("%+v: %v", opts, err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%+v: records differ from the sequential processing", opts)
+		}
+		if stats.Lines != len(lines)+1 || stats.URLs != len(want) || stats.Rejected != 10 {
+			t.Errorf("%+v: stats %+v, want %d lines, %d URLs, 10 rejected", opts, stats, len(lines)+1, len(want))
+		}
+	}
+
+	var got []Record
+	stats, err := Run(strings.NewReader(input), Options{MaxLines: 5, BatchSize: 2}, func(rec *Record) error {
+		got = append(got, *rec)
+		return nil
+	})
+	if err != nil || stats.Lines != 5 || !reflect.DeepEqual(got, want[:4]) {
+		t.Errorf("MaxLines 5: %d records, stats %+v, error %v", len(got), stats, err)
+	}
+}
+
+// endless is an infinite feed.
+type endless struct{ n int }
+
+func (r *endless) Read(p []byte) (int, error) {
+	i := 0
+	for i+64 <= len(p) {
+		r.n++
+		i += copy(p[i:], fmt.Sprintf("http://%d.example.com/\n", r.n))
+	}
+	return i, nil
+}
+
+func TestRunStop(t *testing.T) {
+	errStop := errors.New("stop")
+	emitted := 0
+	_, err := Run(new(endless), Options{Workers: 4, BatchSize: 16}, func(rec *Record) error {
+		if emitted++; emitted == 100 {
+			return errStop
+		}
+		return nil
+	})
+	if err != errStop || emitted != 100 {
+		t.Errorf("Run returned %v after %d records, want %v after 100", err, emitted, errStop)
+	}
+
+}
+
+func TestRunLines(t *testing.T) {
+	long := "http://a.com/" + strings.Repeat("a", 2<<20)
+	var got []Record
+	stats, err := Run(strings.NewReader(long+"\nhttp://bad\x00.com/\nhttp://a.com/caf\xe9.html\n"), Options{}, func(rec *Record) error {
+		got = append(got, *rec)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 3 {
+		t.Fatalf("got %d records, want 3", len(got))
+	}
+	if got[0].Err != nil || got[0].Canonical != strings.TrimPrefix(long, "http://") {
+		t.Errorf("long line: canonical %.40q, error %v", got[0].Canonical, got[0].Err)
+	}
+	var me *lines.MalformedError
+	if !errors.As(got[1].Err, &me) || me.Line != 2 || got[1].Patterns != nil {
+		t.Errorf("malformed line: error %v, patterns %q", got[1].Err, got[1].Patterns)
+	}
+	// A Latin-1 URL is processed, with a warning.
+	if got[2].Err != nil || got[2].Canonical != "a.com/caf%e9.html" || !errors.As(got[2].Warning, &me) || me.Line != 3 {
+		t.Errorf("Latin-1 line: canonical %q, error %v, warning %v", got[2].Canonical, got[2].Err, got[2].Warning)
+	}
+	if stats.Rejected != 1 || stats.Warned != 1 {
+		t.Errorf("%d rejected and %d warned URLs, want 1 and 1", stats.Rejected, stats.Warned)
+	}
+}
+
+// releaseURLs returns the URLs of the release-json blacklists, one per line.
+func releaseURLs(tb testing.TB) string {
+	paths, err := filepath.Glob(releaseGlob)
+	if err != nil || len(paths) == 0 {
+		tb.Fatalf("no blacklist in %s: %v", releaseGlob, err)
+	}
+	var b strings.Builder
+	for _, path := range paths {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			tb.Fatal(err)
+		}
+		var entries []struct {
+			URL string `json:"u"`
+		}
+		if err := json.Unmarshal(data, &entries); err != nil {
+			tb.Fatalf("%s: %v", path, err)
+		}
+		for _, e := range entries {
+			b.WriteString(e.URL)
+			b.WriteByte('\n')
+		}
+	}
+	return b.String()
+}
+
+// BenchmarkRun measures the throughput of the pipeline on the release-json
+// blacklists, with one worker and with a worker per CPU:
+//
+//	go test -run '^$' -bench Run
+func BenchmarkRun(b *testing.B) {
+	input := releaseURLs(b)
+	workers := []int{1}
+	if n := runtime.NumCPU(); n > 1 {
+		workers = append(workers, n)
+	}
+	for _, n := range workers {
+		b.Run(fmt.Sprintf("workers=%d", n), func(b *testing.B) {
+			b.SetBytes(int64(len(input)))
+			urls := 0
+			for i := 0; i < b.N; i++ {
+				stats, err := Run(strings.NewReader(input), Options{Workers: n}, func(*Record) error { return nil })
+				if err != nil {
+					b.Fatal(err)
+				}
+				urls += stats.URLs
+			}
+			b.ReportMetric(float64(urls)/b.Elapsed().Seconds(), "urls/s")
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package preimage
+
+import (
+	"sort"
+	"strings"
+
+	"../focal"
+	"../publicsuffix"
+)
+
+// Generator enumerates candidate patterns, decompositions as
+// focal.GeneratePatterns writes them, by index. Indexing lets the search
+// split a generator across workers and resume it where a checkpoint left
+// off: the i-th candidate must not change between runs.
+type Generator interface {
+	// Name identifies the generator in checkpoints.
+	Name() string
+	// Len returns the number of candidates.
+	Len() int64
+	// Append appends the i-th candidate, 0 <= i < Len(), to buf.
+	Append(buf []byte, i int64) []byte
+}
+
+// maxParts bounds the number of parts of a product.
+const maxParts = 8
+
+// product enumerates the concatenations of an element of each part, the last
+// part varying fastest.
+type product struct {
+	name  string
+	parts [][]string
+	size  int64
+}
+
+// Product returns the generator of the concatenations of an element of each
+// of parts, in order, e.g. Product("x", words, []string{"."}, tlds). The
+// number of candidates, the product of the sizes of parts, must fit an int64.
+func Product(name string, parts ...[]string) Generator {
+	if len(parts) > maxParts {
+		panic("preimage: too many parts")
+	}
+	g := &product{name: name, parts: parts, size: 1}
+	for _, p := range parts {
+		g.size *= int64(len(p))
+	}
+	return g
+}
+
+func (g *product) Name() string { return g.name }
+func (g *product) Len() int64   { return g.size }
+
+func (g *product) Append(buf []byte, i int64) []byte {
+	var digits [maxParts]int
+	for k := len(g.parts) - 1; k >= 0; k-- {
+		n := int64(len(g.parts[k]))
+		digits[k] = int(i % n)
+		i /= n
+	}
+	for k, p := range g.parts {
+		buf = append(buf, p[digits[k]]...)
+	}
+	return buf
+}
+
+// Domains returns the generator of the domains word.suffix/, such as a
+// wordlist times the public suffixes of the feeds.
+func Domains(words, suffixes []string) Generator {
+	return Product("domains", normalize(words), []string{"."}, normalize(suffixes), []string{"/"})
+}
+
+// Subdomains returns the generator of the subdomains label.host/ of known
+// hosts, such as the hosts of the feeds.
+func Subdomains(labels, hosts []string) Generator {
+	return Product("subdomains", normalize(labels), []string{"."}, normalize(hosts), []string{"/"})
+}
+
+// Paths returns the generator of the patterns host+path of known hosts and
+// path templates, such as the lookup paths of the feeds.
+func Paths(hosts, paths []string) Generator {
+	return Product("paths", normalize(hosts), dedup(paths))
+}
+
+// Labels are common subdomain labels, those of phishing kits and of the
+// services they mimic.
+var Labels = []string{
+	"account", "accounts", "admin", "api", "app", "auth", "cdn", "secure",
+	"id", "login", "m", "mail", "mobile", "my", "online", "portal",
+	"service", "signin", "support", "update", "verify", "web", "webmail", "www",
+}
+
+// normalize lowercases host labels, trims their dots and dedups them.
+func normalize(names []string) []string {
+	lower := make([]string, 0, len(names))
+	for _, n := range names {
+		if n = strings.Trim(strings.ToLower(strings.TrimSpace(n)), "."); n != "" {
+			lower = append(lower, n)
+		}
+	}
+	return dedup(lower)
+}
+
+// dedup returns the distinct elements of ss, sorted, so that a generator does
+// not depend on the order of its input.
+func dedup(ss []string) []string {
+	sorted := append([]string(nil), ss...)
+	sort.Strings(sorted)
+	uniq := sorted[:0]
+	for i, s := range sorted {
+		if i == 0 || s != sorted[i-1] {
+			uniq = append(uniq, s)
+		}
+	}
+	return uniq
+}
+
+// Feed holds the material the generators take from the URLs of feeds.
+type Feed struct {
+	// Hosts are the hosts of the URLs, Suffixes their public suffixes, in
+	// the ICANN or the private section of the Public Suffix List.
+	Hosts    []string
+	Suffixes []string
+	// Paths are the lookup paths of the URLs but "/", e.g. "/a/" and
+	// "/a/b.php" for x.com/a/b.php.
+	Paths []string
+	// Rejected is the number of URLs without decompositions.
+	Rejected int
+}
+
+// FromFeed returns the hosts, suffixes and path templates of the feed URLs
+// urls, sorted.
+func FromFeed(urls []string) *Feed {
+	f := new(Feed)
+	hosts, suffixes, paths := make(map[string]bool), make(map[string]bool), make(map[string]bool)
+	for _, u := range urls {
+		lookupHosts, err := focal.GenerateLookupHosts(u)
+		if err != nil {
+			f.Rejected++
+			continue
+		}
+		lookupPaths, err := focal.GenerateLookupPaths(u)
+		if err != nil {
+			f.Rejected++
+			continue
+		}
+		hosts[lookupHosts[0]] = true
+		if s, _ := publicsuffix.Default.PublicSuffix(lookupHosts[0]); s != "" {
+			suffixes[s] = true
+		}
+		for _, p := range lookupPaths[1:] {
+			paths[p] = true
+		}
+	}
+	f.Hosts, f.Suffixes, f.Paths = keys(hosts), keys(suffixes), keys(paths)
+	return f
+}
+
+func keys(set map[string]bool) []string {
+	ks := make([]string, 0, len(set))
+	for k := range set {
+		ks = append(ks, k)
+	}
+	sort.Strings(ks)
+	return ks
+}
@@ -0,0 +1,306 @@
+// Package preimage searches the pre-images of orphan prefixes, the published
+// prefixes of no known decomposition, such as the GSB prefixes the eCrimeX
+// decompositions do not explain.
+//
+// Candidate decompositions are enumerated by generators (a wordlist times
+// the public suffixes, subdomains of known hosts, path templates of the feeds
+// on known hosts) and hashed on all CPUs; every candidate whose hash starts
+// with an orphan prefix is a hit. A 32-bit prefix matches a random candidate
+// with a chance of one in 2^32 per orphan, so that hits among billions of
+// candidates are still to be confirmed, e.g. by the full hashes of the
+// provider. The search records its progress in a checkpoint, from which an
+// interrupted search resumes.
+package preimage
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"runtime"
+	"sync"
+	"time"
+
+	"../focal"
+)
+
+// Hit is a candidate whose hash starts with an orphan prefix.
+type Hit struct {
+	Generator string `json:"generator"`
+	Index     int64  `json:"index"`
+	Pattern   string `json:"pattern"`
+	Prefix    string `json:"prefix"` // the orphan, in hex
+}
+
+// Progress is how far the search went through a generator: the candidates
+// before Done are hashed.
+type Progress struct {
+	Name string `json:"name"`
+	Len  int64  `json:"len"`
+	Done int64  `json:"done"`
+}
+
+// Checkpoint is the state of a search: the progress of each generator and
+// the hits so far. It is bound to the orphans by their checksum.
+type Checkpoint struct {
+	Orphans    string     `json:"orphans"` // hex SHA-256 of the sorted orphans
+	Generators []Progress `json:"generators"`
+	Hits       []Hit      `json:"hits"`
+	Elapsed    float64    `json:"elapsed"` // seconds spent searching, over all runs
+
+	seen map[Hit]bool
+}
+
+// Hashed returns the number of candidates hashed.
+func (cp *Checkpoint) Hashed() int64 {
+	var n int64
+	for _, p := range cp.Generators {
+		n += p.Done
+	}
+	return n
+}
+
+// Len returns the number of candidates of the generators.
+func (cp *Checkpoint) Len() int64 {
+	var n int64
+	for _, p := range cp.Generators {
+		n += p.Len
+	}
+	return n
+}
+
+// add records h unless a previous run did: the candidates hashed after the
+// progress of a checkpoint are hashed again on resume.
+func (cp *Checkpoint) add(h Hit) {
+	if cp.seen == nil {
+		cp.seen = make(map[Hit]bool)
+		for _, old := range cp.Hits {
+			cp.seen[old] = true
+		}
+	}
+	if !cp.seen[h] {
+		cp.seen[h] = true
+		cp.Hits = append(cp.Hits, h)
+	}
+}
+
+// checksum returns the checksum of the sorted orphans.
+func checksum(orphans focal.HashPrefixes) string {
+	sorted := append(focal.HashPrefixes(nil), orphans...)
+	sorted.Sort()
+	return hex.EncodeToString(sorted.SHA256())
+}
+
+// NewCheckpoint returns the checkpoint of a search of the orphans with the
+// generators gens that has not started.
+func NewCheckpoint(orphans focal.HashPrefixes, gens []Generator) *Checkpoint {
+	cp := &Checkpoint{Orphans: checksum(orphans), Hits: []Hit{}}
+	for _, g := range gens {
+		cp.Generators = append(cp.Generators, Progress{Name: g.Name(), Len: g.Len()})
+	}
+	return cp
+}
+
+// Resume reads the checkpoint at path of a search of the orphans with the
+// generators gens, or returns a new one if there is no file at path. It
+// fails if the checkpoint is that of another search.
+func Resume(path string, orphans focal.HashPrefixes, gens []Generator) (*Checkpoint, error) {
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return NewCheckpoint(orphans, gens), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	cp := new(Checkpoint)
+	if err := json.Unmarshal(data, cp); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	if cp.Orphans != checksum(orphans) {
+		return nil, fmt.Errorf("%s: checkpoint of other orphans", path)
+	}
+	if len(cp.Generators) != len(gens) {
+		return nil, fmt.Errorf("%s: checkpoint of %d generators, not %d", path, len(cp.Generators), len(gens))
+	}
+	for i, g := range gens {
+		if p := cp.Generators[i]; p.Name != g.Name() || p.Len != g.Len() {
+			return nil, fmt.Errorf("%s: checkpoint of generator %s of %d candidates, not %s of %d", path, p.Name, p.Len, g.Name(), g.Len())
+		}
+	}
+	if cp.Hits == nil {
+		cp.Hits = []Hit{}
+	}
+	return cp, nil
+}
+
+// Write writes the checkpoint to path. The previous checkpoint is replaced
+// at once, so that an interrupted write leaves it whole.
+func (cp *Checkpoint) Write(path string) error {
+	data, err := json.MarshalIndent(cp, "", "    ")
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// Options configures a search. The zero value hashes on every CPU.
+type Options struct {
+	// Workers is the number of goroutines hashing candidates (default
+	// runtime.NumCPU()).
+	Workers int
+	// BatchSize is the number of candidates a worker hashes at once
+	// (default 1<<16).
+	BatchSize int64
+	// Save, if set, is called with the checkpoint every SaveInterval
+	// (default 1m) and when the search ends, on the goroutine that calls
+	// Search.
+	Save         func(*Checkpoint) error
+	SaveInterval time.Duration
+	// Stop, once closed, stops the search after the batches in flight.
+	Stop <-chan struct{}
+}
+
+func (o *Options) defaults() {
+	if o.Workers <= 0 {
+		o.Workers = runtime.NumCPU()
+	}
+	if o.BatchSize <= 0 {
+		o.BatchSize = 1 << 16
+	}
+	if o.SaveInterval <= 0 {
+		o.SaveInterval = time.Minute
+	}
+}
+
+// ErrStopped is returned by Search when Options.Stop stopped it.
+var ErrStopped = fmt.Errorf("preimage: search stopped")
+
+// batch is a range of candidates of a generator.
+type batch struct {
+	from, to int64
+	hits     []Hit
+}
+
+// Search hashes the candidates of gens from where cp left off and records the
+// hits in cp. gens must be those of cp, in order.
+func Search(orphans focal.HashPrefixes, gens []Generator, cp *Checkpoint, opts Options) error {
+	opts.defaults()
+	set := focal.NewHashSet(orphans)
+	// The first four bytes of the hash rule most candidates out without
+	// allocating.
+	first := make(map[[focal.MinHashPrefixLength]byte]bool, len(orphans))
+	for _, h := range orphans {
+		first[[focal.MinHashPrefixLength]byte{h[0], h[1], h[2], h[3]}] = true
+	}
+
+	start, elapsed := time.Now(), cp.Elapsed
+	save := func() error {
+		cp.Elapsed = elapsed + time.Since(start).Seconds()
+		if opts.Save == nil {
+			return nil
+		}
+		return opts.Save(cp)
+	}
+	lastSave := time.Now()
+
+	for gi, g := range gens {
+		p := &cp.Generators[gi]
+		if p.Done >= p.Len {
+			continue
+		}
+
+		// At most 2*Workers batches are in flight: neither the workers nor
+		// the dispatch block on a full channel.
+		batches, done := make(chan *batch, 2*opts.Workers), make(chan *batch, 2*opts.Workers)
+		var wg sync.WaitGroup
+		for w := 0; w < opts.Workers; w++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				buf := make([]byte, 0, 256)
+				for b := range batches {
+					for i := b.from; i < b.to; i++ {
+						buf = g.Append(buf[:0], i)
+						sum := sha256.Sum256(buf)
+						if !first[[focal.MinHashPrefixLength]byte{sum[0], sum[1], sum[2], sum[3]}] {
+							continue
+						}
+						if n := set.Lookup(focal.HashPrefix(sum[:])); n > 0 {
+							b.hits = append(b.hits, Hit{Generator: g.Name(), Index: i, Pattern: string(buf), Prefix: hex.EncodeToString(sum[:n])})
+						}
+					}
+					done <- b
+				}
+			}()
+		}
+		go func() {
+			wg.Wait()
+			close(done)
+		}()
+
+		// Batches complete out of order: the progress only moves past the
+		// batches that all completed, the others wait in finished.
+		next, stopped := p.Done, false
+		var saveErr error
+		finished := make(map[int64]*batch)
+		inFlight := 0
+		dispatch := func() {
+			for !stopped && inFlight < 2*opts.Workers && next < p.Len {
+				select {
+				case <-opts.Stop:
+					stopped = true
+					return
+				default:
+				}
+				to := next + opts.BatchSize
+				if to > p.Len {
+					to = p.Len
+				}
+				batches <- &batch{from: next, to: to}
+				next = to
+				inFlight++
+			}
+		}
+		dispatch()
+		if inFlight == 0 {
+			close(batches)
+		}
+		for b := range done {
+			inFlight--
+			for _, h := range b.hits {
+				cp.add(h)
+			}
+			finished[b.from] = b
+			for f := finished[p.Done]; f != nil; f = finished[p.Done] {
+				delete(finished, f.from)
+				p.Done = f.to
+			}
+			if time.Since(lastSave) >= opts.SaveInterval {
+				if saveErr = save(); saveErr != nil {
+					stopped = true
+				}
+				lastSave = time.Now()
+			}
+			dispatch()
+			if inFlight == 0 {
+				close(batches)
+			}
+		}
+		if saveErr != nil {
+			return saveErr
+		}
+		if err := save(); err != nil {
+			return err
+		}
+		if stopped {
+			return ErrStopped
+		}
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package preimage
+
+import (
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	"../focal"
+)
+
+func candidates(g Generator) []string {
+	var cs []string
+	for i := int64(0); i < g.Len(); i++ {
+		cs = append(cs, string(g.Append(nil, i)))
+	}
+	return cs
+}
+
+func TestGenerators(t *testing.T) {
+	got := candidates(Domains([]string{"B", "a", "b"}, []string{".Com.", "org"}))
+	want := []string{"a.com/", "a.org/", "b.com/", "b.org/"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("domains %v, want %v", got, want)
+	}
+	got = candidates(Paths([]string{"x.com"}, []string{"/a/b.php", "/a/"}))
+	want = []string{"x.com/a/", "x.com/a/b.php"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("paths %v, want %v", got, want)
+	}
+	if n := Product("empty", []string{"a"}, nil).Len(); n != 0 {
+		t.Errorf("%d candidates of an empty part, want 0", n)
+	}
+}
+
+func TestFromFeed(t *testing.T) {
+	f := FromFeed([]string{"http://x.example.co.uk/a/b.php?q=1", "http://x.example.co.uk/", "http://"})
+	want := &Feed{
+		Hosts:    []string{"x.example.co.uk"},
+		Suffixes: []string{"co.uk"},
+		Paths:    []string{"/a/", "/a/b.php", "/a/b.php?q=1"},
+		Rejected: 1,
+	}
+	if !reflect.DeepEqual(f, want) {
+		t.Errorf("got %+v, want %+v", f, want)
+	}
+}
+
+var testWords = []string{"alpha", "beta", "gamma", "delta", "epsilon", "zeta", "eta", "theta"}
+
+func testSearch() (focal.HashPrefixes, []Generator) {
+	orphans := focal.HashPrefixes{
+		focal.HashFromPattern("beta.org/").Short(),
+		focal.HashFromPattern("login.example.com/")[:8],
+		focal.HashFromPattern("not.generated/").Short(),
+	}
+	gens := []Generator{
+		Domains(testWords, []string{"com", "net", "org"}),
+		Subdomains(Labels, []string{"example.com", "example.org"}),
+	}
+	return orphans, gens
+}
+
+func hitPatterns(cp *Checkpoint) []string {
+	var patterns []string
+	for _, h := range cp.Hits {
+		patterns = append(patterns, h.Pattern)
+	}
+	sort.Strings(patterns)
+	return patterns
+}
+
+func TestSearch(t *testing.T) {
+	orphans, gens := testSearch()
+	cp := NewCheckpoint(orphans, gens)
+	if err := Search(orphans, gens, cp, Options{Workers: 4, BatchSize: 5}); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"beta.org/", "login.example.com/"}; !reflect.DeepEqual(hitPatterns(cp), want) {
+		t.Errorf("hits %v, want %v", hitPatterns(cp), want)
+	}
+	if cp.Hashed() != cp.Len() || cp.Len() != int64(len(testWords)*3+2*len(Labels)) {
+		t.Errorf("hashed %d of %d candidates", cp.Hashed(), cp.Len())
+	}
+	for _, h := range cp.Hits {
+		if h.Pattern == "login.example.com/" && len(h.Prefix) != 16 {
+			t.Errorf("hit prefix %s, want the 8-byte orphan", h.Prefix)
+		}
+	}
+}
+
+func TestResume(t *testing.T) {
+	orphans, gens := testSearch()
+	path := filepath.Join(t.TempDir(), "checkpoint.json")
+
+	// Stop at the first save, after a batch.
+	cp, err := Resume(path, orphans, gens)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stop := make(chan struct{})
+	opts := Options{Workers: 1, BatchSize: 4, SaveInterval: 1, Stop: stop}
+	opts.Save = func(cp *Checkpoint) error {
+		select {
+		case <-stop:
+		default:
+			close(stop)
+		}
+		return cp.Write(path)
+	}
+	if err := Search(orphans, gens, cp, opts); err != ErrStopped {
+		t.Fatalf("got %v, want %v", err, ErrStopped)
+	}
+	if cp.Hashed() == 0 || cp.Hashed() == cp.Len() {
+		t.Fatalf("stopped after %d of %d candidates", cp.Hashed(), cp.Len())
+	}
+
+	cp, err = Resume(path, orphans, gens)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts.Stop, opts.Save = nil, nil
+	if err := Search(orphans, gens, cp, opts); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"beta.org/", "login.example.com/"}; !reflect.DeepEqual(hitPatterns(cp), want) || cp.Hashed() != cp.Len() {
+		t.Errorf("hits %v after %d of %d candidates, want %v", hitPatterns(cp), cp.Hashed(), cp.Len(), want)
+	}
+
+	if _, err := Resume(path, orphans[:2], gens); err == nil {
+		t.Error("resumed the checkpoint of other orphans")
+	}
+	if _, err := Resume(path, orphans, gens[:1]); err == nil {
+		t.Error("resumed the checkpoint of other generators")
+	}
+}
@@ -0,0 +1,259 @@
+// Package publicsuffix finds the public suffix and the registrable domain of
+// hostnames with a bundled snapshot of the Public Suffix List
+// (public_suffix_list.dat, see https://publicsuffix.org/) and a list of
+// shared hosting suffixes that the PSL lacks (shared_hosting.dat).
+//
+// FOCAL uses it to tell which decompositions of a URL are so broad that they
+// match the sites of other owners: Safe Browsing decomposes a hostname into
+// the suffixes formed from its last 5 components, down to 2 components, so
+// a.example.000webhostapp.com into example.000webhostapp.com and
+// 000webhostapp.com too.
+package publicsuffix
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+
+	"../golang.org/x/net/idna"
+)
+
+var (
+	//go:embed public_suffix_list.dat
+	pslData string
+	//go:embed shared_hosting.dat
+	sharedData string
+)
+
+// Section is the part of the lists a rule comes from.
+type Section int
+
+const (
+	// ICANN suffixes are the ones registries delegate, e.g. com or co.uk.
+	ICANN Section = iota
+	// Private suffixes are the ones companies let their users register
+	// subdomains under, e.g. github.io (the PSL PRIVATE DOMAINS section).
+	Private
+	// Shared suffixes are shared hosting suffixes of shared_hosting.dat.
+	Shared
+)
+
+func (s Section) String() string {
+	switch s {
+	case ICANN:
+		return "icann"
+	case Private:
+		return "private"
+	case Shared:
+		return "shared"
+	}
+	return fmt.Sprintf("Section(%d)", int(s))
+}
+
+type rule struct {
+	section Section
+}
+
+// List is a list of public suffix rules, in ASCII (A-label) form. Rules are
+// keyed by suffix; wildcard rules are keyed as "*.ck" and exception rules as
+// "!www.ck".
+type List struct {
+	rules map[string]rule
+}
+
+// Default is the bundled Public Suffix List, with the shared hosting
+// suffixes.
+var Default = mustParse(pslData, sharedData)
+
+// PSL is the bundled Public Suffix List alone.
+var PSL = mustParse(pslData)
+
+func mustParse(data ...string) *List {
+	l := &List{rules: make(map[string]rule)}
+	for i, d := range data {
+		section := ICANN
+		if i > 0 {
+			section = Shared
+		}
+		if err := l.Parse(strings.NewReader(d), section); err != nil {
+			panic(err)
+		}
+	}
+	return l
+}
+
+// Parse adds the rules of a list in the format of public_suffix_list.dat.
+// Rules are in section until a "===BEGIN PRIVATE DOMAINS===" comment.
+func (l *List) Parse(r io.Reader, section Section) error {
+	if l.rules == nil {
+		l.rules = make(map[string]rule)
+	}
+	s := bufio.NewScanner(r)
+	for n := 1; s.Scan(); n++ {
+		line := strings.TrimSpace(s.Text())
+		if strings.HasPrefix(line, "//") {
+			if strings.Contains(line, "===BEGIN PRIVATE DOMAINS===") {
+				section = Private
+			}
+			continue
+		}
+		// Rules end at the first whitespace.
+		if i := strings.IndexAny(line, " \t"); i >= 0 {
+			line = line[:i]
+		}
+		if line == "" {
+			continue
+		}
+		prefix := ""
+		switch {
+		case strings.HasPrefix(line, "!"):
+			prefix, line = "!", line[1:]
+		case strings.HasPrefix(line, "*."):
+			prefix, line = "*.", line[2:]
+		}
+		name, err := idna.ToASCII(strings.ToLower(line))
+		if err != nil || name == "" {
+			return fmt.Errorf("publicsuffix: line %d: invalid rule %q", n, s.Text())
+		}
+		l.rules[prefix+name] = rule{section}
+	}
+	return s.Err()
+}
+
+// Len returns the number of rules of l.
+func (l *List) Len() int {
+	return len(l.rules)
+}
+
+// labels splits a lowercased hostname into its labels, as A-labels for the
+// lookups. It returns nil for hostnames with empty labels and IP addresses.
+func labels(host string) (display, ascii []string) {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if host == "" || net.ParseIP(strings.Trim(host, "[]")) != nil {
+		return nil, nil
+	}
+	display = strings.Split(host, ".")
+	ascii = make([]string, len(display))
+	for i, label := range display {
+		if label == "" {
+			return nil, nil
+		}
+		a, err := idna.ToASCII(label)
+		if err != nil {
+			a = label
+		}
+		ascii[i] = a
+	}
+	return display, ascii
+}
+
+// suffix returns the number of labels of the public suffix of a hostname of
+// labels, and the section of the rule that matched. The default rule "*"
+// makes an unlisted TLD a public suffix of the ICANN section.
+func (l *List) suffix(labels []string) (n int, section Section) {
+	n = 1
+	for i := len(labels) - 1; i >= 0; i-- {
+		name := strings.Join(labels[i:], ".")
+		// An exception rule overrides every other rule.
+		if r, ok := l.rules["!"+name]; ok {
+			return len(labels) - i - 1, r.section
+		}
+		if r, ok := l.rules[name]; ok {
+			n, section = len(labels)-i, r.section
+		}
+		if i+1 < len(labels) {
+			if r, ok := l.rules["*."+strings.Join(labels[i+1:], ".")]; ok {
+				n, section = len(labels)-i, r.section
+			}
+		}
+	}
+	return n, section
+}
+
+// PublicSuffix returns the public suffix of host, e.g. "co.uk" for
+// "www.example.co.uk", and the section of its rule. It returns "" for IP
+// addresses and hostnames with empty labels.
+func (l *List) PublicSuffix(host string) (suffix string, section Section) {
+	display, ascii := labels(host)
+	if display == nil {
+		return "", ICANN
+	}
+	n, section := l.suffix(ascii)
+	return strings.Join(display[len(display)-n:], "."), section
+}
+
+// RegistrableDomain returns the registrable domain of host, i.e. its public
+// suffix and the label before it, e.g. "example.co.uk" for
+// "www.example.co.uk". It returns "" if host is a public suffix, an IP
+// address or has empty labels.
+func (l *List) RegistrableDomain(host string) string {
+	display, ascii := labels(host)
+	if display == nil {
+		return ""
+	}
+	n, _ := l.suffix(ascii)
+	if n >= len(display) {
+		return ""
+	}
+	return strings.Join(display[len(display)-n-1:], ".")
+}
+
+// Level is where a hostname is in the domain hierarchy. Levels are ordered
+// from the narrowest to the broadest.
+type Level int
+
+const (
+	// Subdomain is a hostname below its registrable domain, e.g.
+	// www.example.com, or an IP address.
+	Subdomain Level = iota
+	// Registrable is a registrable domain, e.g. example.com or
+	// example.github.io.
+	Registrable
+	// SharedSuffix is a suffix of the PSL private section or a shared
+	// hosting suffix, e.g. github.io or 000webhostapp.com. Its subdomains
+	// are the sites of many owners.
+	SharedSuffix
+	// PublicSuffix is an ICANN public suffix, e.g. com.br.
+	PublicSuffix
+)
+
+var levelNames = []string{"subdomain", "registrable", "shared", "public"}
+
+func (lv Level) String() string {
+	if lv < 0 || int(lv) >= len(levelNames) {
+		return fmt.Sprintf("Level(%d)", int(lv))
+	}
+	return levelNames[lv]
+}
+
+// ParseLevel parses the name of a level, as returned by Level.String.
+func ParseLevel(s string) (Level, error) {
+	for i, name := range levelNames {
+		if s == name {
+			return Level(i), nil
+		}
+	}
+	return 0, fmt.Errorf("publicsuffix: unknown level %q (want subdomain, registrable, shared or public)", s)
+}
+
+// Level returns the level of host. IP addresses have no parent domains and
+// are Subdomain; so are hostnames with empty labels.
+func (l *List) Level(host string) Level {
+	display, ascii := labels(host)
+	if display == nil {
+		return Subdomain
+	}
+	n, section := l.suffix(ascii)
+	switch {
+	case n < len(display)-1:
+		return Subdomain
+	case n == len(display)-1:
+		return Registrable
+	case section == ICANN:
+		return PublicSuffix
+	}
+	return SharedSuffix
+}
@@ -0,0 +1,126 @@
+package publicsuffix
+
+import (
+	"bufio"
+	"os"
+	"strings"
+	"testing"
+)
+
+// TestRegistrableDomain runs the checkPublicSuffix tests of the PSL
+// (testdata/tests.txt, from https://github.com/publicsuffix/list).
+func TestRegistrableDomain(t *testing.T) {
+	f, err := os.Open("testdata/tests.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	null := func(s string) string {
+		if s == "null" {
+			return ""
+		}
+		return s
+	}
+	s := bufio.NewScanner(f)
+	tests := 0
+	for n := 1; s.Scan(); n++ {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "//") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			t.Fatalf("tests.txt:%d: %d fields, want 2", n, len(fields))
+		}
+		host, want := null(fields[0]), null(fields[1])
+		if got := PSL.RegistrableDomain(host); got != strings.ToLower(want) {
+			t.Errorf("tests.txt:%d: RegistrableDomain(%q) = %q, want %q", n, host, got, want)
+		}
+		tests++
+	}
+	if err := s.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if tests < 50 {
+		t.Fatalf("ran %d tests, want the whole file", tests)
+	}
+}
+
+func TestLevel(t *testing.T) {
+	vectors := []struct {
+		host    string
+		level   Level
+		suffix  string
+		section Section
+	}{
+		{"www.google.com", Subdomain, "com", ICANN},
+		{"google.com", Registrable, "com", ICANN},
+		{"com", PublicSuffix, "com", ICANN},
+		{"www.example.com.br", Subdomain, "com.br", ICANN},
+		{"example.com.br", Registrable, "com.br", ICANN},
+		{"com.br", PublicSuffix, "com.br", ICANN},
+		{"a.example.000webhostapp.com", Subdomain, "000webhostapp.com", Shared},
+		{"example.000webhostapp.com", Registrable, "000webhostapp.com", Shared},
+		{"000webhostapp.com", SharedSuffix, "000webhostapp.com", Shared},
+		{"example.github.io", Registrable, "github.io", Private},
+		{"github.io", SharedSuffix, "github.io", Private},
+		{"foo.blogspot.com", Registrable, "blogspot.com", Private},
+		{"www.ck", Registrable, "ck", ICANN},
+		{"a.ck", PublicSuffix, "a.ck", ICANN},
+		{"xn--85x722f.xn--55qx5d.cn", Registrable, "xn--55qx5d.cn", ICANN},
+		{"食狮.公司.cn", Registrable, "公司.cn", ICANN},
+		{"example.unlisted", Registrable, "unlisted", ICANN},
+		{"1.2.3.4", Subdomain, "", ICANN},
+		{"[::1]", Subdomain, "", ICANN},
+		{"a..com", Subdomain, "", ICANN},
+	}
+	for _, v := range vectors {
+		if got := Default.Level(v.host); got != v.level {
+			t.Errorf("Level(%q) = %v, want %v", v.host, got, v.level)
+		}
+		if suffix, section := Default.PublicSuffix(v.host); suffix != v.suffix || section != v.section {
+			t.Errorf("PublicSuffix(%q) = %q, %v, want %q, %v", v.host, suffix, section, v.suffix, v.section)
+		}
+	}
+
+	if got := PSL.Level("000webhostapp.com"); got != Registrable {
+		t.Errorf("PSL.Level(%q) = %v, want %v without the shared hosting suffixes", "000webhostapp.com", got, Registrable)
+	}
+}
+
+func TestParse(t *testing.T) {
+	var l List
+	err := l.Parse(strings.NewReader(`// Comment
+com
+*.kawasaki.jp
+!city.kawasaki.jp  trailing text is ignored
+// ===BEGIN PRIVATE DOMAINS===
+Example.COM
+`), ICANN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.Len() != 4 {
+		t.Errorf("Len() = %d, want 4", l.Len())
+	}
+	if _, section := l.PublicSuffix("www.example.com"); section != Private {
+		t.Errorf("section of example.com = %v, want %v", section, Private)
+	}
+	if got := l.RegistrableDomain("www.city.kawasaki.jp"); got != "city.kawasaki.jp" {
+		t.Errorf("RegistrableDomain(www.city.kawasaki.jp) = %q, want city.kawasaki.jp", got)
+	}
+
+	if err := new(List).Parse(strings.NewReader("*.\n"), ICANN); err == nil {
+		t.Error("Parse accepted an empty wildcard rule")
+	}
+
+	for _, s := range []string{"subdomain", "registrable", "shared", "public"} {
+		if lv, err := ParseLevel(s); err != nil || lv.String() != s {
+			t.Errorf("ParseLevel(%q) = %v, %v", s, lv, err)
+		}
+	}
+	if _, err := ParseLevel("tld"); err == nil {
+		t.Error("ParseLevel accepted an unknown level")
+	}
+}
@@ -0,0 +1,134 @@
+package reident
+
+import (
+	"math"
+	"sort"
+
+	"../focal"
+)
+
+// Percentile is a percentile of the anonymity set sizes of the patterns: p
+// percent of the patterns share their prefix with at most SetSize-1 others.
+type Percentile struct {
+	P       float64 `json:"p"`
+	SetSize int     `json:"setSize"`
+}
+
+// percentiles are the percentiles Anonymity reports.
+var percentiles = []float64{50, 90, 95, 99, 100}
+
+// AnonymityStats measures how well the prefixes of an index hide its
+// patterns. The anonymity set of a pattern is the set of patterns sharing its
+// prefix, i.e., the candidates of the prefix; the pattern is k-anonymous for
+// k its size. Entropies are those of the pattern given its prefix, in bits.
+type AnonymityStats struct {
+	Bits     int `json:"bits"`
+	Patterns int `json:"patterns"`
+	Prefixes int `json:"prefixes"`
+	// SetSizes counts the prefixes by the size of their anonymity set.
+	SetSizes map[int]int `json:"setSizes"`
+	// MeanSetSize is the mean set size of a prefix, patterns per prefix.
+	MeanSetSize float64 `json:"meanSetSize"`
+	// ExpectedSetSize is the mean set size of a pattern: patterns in large
+	// sets count more.
+	ExpectedSetSize float64      `json:"expectedSetSize"`
+	Percentiles     []Percentile `json:"percentiles"`
+	// UniquePrefixes is the fraction of the prefixes that single out their
+	// pattern, UniquePatterns the fraction of the patterns singled out.
+	UniquePrefixes float64 `json:"uniquePrefixes"`
+	UniquePatterns float64 `json:"uniquePatterns"`
+	// ShannonEntropy is the mean uncertainty on a pattern given its prefix,
+	// MinEntropy the uncertainty of the best guess, -log2 of the chance to
+	// guess the pattern of a prefix right (average min-entropy).
+	ShannonEntropy float64 `json:"shannonEntropy"`
+	MinEntropy     float64 `json:"minEntropy"`
+	// Weighted are the measures for patterns drawn by popularity rather
+	// than uniformly, if Anonymity is given weights.
+	Weighted *WeightedStats `json:"weighted,omitempty"`
+}
+
+// WeightedStats are the measures of the patterns drawn with probabilities
+// proportional to their weights.
+type WeightedStats struct {
+	ExpectedSetSize float64 `json:"expectedSetSize"`
+	ShannonEntropy  float64 `json:"shannonEntropy"`
+	MinEntropy      float64 `json:"minEntropy"`
+}
+
+// Anonymity measures the anonymity of the patterns of index, whose keys are
+// their prefixes of bits bits. If weight is set, the patterns are also
+// measured as drawn with probabilities proportional to weight(pattern), such
+// as their popularity; weights must be positive.
+func Anonymity(index map[focal.HashPrefix][]string, bits int, weight func(pattern string) float64) *AnonymityStats {
+	s := &AnonymityStats{Bits: bits, Prefixes: len(index), SetSizes: make(map[int]int)}
+	var sumSquares, shannon float64
+	var w, wSquares, wShannon, wMax float64
+	for _, patterns := range index {
+		k := len(patterns)
+		s.SetSizes[k]++
+		s.Patterns += k
+		sumSquares += float64(k) * float64(k)
+		shannon += float64(k) * math.Log2(float64(k))
+
+		if weight == nil {
+			continue
+		}
+		// With weights, the patterns of a prefix are a distribution of
+		// their own, which weighs in with the total weight of the prefix.
+		var bucket, best, plogp float64
+		for _, p := range patterns {
+			pw := weight(p)
+			bucket += pw
+			plogp += pw * math.Log2(pw)
+			best = math.Max(best, pw)
+		}
+		w += bucket
+		wSquares += bucket * float64(k)
+		wShannon += bucket*math.Log2(bucket) - plogp
+		wMax += best
+	}
+	if s.Patterns == 0 {
+		return s
+	}
+
+	n := float64(s.Patterns)
+	s.MeanSetSize = n / float64(s.Prefixes)
+	s.ExpectedSetSize = sumSquares / n
+	s.UniquePrefixes = float64(s.SetSizes[1]) / float64(s.Prefixes)
+	s.UniquePatterns = float64(s.SetSizes[1]) / n
+	s.ShannonEntropy = shannon / n
+	s.MinEntropy = math.Log2(n / float64(s.Prefixes))
+	s.Percentiles = setSizePercentiles(s.SetSizes, s.Patterns)
+	if weight != nil && w > 0 {
+		// Rounding errors must not make an entropy of unique patterns
+		// negative.
+		s.Weighted = &WeightedStats{
+			ExpectedSetSize: wSquares / w,
+			ShannonEntropy:  math.Max(0, wShannon/w),
+			MinEntropy:      math.Max(0, -math.Log2(wMax/w)),
+		}
+	}
+	return s
+}
+
+// setSizePercentiles returns the percentiles of the set sizes of the n
+// patterns counted by sizes.
+func setSizePercentiles(sizes map[int]int, n int) []Percentile {
+	var ks []int
+	for k := range sizes {
+		ks = append(ks, k)
+	}
+	sort.Ints(ks)
+	var ps []Percentile
+	i, cum := 0, 0
+	for _, p := range percentiles {
+		for ; i < len(ks); i++ {
+			if cum+ks[i]*sizes[ks[i]] >= int(math.Ceil(p/100*float64(n))) {
+				break
+			}
+			cum += ks[i] * sizes[ks[i]]
+		}
+		ps = append(ps, Percentile{P: p, SetSize: ks[i]})
+	}
+	return ps
+}
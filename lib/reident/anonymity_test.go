@@ -0,0 +1,64 @@
+package reident
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"../focal"
+)
+
+func TestAnonymity(t *testing.T) {
+	index := map[focal.HashPrefix][]string{
+		"\x00\x00\x00\x01": {"a/"},
+		"\x00\x00\x00\x02": {"b/"},
+		"\x00\x00\x00\x03": {"c1/", "c2/"},
+		"\x00\x00\x00\x04": {"d1/", "d2/", "d3/", "d4/"},
+	}
+	near := func(got, want float64) bool { return math.Abs(got-want) < 1e-9 }
+
+	s := Anonymity(index, 32, nil)
+	if s.Patterns != 8 || s.Prefixes != 4 || !reflect.DeepEqual(s.SetSizes, map[int]int{1: 2, 2: 1, 4: 1}) {
+		t.Errorf("%d patterns, %d prefixes, set sizes %v", s.Patterns, s.Prefixes, s.SetSizes)
+	}
+	// The mean of a prefix is 8/4; a pattern is in a set of (1+1+2*2+4*4)/8
+	// on average.
+	if !near(s.MeanSetSize, 2) || !near(s.ExpectedSetSize, 2.75) {
+		t.Errorf("mean set size %v, expected set size %v, want 2 and 2.75", s.MeanSetSize, s.ExpectedSetSize)
+	}
+	if !near(s.UniquePrefixes, 0.5) || !near(s.UniquePatterns, 0.25) {
+		t.Errorf("unique prefixes %v, patterns %v, want 0.5 and 0.25", s.UniquePrefixes, s.UniquePatterns)
+	}
+	if !near(s.ShannonEntropy, 1.25) || !near(s.MinEntropy, 1) {
+		t.Errorf("Shannon entropy %v, min-entropy %v, want 1.25 and 1", s.ShannonEntropy, s.MinEntropy)
+	}
+	want := []Percentile{{50, 2}, {90, 4}, {95, 4}, {99, 4}, {100, 4}}
+	if !reflect.DeepEqual(s.Percentiles, want) {
+		t.Errorf("percentiles %v, want %v", s.Percentiles, want)
+	}
+	if s.Weighted != nil {
+		t.Errorf("weighted stats %+v without weights", s.Weighted)
+	}
+
+	// Equal weights measure as much as none.
+	s = Anonymity(index, 32, func(string) float64 { return 3 })
+	if w := s.Weighted; w == nil || !near(w.ExpectedSetSize, 2.75) || !near(w.ShannonEntropy, 1.25) || !near(w.MinEntropy, 1) {
+		t.Errorf("equal weights: %+v", w)
+	}
+
+	// A popular pattern that is alone on its prefix is re-identified more
+	// often.
+	s = Anonymity(index, 32, func(p string) float64 {
+		if p == "a/" {
+			return 4
+		}
+		return 1
+	})
+	if w := s.Weighted; w == nil || !near(w.ExpectedSetSize, 25.0/11) || !near(w.ShannonEntropy, 10.0/11) || !near(w.MinEntropy, math.Log2(11.0/7)) {
+		t.Errorf("popular unique pattern: %+v", w)
+	}
+
+	if s := Anonymity(nil, 32, nil); s.Patterns != 0 || s.Percentiles != nil {
+		t.Errorf("empty index: %+v", s)
+	}
+}
@@ -0,0 +1,364 @@
+package reident
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"../focal"
+	"../publicsuffix"
+)
+
+// The tracking attack of the test_gsb_prefix_attack experiment: the provider
+// of the prefix set plants the prefixes of a target URL into it, so that a
+// client visiting the target sends them. The Injector computes the fewest
+// prefixes to plant for the prefixes sent by a visit to tell the target from
+// every other URL of a corpus, the URLs the provider expects its users to
+// visit. Prefixes are 32-bit, the length FOCAL and Safe Browsing clients
+// match first. Each target is planned against the published set alone, not
+// with the prefixes planted for the others.
+
+// Level is the granularity at which a target is tracked.
+type Level int
+
+const (
+	// URLLevel tracks the visits to the target URL itself.
+	URLLevel Level = iota
+	// DomainLevel tracks the visits to any URL of the registrable domain
+	// of the target.
+	DomainLevel
+)
+
+func (l Level) String() string {
+	if l == DomainLevel {
+		return "domain"
+	}
+	return "url"
+}
+
+// Planted is a prefix planted into the set and the decomposition it tracks.
+type Planted struct {
+	Pattern string
+	Prefix  focal.HashPrefix
+}
+
+// Injection is the plan to track a target at a level.
+type Injection struct {
+	Target string
+	Level  Level
+	// Pattern is the decomposition that stands for the target: its full
+	// pattern at the URL level, its registrable domain at the domain level.
+	Pattern string
+	// Published are the prefixes of the target a visit already sends.
+	Published []focal.HashPrefix
+	// Planted are the prefixes to plant, none if the published ones already
+	// single the target out.
+	Planted []Planted
+	// Confusable are the corpus URLs whose visits send the same prefixes as
+	// a visit to the target, or, at the domain level, send the prefix of the
+	// domain from another domain. Planting prefixes of the target alone
+	// cannot tell them apart, e.g. the URLs whose decompositions include
+	// every decomposition of the target.
+	Confusable []string
+}
+
+// Unique reports whether the visits to the target are told from the visits
+// to every other URL of the corpus.
+func (in *Injection) Unique() bool {
+	return len(in.Confusable) == 0
+}
+
+// visit is the lookup of a corpus URL.
+type visit struct {
+	url  string
+	host string
+	// prefixes are the distinct prefixes of the decompositions, sorted,
+	// and key their concatenation: visits of the same key look the same
+	// decompositions up.
+	prefixes []focal.HashPrefix
+	key      string
+	// sent is the concatenation of the prefixes in the published set.
+	sent string
+}
+
+// has reports whether the visit looks a decomposition of prefix h up.
+func (v *visit) has(h focal.HashPrefix) bool {
+	i := sort.Search(len(v.prefixes), func(i int) bool { return v.prefixes[i] >= h })
+	return i < len(v.prefixes) && v.prefixes[i] == h
+}
+
+// Injector plans the injections into a published prefix set.
+type Injector struct {
+	published *focal.HashSet
+	byPrefix  map[focal.HashPrefix][]*visit
+}
+
+// NewInjector returns an injector into published for the visits to the URLs
+// of corpus. It also returns the corpus URLs that have no decomposition.
+func NewInjector(published *focal.HashSet, corpus []string) (*Injector, []string) {
+	in := &Injector{published: published, byPrefix: make(map[focal.HashPrefix][]*visit)}
+	var rejected []string
+	for _, u := range corpus {
+		v, err := in.lookup(u)
+		if err != nil {
+			rejected = append(rejected, u)
+			continue
+		}
+		for _, h := range v.prefixes {
+			in.byPrefix[h] = append(in.byPrefix[h], v)
+		}
+	}
+	return in, rejected
+}
+
+// lookup decomposes u as a client visiting it does.
+func (in *Injector) lookup(u string) (*visit, error) {
+	patterns, err := focal.GeneratePatterns(u)
+	if err != nil {
+		return nil, err
+	}
+	v := &visit{url: u, host: patterns[0][:strings.IndexByte(patterns[0], '/')]}
+	seen := make(map[focal.HashPrefix]bool)
+	for _, p := range patterns {
+		h := focal.HashFromPattern(p).Short()
+		if !seen[h] {
+			seen[h] = true
+			v.prefixes = append(v.prefixes, h)
+		}
+	}
+	focal.HashPrefixes(v.prefixes).Sort()
+	var key, sent strings.Builder
+	for _, h := range v.prefixes {
+		key.WriteString(string(h))
+		if in.isPublished(h) {
+			sent.WriteString(string(h))
+		}
+	}
+	v.key, v.sent = key.String(), sent.String()
+	return v, nil
+}
+
+func (in *Injector) isPublished(h focal.HashPrefix) bool {
+	return in.published.Lookup(h) > 0
+}
+
+// URL plans the tracking of the visits to target. A visit is singled out
+// when the set of prefixes it sends differs from that of every corpus URL
+// with other decompositions; the prefixes to plant are picked greedily among
+// the unpublished prefixes of the target, as the ones telling it from the
+// most confusable URLs, so that their number is close to the minimum.
+func (in *Injector) URL(target string) (*Injection, error) {
+	t, err := in.lookup(target)
+	if err != nil {
+		return nil, err
+	}
+	patterns, _ := focal.GeneratePatterns(target)
+	// The full pattern comes last among those of the host of the URL; it is
+	// planted first among equally good prefixes.
+	full := 0
+	for full+1 < len(patterns) && strings.HasPrefix(patterns[full+1], t.host+"/") {
+		full++
+	}
+	inj := &Injection{Target: target, Level: URLLevel, Pattern: patterns[full]}
+
+	var candidates []Planted
+	seen := make(map[focal.HashPrefix]bool)
+	ordered := append([]string{patterns[full]}, patterns[:full]...)
+	for _, p := range append(ordered, patterns[full+1:]...) {
+		h := focal.HashFromPattern(p).Short()
+		if seen[h] {
+			continue
+		}
+		seen[h] = true
+		if in.isPublished(h) {
+			inj.Published = append(inj.Published, h)
+		} else {
+			candidates = append(candidates, Planted{Pattern: p, Prefix: h})
+		}
+	}
+	focal.HashPrefixes(inj.Published).Sort()
+
+	// The confusable URLs send the published prefixes of the target, and
+	// only those, and every planted prefix.
+	confusable := func(v *visit) bool {
+		return v.key != t.key && v.sent == t.sent
+	}
+	var pool []*visit
+	if len(inj.Published) > 0 {
+		for _, v := range in.byPrefix[inj.Published[0]] {
+			if confusable(v) {
+				pool = append(pool, v)
+			}
+		}
+	} else {
+		// A visit that sends nothing goes unseen: the first prefix is a
+		// must, the one the fewest confusable URLs share.
+		best, bestPool := -1, []*visit(nil)
+		for i, c := range candidates {
+			var p []*visit
+			for _, v := range in.byPrefix[c.Prefix] {
+				if confusable(v) {
+					p = append(p, v)
+				}
+			}
+			if best < 0 || len(p) < len(bestPool) {
+				best, bestPool = i, p
+			}
+		}
+		inj.Planted = append(inj.Planted, candidates[best])
+		candidates = append(candidates[:best:best], candidates[best+1:]...)
+		pool = bestPool
+	}
+
+	for len(pool) > 0 && len(candidates) > 0 {
+		best, told := -1, 0
+		for i, c := range candidates {
+			n := 0
+			for _, v := range pool {
+				if !v.has(c.Prefix) {
+					n++
+				}
+			}
+			if n > told {
+				best, told = i, n
+			}
+		}
+		if best < 0 {
+			break
+		}
+		c := candidates[best]
+		inj.Planted = append(inj.Planted, c)
+		candidates = append(candidates[:best:best], candidates[best+1:]...)
+		kept := pool[:0]
+		for _, v := range pool {
+			if v.has(c.Prefix) {
+				kept = append(kept, v)
+			}
+		}
+		pool = kept
+	}
+	inj.Confusable = urls(pool)
+	return inj, nil
+}
+
+// Domain plans the tracking of the visits to the registrable domain of
+// target, or to its host if it has none, such as an IP address. Every visit
+// to the domain looks the domain up, among the last five labels of its host,
+// so that its prefix is the one to plant; the corpus URLs of other domains
+// that share it are confusable.
+func (in *Injector) Domain(target string) (*Injection, error) {
+	t, err := in.lookup(target)
+	if err != nil {
+		return nil, err
+	}
+	domain := publicsuffix.Default.RegistrableDomain(t.host)
+	if domain == "" {
+		domain = t.host
+	}
+	pattern := domain + "/"
+	h := focal.HashFromPattern(pattern).Short()
+	inj := &Injection{Target: target, Level: DomainLevel, Pattern: pattern}
+	if in.isPublished(h) {
+		inj.Published = append(inj.Published, h)
+	} else {
+		inj.Planted = append(inj.Planted, Planted{Pattern: pattern, Prefix: h})
+	}
+
+	var confusable []*visit
+	for _, v := range in.byPrefix[h] {
+		if !inDomain(v.host, domain) {
+			confusable = append(confusable, v)
+		}
+	}
+	inj.Confusable = urls(confusable)
+	return inj, nil
+}
+
+// inDomain reports whether host is domain or one of its subdomains.
+func inDomain(host, domain string) bool {
+	return host == domain || strings.HasSuffix(host, "."+domain)
+}
+
+// urls returns the distinct URLs of vs, sorted.
+func urls(vs []*visit) []string {
+	seen := make(map[string]bool)
+	var us []string
+	for _, v := range vs {
+		if !seen[v.url] {
+			seen[v.url] = true
+			us = append(us, v.url)
+		}
+	}
+	sort.Strings(us)
+	return us
+}
+
+// Visibility is how visible injections are to whoever audits the published
+// set: its growth, and the planted prefixes of no blacklisted pattern, which
+// an auditor holding the blacklist, such as the pre-images of a FOCAL
+// blacklist, can single out.
+type Visibility struct {
+	// Published is the size of the published set, Planted the distinct
+	// prefixes planted into it, sorted, and Growth their ratio.
+	Published int
+	Planted   []Planted
+	Growth    float64
+	// PreImages tells whether the blacklist was known; the counts below are
+	// zero otherwise.
+	PreImages bool
+	// Orphans are the published prefixes without a blacklisted pre-image
+	// before the injections, the background the planted ones hide in.
+	Orphans int
+	// PlantedOrphans are the planted prefixes without a blacklisted
+	// pre-image.
+	PlantedOrphans []focal.HashPrefix
+}
+
+// String summarizes the visibility.
+func (vis *Visibility) String() string {
+	s := fmt.Sprintf("%d prefixes planted into %d (+%.4f%%)", len(vis.Planted), vis.Published, 100*vis.Growth)
+	if vis.PreImages {
+		s += fmt.Sprintf(", %d without pre-image next to %d published orphans", len(vis.PlantedOrphans), vis.Orphans)
+	}
+	return s
+}
+
+// Visible measures the visibility of injections into published. blacklist
+// holds the blacklisted patterns, the pre-images of the published prefixes;
+// nil if unknown, as for Safe Browsing.
+func Visible(published *focal.HashSet, injections []*Injection, blacklist []string) *Visibility {
+	vis := &Visibility{Published: published.Len()}
+	seen := make(map[focal.HashPrefix]bool)
+	for _, inj := range injections {
+		for _, p := range inj.Planted {
+			if !seen[p.Prefix] {
+				seen[p.Prefix] = true
+				vis.Planted = append(vis.Planted, p)
+			}
+		}
+	}
+	sort.Slice(vis.Planted, func(i, j int) bool { return vis.Planted[i].Prefix < vis.Planted[j].Prefix })
+	if vis.Published > 0 {
+		vis.Growth = float64(len(vis.Planted)) / float64(vis.Published)
+	}
+	if blacklist == nil {
+		return vis
+	}
+
+	vis.PreImages = true
+	preimages := make(map[focal.HashPrefix]bool, len(blacklist))
+	for _, p := range blacklist {
+		preimages[focal.HashFromPattern(p).Short()] = true
+	}
+	for _, h := range published.Export() {
+		if !preimages[h.Short()] {
+			vis.Orphans++
+		}
+	}
+	for _, p := range vis.Planted {
+		if !preimages[p.Prefix] {
+			vis.PlantedOrphans = append(vis.PlantedOrphans, p.Prefix)
+		}
+	}
+	return vis
+}
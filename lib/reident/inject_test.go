@@ -0,0 +1,138 @@
+package reident
+
+import (
+	"reflect"
+	"testing"
+
+	"../focal"
+)
+
+// patternSet returns the set of the 32-bit prefixes of patterns.
+func patternSet(patterns ...string) *focal.HashSet {
+	var phs focal.HashPrefixes
+	for _, p := range patterns {
+		phs = append(phs, focal.HashFromPattern(p).Short())
+	}
+	phs.Sort()
+	return focal.NewHashSet(phs)
+}
+
+func planted(inj *Injection) []string {
+	var patterns []string
+	for _, p := range inj.Planted {
+		patterns = append(patterns, p.Pattern)
+	}
+	return patterns
+}
+
+func TestInjectURL(t *testing.T) {
+	tests := []struct {
+		published  []string
+		target     string
+		planted    []string
+		confusable []string
+	}{{
+		// Nothing is published: the full pattern alone is enough.
+		target:  "http://example.com/z.html",
+		planted: []string{"example.com/z.html"},
+	}, {
+		// Every URL of example.com looks example.com/ up too.
+		target:  "http://example.com/",
+		planted: []string{"example.com/"},
+		confusable: []string{
+			"http://a.b.example.com/x/y.html?q=1",
+			"http://b.example.com/x/",
+			"http://example.com/z.html",
+		},
+	}, {
+		// The published prefix of the domain already narrows the visits
+		// down to those of example.com; the full pattern tells them apart.
+		published: []string{"example.com/"},
+		target:    "http://example.com/z.html",
+		planted:   []string{"example.com/z.html"},
+	}, {
+		// The target is blacklisted: its visits are already singled out.
+		published: []string{"other.org/x/y.html"},
+		target:    "http://other.org/x/y.html",
+	}, {
+		// A URL that is not in the corpus is told from it by its full
+		// pattern, even when its decompositions are.
+		published: []string{"b.example.com/x/"},
+		target:    "http://b.example.com/x/new.html",
+		planted:   []string{"b.example.com/x/new.html"},
+	}}
+	for _, test := range tests {
+		in, rejected := NewInjector(patternSet(test.published...), append(testURLs, "http://"))
+		if len(rejected) != 1 {
+			t.Fatalf("rejected %v, want the empty URL", rejected)
+		}
+		inj, err := in.URL(test.target)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := planted(inj); !reflect.DeepEqual(got, test.planted) {
+			t.Errorf("%s: planted %v, want %v", test.target, got, test.planted)
+		}
+		if !reflect.DeepEqual(inj.Confusable, test.confusable) {
+			t.Errorf("%s: confusable %v, want %v", test.target, inj.Confusable, test.confusable)
+		}
+		if inj.Unique() != (test.confusable == nil) {
+			t.Errorf("%s: unique %v", test.target, inj.Unique())
+		}
+		if len(inj.Published)+len(inj.Planted) == 0 {
+			t.Errorf("%s: a visit sends no prefix", test.target)
+		}
+	}
+}
+
+func TestInjectDomain(t *testing.T) {
+	in, _ := NewInjector(patternSet(), testURLs)
+	inj, err := in.Domain("http://a.b.example.com/x/y.html?q=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inj.Pattern != "example.com/" || !reflect.DeepEqual(planted(inj), []string{"example.com/"}) || !inj.Unique() {
+		t.Errorf("got pattern %s, planted %v, confusable %v, want example.com/ planted alone", inj.Pattern, planted(inj), inj.Confusable)
+	}
+
+	in, _ = NewInjector(patternSet("example.com/"), testURLs)
+	inj, err = in.Domain("http://example.com/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(inj.Planted) != 0 || len(inj.Published) != 1 {
+		t.Errorf("planted %v and published %d prefixes, want none and 1", planted(inj), len(inj.Published))
+	}
+}
+
+func TestVisible(t *testing.T) {
+	published := patternSet("example.com/", "rogue.example/")
+	in, _ := NewInjector(published, testURLs)
+	var injections []*Injection
+	for _, target := range []string{"http://example.com/z.html", "http://other.org/x/y.html"} {
+		inj, err := in.URL(target)
+		if err != nil {
+			t.Fatal(err)
+		}
+		injections = append(injections, inj)
+	}
+	// The domain of example.com/z.html is published: planting it again adds
+	// nothing.
+	dom, err := in.Domain("http://example.com/z.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	injections = append(injections, dom)
+
+	vis := Visible(published, injections, nil)
+	if len(vis.Planted) != 2 || vis.Growth != 1 || vis.PreImages {
+		t.Errorf("got %s, want 2 prefixes planted into 2", vis)
+	}
+
+	// other.org/x/y.html is blacklisted but was not published yet.
+	vis = Visible(published, injections, []string{"example.com/", "other.org/x/y.html"})
+	want := []focal.HashPrefix{focal.HashFromPattern("example.com/z.html").Short()}
+	if vis.Orphans != 1 || !reflect.DeepEqual(vis.PlantedOrphans, want) {
+		t.Errorf("got %s, want 1 planted orphan next to 1", vis)
+	}
+}
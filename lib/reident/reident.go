@@ -0,0 +1,164 @@
+// Package reident re-identifies the URL behind a set of hash prefixes sent
+// together, as a Safe Browsing or FOCAL server sees them when a client looks
+// up the decompositions of a URL.
+//
+// One prefix is ambiguous: several decompositions may share it. But the
+// prefixes a client sends together are those of the decompositions of a
+// single URL, so that a candidate whose own decompositions hash to several of
+// them is far more likely (Gerbet et al., "A privacy analysis of Google and
+// Yandex Safe Browsing", DSN 2016). The engine intersects the candidates of
+// every prefix from an inverted index, prefix -> decompositions, and ranks
+// them by the number of prefixes they explain.
+package reident
+
+import (
+	"math"
+	"sort"
+
+	"../focal"
+)
+
+// Engine re-identifies sets of prefixes from an index of decompositions.
+type Engine struct {
+	index map[focal.HashPrefix][]string
+	bits  int
+}
+
+// New returns an engine searching index, whose keys are the prefixes of
+// bits bits of the decompositions of their values, such as the indexes of
+// test-source.
+func New(index map[focal.HashPrefix][]string, bits int) *Engine {
+	return &Engine{index: index, bits: bits}
+}
+
+// Candidate is a decomposition of the index that may be the URL looked up.
+type Candidate struct {
+	URL string
+	// Explained are the observed prefixes of the decompositions of URL.
+	Explained []focal.HashPrefix
+	// Contradicted are the decompositions of URL whose prefix is in the
+	// index but was not observed: a client looking URL up would have sent
+	// it.
+	Contradicted []string
+	// Decompositions is the number of decompositions of URL.
+	Decompositions int
+}
+
+// consistent reports whether c explains every one of n observed prefixes
+// and is contradicted by none.
+func (c *Candidate) consistent(n int) bool {
+	return len(c.Explained) == n && len(c.Contradicted) == 0
+}
+
+// Result is the re-identification of a set of prefixes.
+type Result struct {
+	// Observed are the distinct observed prefixes, truncated to the bit
+	// length of the index, sorted.
+	Observed []focal.HashPrefix
+	// Unmatched are the observed prefixes of no decomposition of the index.
+	Unmatched []focal.HashPrefix
+	// Candidates are ranked by the number of prefixes they explain, then by
+	// the number of decompositions that contradict them, then by URL.
+	Candidates []Candidate
+	// Ambiguity is the number of candidates ranked first ex aequo: the
+	// residual ambiguity of the re-identification.
+	Ambiguity int
+	// Consistent is the number of candidates that explain every observed
+	// prefix and are contradicted by none.
+	Consistent int
+}
+
+// Bits returns the residual uncertainty of the re-identification in bits,
+// log2 of the ambiguity, assuming the best candidates equally likely.
+func (r *Result) Bits() float64 {
+	if r.Ambiguity == 0 {
+		return 0
+	}
+	return math.Log2(float64(r.Ambiguity))
+}
+
+// Best returns the candidates ranked first ex aequo.
+func (r *Result) Best() []Candidate {
+	return r.Candidates[:r.Ambiguity]
+}
+
+// Reidentify ranks the candidates of the prefixes observed together. The
+// prefixes must be at least as long as the prefixes of the index.
+func (e *Engine) Reidentify(observed []focal.HashPrefix) *Result {
+	r := &Result{}
+	seen := make(map[focal.HashPrefix]bool)
+	for _, h := range observed {
+		h = h.Truncate(e.bits)
+		if !seen[h] {
+			seen[h] = true
+			r.Observed = append(r.Observed, h)
+		}
+	}
+	focal.HashPrefixes(r.Observed).Sort()
+
+	// The candidates are the decompositions of the index sharing an observed
+	// prefix; each is then decomposed in turn to see which of the observed
+	// prefixes it explains.
+	candidates := make(map[string]bool)
+	for _, h := range r.Observed {
+		urls := e.index[h]
+		if len(urls) == 0 {
+			r.Unmatched = append(r.Unmatched, h)
+		}
+		for _, u := range urls {
+			candidates[u] = true
+		}
+	}
+	for u := range candidates {
+		r.Candidates = append(r.Candidates, e.candidate(u, seen))
+	}
+
+	sort.Slice(r.Candidates, func(i, j int) bool {
+		a, b := &r.Candidates[i], &r.Candidates[j]
+		if len(a.Explained) != len(b.Explained) {
+			return len(a.Explained) > len(b.Explained)
+		}
+		if len(a.Contradicted) != len(b.Contradicted) {
+			return len(a.Contradicted) < len(b.Contradicted)
+		}
+		return a.URL < b.URL
+	})
+	for i := range r.Candidates {
+		c := &r.Candidates[i]
+		if c.consistent(len(r.Observed)) {
+			r.Consistent++
+		}
+		if i == 0 || len(c.Explained) == len(r.Candidates[0].Explained) && len(c.Contradicted) == len(r.Candidates[0].Contradicted) {
+			r.Ambiguity++
+		}
+	}
+	return r
+}
+
+// candidate decomposes u and matches its decompositions against the observed
+// prefixes and the index.
+func (e *Engine) candidate(u string, observed map[focal.HashPrefix]bool) Candidate {
+	c := Candidate{URL: u}
+	patterns, err := focal.GeneratePatterns(u)
+	if err != nil {
+		// The index holds decompositions, which are canonical; one that is
+		// not explains its own prefix only.
+		patterns = []string{u}
+	}
+	c.Decompositions = len(patterns)
+	explained := make(map[focal.HashPrefix]bool)
+	for _, p := range patterns {
+		h := focal.HashFromPattern(p).Truncate(e.bits)
+		switch {
+		case observed[h]:
+			if !explained[h] {
+				explained[h] = true
+				c.Explained = append(c.Explained, h)
+			}
+		case len(e.index[h]) > 0:
+			c.Contradicted = append(c.Contradicted, p)
+		}
+	}
+	focal.HashPrefixes(c.Explained).Sort()
+	return c
+}
@@ -0,0 +1,107 @@
+package reident
+
+import (
+	"fmt"
+	"testing"
+
+	"../focal"
+)
+
+var testURLs = []string{
+	"http://a.b.example.com/x/y.html?q=1",
+	"http://b.example.com/x/",
+	"http://example.com/",
+	"http://example.com/z.html",
+	"http://other.org/x/y.html",
+}
+
+// testIndex indexes the decompositions of urls by their prefixes of bits
+// bits, as test-source does.
+func testIndex(t *testing.T, urls []string, bits int) map[focal.HashPrefix][]string {
+	index := make(map[focal.HashPrefix][]string)
+	seen := make(map[string]bool)
+	for _, u := range urls {
+		patterns, err := focal.GeneratePatterns(u)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, p := range patterns {
+			if !seen[p] {
+				seen[p] = true
+				h := focal.HashFromPattern(p).Truncate(bits)
+				index[h] = append(index[h], p)
+			}
+		}
+	}
+	return index
+}
+
+// prefixes returns the full hashes of the decompositions of u.
+func prefixes(t *testing.T, u string) []focal.HashPrefix {
+	patterns, err := focal.GeneratePatterns(u)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var hashes []focal.HashPrefix
+	for _, p := range patterns {
+		hashes = append(hashes, focal.HashFromPattern(p))
+	}
+	return hashes
+}
+
+func TestReidentify(t *testing.T) {
+	e := New(testIndex(t, testURLs, 32), 32)
+
+	// All the prefixes of a URL single it out among the decompositions that
+	// share some of them, such as b.example.com/x/ and example.com/.
+	r := e.Reidentify(prefixes(t, testURLs[0]))
+	if len(r.Observed) != 12 || len(r.Unmatched) != 0 {
+		t.Fatalf("%d observed prefixes, %d unmatched, want 12 and 0", len(r.Observed), len(r.Unmatched))
+	}
+	if best := r.Best(); len(best) != 1 || best[0].URL != "a.b.example.com/x/y.html?q=1" || len(best[0].Explained) != 12 {
+		t.Errorf("best candidates %+v, want a.b.example.com/x/y.html?q=1 explaining 12 prefixes", best)
+	}
+	if r.Ambiguity != 1 || r.Bits() != 0 || r.Consistent != 1 || len(r.Candidates) != 12 {
+		t.Errorf("ambiguity %d (%.1f bits), %d consistent of %d candidates, want 1 (0 bits), 1 of 12", r.Ambiguity, r.Bits(), r.Consistent, len(r.Candidates))
+	}
+
+	// A decomposition whose other decompositions are in the index but were
+	// not sent is contradicted by them.
+	r = e.Reidentify([]focal.HashPrefix{focal.HashFromPattern("b.example.com/x/")})
+	if len(r.Candidates) != 1 || r.Candidates[0].URL != "b.example.com/x/" || len(r.Candidates[0].Contradicted) != 3 || r.Consistent != 0 {
+		t.Errorf("candidates %+v, want b.example.com/x/ contradicted by 3 decompositions", r.Candidates)
+	}
+
+	// Prefixes of no decomposition are reported.
+	unknown := focal.HashFromPattern("unknown.net/")
+	r = e.Reidentify([]focal.HashPrefix{unknown, unknown})
+	if len(r.Observed) != 1 || len(r.Unmatched) != 1 || len(r.Candidates) != 0 || r.Ambiguity != 0 {
+		t.Errorf("unknown prefix: %d observed, %d unmatched, %d candidates, ambiguity %d", len(r.Observed), len(r.Unmatched), len(r.Candidates), r.Ambiguity)
+	}
+}
+
+func TestReidentifyCollisions(t *testing.T) {
+	// With 8-bit prefixes, a thousand hosts collide on every prefix: one
+	// prefix leaves several candidates, the prefixes of a whole URL fewer.
+	var urls []string
+	for i := 0; i < 1000; i++ {
+		urls = append(urls, fmt.Sprintf("http://www.host%d.com/a/b.html", i))
+	}
+	e := New(testIndex(t, urls, 8), 8)
+
+	one := e.Reidentify([]focal.HashPrefix{focal.HashFromPattern("www.host0.com/a/b.html")})
+	if one.Ambiguity < 2 {
+		t.Errorf("one 8-bit prefix: ambiguity %d, want several candidates", one.Ambiguity)
+	}
+	all := e.Reidentify(prefixes(t, urls[0]))
+	if all.Ambiguity >= one.Ambiguity || len(all.Best()[0].Explained) < 2 {
+		t.Errorf("all 8-bit prefixes: ambiguity %d, want less than %d", all.Ambiguity, one.Ambiguity)
+	}
+	found := false
+	for _, c := range all.Best() {
+		found = found || c.URL == "www.host0.com/a/b.html"
+	}
+	if !found {
+		t.Errorf("www.host0.com/a/b.html is not among the best candidates %+v", all.Best())
+	}
+}
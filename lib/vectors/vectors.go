@@ -0,0 +1,221 @@
+// Package vectors reads, writes and checks the URL processing test vectors
+// FOCAL implementations share. The Go analyses, the JS blacklist builder and
+// the extension must canonicalize and decompose URLs identically, or the
+// prefixes of a blacklist will not match the ones the extension looks up.
+//
+// A vector file is JSON:
+//
+//	{
+//	    "version": 1,
+//	    "implementation": "focal-go",
+//	    "vectors": [
+//	        {"input": "http://a.b.com/1/2.html?q", "canonical": "a.b.com/1/2.html",
+//	         "patterns": ["a.b.com/1/2.html", ...], "prefixes": [...]},
+//	        {"input": "/blah", "error": "safebrowsing: missing hostname"}
+//	    ]
+//	}
+//
+// canonical is the URL as blacklists hold it, hostname/path without the
+// scheme. prefixes[i] is the FOCAL prefix of patterns[i], the first four bytes
+// of its SHA-256 as a little-endian uint32. An implementation that rejects an
+// input sets error, with any message, instead.
+package vectors
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"../focal"
+)
+
+// Version is the version of the vector file format.
+const Version = 1
+
+// Implementation is the name of the implementation of this package.
+const Implementation = "focal-go"
+
+// Vector is the processing of an input URL by an implementation.
+type Vector struct {
+	Input     string   `json:"input"`
+	Canonical string   `json:"canonical,omitempty"`
+	Patterns  []string `json:"patterns,omitempty"`
+	Prefixes  []uint32 `json:"prefixes,omitempty"`
+	Error     string   `json:"error,omitempty"`
+}
+
+// File is a vector file.
+type File struct {
+	Version        int      `json:"version"`
+	Implementation string   `json:"implementation"`
+	Vectors        []Vector `json:"vectors"`
+}
+
+// Prefix returns the FOCAL prefix of a pattern.
+func Prefix(pattern string) uint32 {
+	return focal.HashFromPattern(pattern).Uint32LE()
+}
+
+// Generate returns the vector of input for the Go implementation, i.e.
+// focal.CanonicalURL and focal.GeneratePatterns.
+func Generate(input string) Vector {
+	v := Vector{Input: input}
+	canonical, err := focal.CanonicalURL(input)
+	if err != nil {
+		v.Error = err.Error()
+		return v
+	}
+	patterns, err := focal.GeneratePatterns(input)
+	if err != nil {
+		v.Error = err.Error()
+		return v
+	}
+	v.Canonical, v.Patterns = canonical, patterns
+	v.Prefixes = make([]uint32, len(patterns))
+	for i, p := range patterns {
+		v.Prefixes[i] = Prefix(p)
+	}
+	return v
+}
+
+// GenerateFile returns the vector file of inputs for the Go implementation.
+func GenerateFile(inputs []string) *File {
+	f := &File{Version: Version, Implementation: Implementation, Vectors: make([]Vector, len(inputs))}
+	for i, input := range inputs {
+		f.Vectors[i] = Generate(input)
+	}
+	return f
+}
+
+// Read reads a vector file. It fails on files of another version.
+func Read(path string) (*File, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var f File
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("vectors: %s: %v", path, err)
+	}
+	if f.Version != Version {
+		return nil, fmt.Errorf("vectors: %s: version %d, want %d", path, f.Version, Version)
+	}
+	for i, v := range f.Vectors {
+		if v.Error == "" && len(v.Prefixes) != len(v.Patterns) {
+			return nil, fmt.Errorf("vectors: %s: vector %d (%q): %d prefixes for %d patterns", path, i, v.Input, len(v.Prefixes), len(v.Patterns))
+		}
+	}
+	return &f, nil
+}
+
+// Write writes f to path, one vector per line.
+func (f *File) Write(path string) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "{\n    \"version\": %d,\n    \"implementation\": %q,\n    \"vectors\": [", f.Version, f.Implementation)
+	for i, v := range f.Vectors {
+		line, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString("\n        ")
+		b.Write(line)
+	}
+	b.WriteString("\n    ]\n}\n")
+	return ioutil.WriteFile(path, []byte(b.String()), 0644)
+}
+
+// The kinds of divergences.
+const (
+	KindError     = "error"     // one implementation rejects the input, the other does not
+	KindCanonical = "canonical" // the canonical URLs differ
+	KindPatterns  = "patterns"  // the sets of patterns differ
+	KindPrefix    = "prefix"    // a prefix is not the FOCAL prefix of its pattern
+)
+
+// Divergence is a difference between the vector of an implementation (got)
+// and the one of the reference implementation (want).
+type Divergence struct {
+	Input string `json:"input"`
+	Kind  string `json:"kind"`
+	Want  string `json:"want,omitempty"`
+	Got   string `json:"got,omitempty"`
+
+	// Missing and Extra are the patterns of the reference implementation
+	// that got lacks, and the patterns got has in addition (KindPatterns).
+	Missing []string `json:"missing,omitempty"`
+	Extra   []string `json:"extra,omitempty"`
+}
+
+func (d Divergence) String() string {
+	switch d.Kind {
+	case KindPatterns:
+		return fmt.Sprintf("%s: %q: missing %q, extra %q", d.Kind, d.Input, d.Missing, d.Extra)
+	}
+	return fmt.Sprintf("%s: %q: got %q, want %q", d.Kind, d.Input, d.Got, d.Want)
+}
+
+// Compare returns the divergences of got from want, which must be vectors of
+// the same input. The order of the patterns does not matter; the prefixes of
+// got are checked against its own patterns.
+func Compare(want, got Vector) []Divergence {
+	var ds []Divergence
+	for i, p := range got.Patterns {
+		if i < len(got.Prefixes) && got.Prefixes[i] != Prefix(p) {
+			ds = append(ds, Divergence{Input: got.Input, Kind: KindPrefix, Want: fmt.Sprint(Prefix(p)), Got: fmt.Sprint(got.Prefixes[i])})
+		}
+	}
+	if (want.Error != "") != (got.Error != "") {
+		errorOrOK := func(v Vector) string {
+			if v.Error != "" {
+				return "error: " + v.Error
+			}
+			return v.Canonical
+		}
+		return append(ds, Divergence{Input: got.Input, Kind: KindError, Want: errorOrOK(want), Got: errorOrOK(got)})
+	}
+	if want.Error != "" {
+		return ds
+	}
+	if want.Canonical != got.Canonical {
+		ds = append(ds, Divergence{Input: got.Input, Kind: KindCanonical, Want: want.Canonical, Got: got.Canonical})
+	}
+	missing, extra := minus(want.Patterns, got.Patterns), minus(got.Patterns, want.Patterns)
+	if len(missing) > 0 || len(extra) > 0 {
+		ds = append(ds, Divergence{Input: got.Input, Kind: KindPatterns, Missing: missing, Extra: extra})
+	}
+	return ds
+}
+
+// minus returns the strings of a that are not in b, sorted and without
+// duplicates.
+func minus(a, b []string) []string {
+	skip := make(map[string]bool, len(a)+len(b))
+	for _, s := range b {
+		skip[s] = true
+	}
+	var d []string
+	for _, s := range a {
+		if !skip[s] {
+			d = append(d, s)
+			skip[s] = true
+		}
+	}
+	sort.Strings(d)
+	return d
+}
+
+// Verify checks the vectors of f, as written by another implementation,
+// against the Go implementation, and returns the divergences in the order of
+// the vectors.
+func Verify(f *File) []Divergence {
+	var ds []Divergence
+	for _, got := range f.Vectors {
+		ds = append(ds, Compare(Generate(got.Input), got)...)
+	}
+	return ds
+}
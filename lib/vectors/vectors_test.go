@@ -0,0 +1,102 @@
+package vectors
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+const (
+	corpusPath = "../../testData/conformance/corpus.txt"
+	goldenPath = "../../testData/conformance/vectors.json"
+)
+
+// TestGolden checks that the Go implementation still processes the corpus as
+// in the golden vectors. Regenerate them with cmd/canonvectors after an
+// intended change.
+func TestGolden(t *testing.T) {
+	f, err := Read(goldenPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.Implementation != Implementation || len(f.Vectors) < 100 {
+		t.Fatalf("%s: %d %s vectors, want the Go vectors of the corpus", goldenPath, len(f.Vectors), f.Implementation)
+	}
+	for _, d := range Verify(f) {
+		t.Error(d)
+	}
+}
+
+func TestWriteRead(t *testing.T) {
+	f := GenerateFile([]string{"http://a.b.c/1/2.html?param=1/2", "/blah", "http://www.ümlat.com/"})
+	path := filepath.Join(t.TempDir(), "vectors.json")
+	if err := f.Write(path); err != nil {
+		t.Fatal(err)
+	}
+	got, err := Read(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, f) {
+		t.Errorf("Read(Write(f)) = %+v, want %+v", got, f)
+	}
+	if v := f.Vectors[0]; v.Canonical != "a.b.c/1/2.html" || len(v.Patterns) != 8 || v.Prefixes[0] != Prefix(v.Patterns[0]) {
+		t.Errorf("Generate(%q) = %+v", v.Input, v)
+	}
+	if f.Vectors[1].Error == "" {
+		t.Errorf("Generate(%q) has no error", f.Vectors[1].Input)
+	}
+
+	f.Version = Version + 1
+	if err := f.Write(path); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Read(path); err == nil {
+		t.Error("Read accepted a file of another version")
+	}
+}
+
+func TestCompare(t *testing.T) {
+	want := Generate("http://a.b.c/1/2.html?param=1/2")
+	vectors := []struct {
+		got   Vector
+		kinds []string
+	}{
+		{want, nil},
+		{Vector{Input: want.Input, Canonical: want.Canonical, Patterns: reverse(want.Patterns), Prefixes: reverse32(want.Prefixes)}, nil},
+		{Vector{Input: want.Input, Error: "rejected"}, []string{KindError}},
+		{Vector{Input: want.Input, Canonical: "http://" + want.Canonical, Patterns: want.Patterns, Prefixes: want.Prefixes}, []string{KindCanonical}},
+		{Vector{Input: want.Input, Canonical: want.Canonical, Patterns: append(want.Patterns[1:], "a.b.c/x"), Prefixes: append(want.Prefixes[1:], Prefix("a.b.c/x"))}, []string{KindPatterns}},
+		{Vector{Input: want.Input, Canonical: want.Canonical, Patterns: want.Patterns, Prefixes: append([]uint32{want.Prefixes[0] + 1}, want.Prefixes[1:]...)}, []string{KindPrefix}},
+	}
+	for i, v := range vectors {
+		var kinds []string
+		for _, d := range Compare(want, v.got) {
+			kinds = append(kinds, d.Kind)
+		}
+		if !reflect.DeepEqual(kinds, v.kinds) {
+			t.Errorf("test %d: Compare divergences %q, want %q", i, kinds, v.kinds)
+		}
+	}
+
+	ds := Compare(want, vectors[4].got)
+	if !reflect.DeepEqual(ds[0].Missing, []string{want.Patterns[0]}) || !reflect.DeepEqual(ds[0].Extra, []string{"a.b.c/x"}) {
+		t.Errorf("Compare patterns divergence = %+v", ds[0])
+	}
+}
+
+func reverse(ss []string) []string {
+	r := make([]string, len(ss))
+	for i, s := range ss {
+		r[len(ss)-1-i] = s
+	}
+	return r
+}
+
+func reverse32(us []uint32) []uint32 {
+	r := make([]uint32, len(us))
+	for i, u := range us {
+		r[len(us)-1-i] = u
+	}
+	return r
+}
@@ -0,0 +1,46 @@
+// Writes the test vectors of the extension's URL processing
+// (extension/js/processingURL.js) for a corpus, one input URL per line, in
+// the format of lib/vectors. Verify them against the Go implementation with
+// cmd/canonvectors:
+//
+//   node vectors.js -s corpus.txt -d js.json
+//   canonvectors -verify js.json
+var processing = require('../../extension/js/processingURL.js');
+var crypto = require('crypto');
+var fs = require('fs');
+var path = require('path');
+
+var src = path.normalize(__dirname + '/corpus.txt');
+var dest = 'js.json';
+
+for (var i = 2; i + 1 < process.argv.length; i += 2) {
+    if (process.argv[i] == '-s') { src = process.argv[i + 1]; }
+    if (process.argv[i] == '-d') { dest = process.argv[i + 1]; }
+}
+
+// FOCAL prefix: the first four bytes of sha256(pattern) as a little-endian
+// uint32, i.e., new Uint32Array(hash)[0].
+function prefix(pattern) {
+    return crypto.createHash('sha256').update(pattern, 'utf8').digest().readUInt32LE(0);
+}
+
+var vectors = [];
+fs.readFileSync(src).toString().split('\n').forEach(function (line) {
+    line = line.replace(/\r$/, '');
+    if (line == "") {return;}
+    var v = {"input": line};
+    try {
+        var canonicalized = processing.getCanonicalizedURL(line);
+        var patterns = processing.getLookupExpressions(canonicalized);
+        v.canonical = canonicalized.replace(/^[^:]*:\/\//, ''); // without scheme, as in the blacklists
+        v.patterns = patterns;
+        v.prefixes = patterns.map(prefix);
+    } catch (e) {
+        v.error = String(e);
+    }
+    vectors.push(v);
+});
+
+var out = {"version": 1, "implementation": "focal-extension-js", "vectors": vectors};
+fs.writeFileSync(dest, JSON.stringify(out, null, 1), 'utf-8');
+console.log('Wrote the vectors of ' + vectors.length + ' inputs to ' + dest);
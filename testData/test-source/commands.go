@@ -0,0 +1,584 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"../../lib/focal"
+	"../../lib/gsbdb"
+	"../../lib/pipeline"
+	"../../lib/publicsuffix"
+)
+
+// The default file names below are the ones the analyses historically used
+// when they were switched on by hand in main().
+const (
+	defaultGSBPrefixes = "./GSBhashprefixes.txt"
+	defaultIndex       = "hashprefix.json"
+	defaultHistory     = "BrowserHistory.json"
+	defaultDecomposed  = "./decomposed.txt"
+)
+
+func newFlagSet(name string) *flag.FlagSet {
+	return flag.NewFlagSet(name, flag.ContinueOnError)
+}
+
+// The prefix encoding flags. -gsbenc is the encoding of prefix lists (-gsb),
+// -enc the encoding of the keys of prefix -> decompositions indexes. Published
+// FOCAL blacklists (.json) hold le prefixes, the default -gsbenc for them; GSB
+// sqlite databases (.db) hold raw prefixes and need no encoding.
+const (
+	gsbEncUsage   = "encoding of the GSB hash prefixes: hex, bin, be or le (default hex, le for a .json blacklist)"
+	indexEncUsage = "encoding of the index keys: hex, bin, be or le"
+)
+
+// The prefix bit lengths the index and the analyses support.
+const (
+	minBitLength = 8
+	maxBitLength = 8 * focal.MaxHashPrefixLength
+)
+
+const bitLengthsUsage = "hash prefix bit lengths from 8 to 256, e.g. 32 or 18-32 or 16,32,64"
+
+// parseBitLengths parses a comma-separated list of bit lengths and ranges of
+// bit lengths, such as "16,18-32,64".
+func parseBitLengths(s string) ([]int, error) {
+	var bitlengths []int
+	for _, field := range strings.Split(s, ",") {
+		lo, hi := field, field
+		if i := strings.Index(field, "-"); i >= 0 {
+			lo, hi = field[:i], field[i+1:]
+		}
+		from, err := strconv.Atoi(strings.TrimSpace(lo))
+		if err != nil {
+			return nil, fmt.Errorf("invalid bit length %q", field)
+		}
+		to, err := strconv.Atoi(strings.TrimSpace(hi))
+		if err != nil {
+			return nil, fmt.Errorf("invalid bit length %q", field)
+		}
+		if from < minBitLength || to > maxBitLength || from > to {
+			return nil, fmt.Errorf("invalid bit length %q (want %d to %d)", field, minBitLength, maxBitLength)
+		}
+		for b := from; b <= to; b++ {
+			bitlengths = append(bitlengths, b)
+		}
+	}
+	return bitlengths, nil
+}
+
+// gsbFilterVar defines the flags selecting the threat lists of a GSB sqlite
+// database.
+func gsbFilterVar(fs *flag.FlagSet, f *gsbdb.Filter) {
+	fs.StringVar(&f.ThreatType, "threat", "", "threat type of the GSB database lists, e.g. MALWARE (default any)")
+	fs.StringVar(&f.PlatformType, "platform", gsbdb.AnyPlatform, "platform type of the GSB database lists (empty for any)")
+	fs.StringVar(&f.ThreatEntryType, "entry", "", "threat entry type of the GSB database lists, e.g. URL (default any)")
+}
+
+// prefixSourceVar defines the -gsb flag and the flags that tell how to read
+// it. The source is only complete once fs is parsed.
+func prefixSourceVar(fs *flag.FlagSet, usage string) *prefixSource {
+	src := new(prefixSource)
+	fs.StringVar(&src.path, "gsb", defaultGSBPrefixes, usage+": one prefix per line, a FOCAL blacklist (.json) or a GSB sqlite database (.db)")
+	fs.Var(&src.enc, "gsbenc", gsbEncUsage)
+	gsbFilterVar(fs, &src.filter)
+	return src
+}
+
+// prefixEncodingFlag is the encoding flag of a prefix source. It remembers
+// whether it was set, since FOCAL blacklists have their own default.
+type prefixEncodingFlag struct {
+	focal.PrefixEncoding
+	set bool
+}
+
+func (f *prefixEncodingFlag) Set(name string) error {
+	if err := f.PrefixEncoding.Set(name); err != nil {
+		return err
+	}
+	f.set = true
+	return nil
+}
+
+// levelFilter is the -exclude flag: it leaves out the decompositions whose
+// host is at or above a level of the bundled Public Suffix List. The zero
+// value keeps every decomposition.
+type levelFilter struct {
+	level publicsuffix.Level
+	set   bool
+}
+
+const excludeUsage = "leave out the decompositions whose host is at or above this level of the bundled Public Suffix List: registrable, shared or public (default none)"
+
+func (f *levelFilter) String() string {
+	if f == nil || !f.set {
+		return ""
+	}
+	return f.level.String()
+}
+
+func (f *levelFilter) Set(s string) error {
+	level, err := publicsuffix.ParseLevel(s)
+	if err != nil {
+		return err
+	}
+	if level == publicsuffix.Subdomain {
+		return fmt.Errorf("excluding the subdomain level would leave out every decomposition")
+	}
+	f.level, f.set = level, true
+	return nil
+}
+
+// keeps reports whether f keeps pattern.
+func (f levelFilter) keeps(pattern string) bool {
+	return !f.set || focal.PatternLevel(pattern, publicsuffix.Default) < f.level
+}
+
+// filter returns the patterns f keeps.
+func (f levelFilter) filter(patterns []string) []string {
+	if !f.set {
+		return patterns
+	}
+	kept := patterns[:0:0]
+	for _, p := range patterns {
+		if f.keeps(p) {
+			kept = append(kept, p)
+		}
+	}
+	fmt.Printf("    %d of %d decompositions are below the %s level!\n\n", len(kept), len(patterns), f.level)
+	return kept
+}
+
+// pathList is a flag that may be repeated, each time with a path.
+type pathList []string
+
+func (l *pathList) String() string {
+	if l == nil {
+		return ""
+	}
+	return strings.Join(*l, ",")
+}
+
+func (l *pathList) Set(path string) error {
+	*l = append(*l, path)
+	return nil
+}
+
+func prefixEncodingVar(fs *flag.FlagSet, name, usage string) *focal.PrefixEncoding {
+	enc := focal.HexPrefix
+	fs.Var(&enc, name, usage)
+	return &enc
+}
+
+// Module 1 & 8: Normalize (dedup) phishing URLs from eCrimeX, or websites from
+// Alexa top 1M, and write down results
+func runNormalize(args []string) error {
+	fs := newFlagSet("normalize")
+	filePath := fs.String("p", "../eCrimeExchange/phish15-19_4300k.txt", "input file path, - for the standard input; gzip and bzip2 inputs are decompressed")
+	numOfURLs := fs.Uint("n", ^uint(0), "number of URLs")
+	alexa := fs.Bool("alexa", false, "input is an Alexa top-1m CSV (rank,site)")
+	canonPath := fs.String("canon", "./canonicalized.txt", "output path of the canonicalized URLs")
+	dedupPath := fs.String("dedup", "./canondeduped.txt", "output path of the deduplicated URLs")
+	decomposedPath := fs.String("decomposed", defaultDecomposed, "output path of the unique decompositions")
+	indexPath := fs.String("o", defaultIndex, "output path of the prefix -> decompositions index")
+	indexEnc := prefixEncodingVar(fs, "enc", indexEncUsage)
+	levelsPath := fs.String("levels", "", "output path of the unique decompositions with the level of their host in the bundled Public Suffix List (pattern<TAB>level)")
+	var exclude levelFilter
+	fs.Var(&exclude, "exclude", excludeUsage)
+	rejectsPath := fs.String("rejects", "./rejects.json", "output path of the rejected URLs, with their line and reason, and of their counts (JSON); empty for none")
+	workers := fs.Int("workers", runtime.NumCPU(), "number of goroutines canonicalizing and decomposing URLs")
+	progress := fs.Duration("progress", 10*time.Second, "interval of the progress reports, 0 for none")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *alexa {
+		return alexaDataNorm(*filePath, *numOfURLs, *indexPath, *indexEnc, *rejectsPath)
+	}
+	if *workers < 1 {
+		return fmt.Errorf("invalid number of workers %d", *workers)
+	}
+	opts := pipeline.Options{Workers: *workers, ProgressInterval: *progress}
+	if *numOfURLs < uint(math.MaxInt32) {
+		opts.MaxLines = int(*numOfURLs)
+	}
+	if *progress > 0 {
+		opts.Progress = printProgress
+	}
+	return eCrimeDataNorm(*filePath, opts, *canonPath, *dedupPath, *decomposedPath, *levelsPath, exclude, *indexPath, *indexEnc, *rejectsPath)
+}
+
+// Module 4: Read SQLite db of GSB hash prefixes and write down line by line to
+// a text file, or index a list of decompositions by hash prefix
+func runIndex(args []string) error {
+	fs := newFlagSet("index")
+	dbPath := fs.String("db", "", "GSB sqlite database to export (e.g. "+gsbdb.DefaultPath+")")
+	var filter gsbdb.Filter
+	gsbFilterVar(fs, &filter)
+	lists := fs.Bool("lists", false, "list the threat lists of the -db database instead")
+	filePath := fs.String("p", defaultDecomposed, "input decompositions, one per line")
+	outPath := fs.String("o", "", "output path (default "+defaultIndex+", or "+defaultGSBPrefixes+" with -db)")
+	enc := prefixEncodingVar(fs, "enc", "encoding of the output prefixes: hex, bin, be or le")
+	bitlength := fs.Int("bits", 32, "hash prefix bit length of the index, from 8 to 256")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *dbPath != "" {
+		if *outPath == "" {
+			*outPath = defaultGSBPrefixes
+		}
+		if *lists {
+			return printGSBLists(*dbPath)
+		}
+		hashprefixes, err := readSQLite(*dbPath, filter)
+		if err != nil {
+			return err
+		}
+		return writePrefixes(hashprefixes, *outPath, *enc)
+	}
+
+	if *bitlength < minBitLength || *bitlength > maxBitLength {
+		return fmt.Errorf("invalid bit length %d (want %d to %d)", *bitlength, minBitLength, maxBitLength)
+	}
+	if *bitlength != 32 && (*enc == focal.BigEndianPrefix || *enc == focal.LittleEndianPrefix) {
+		return fmt.Errorf("%s encodes 32-bit prefixes only; use hex or bin for %d-bit prefixes", *enc, *bitlength)
+	}
+	if *outPath == "" {
+		*outPath = defaultIndex
+	}
+	decomposed, err := readURLFromFile(*filePath, ^uint(0))
+	if err != nil {
+		return err
+	}
+	return writeIndex(buildShortHashIndex(decomposed, *bitlength), *outPath, *enc)
+}
+
+// Module 5 & 6: Calculate how many of the GSB hash prefixes match the eCrimeX
+// Json file results, or how many eCrime URLs match the GSB hash prefixes
+func runMatch(args []string) error {
+	fs := newFlagSet("match")
+	gsb := prefixSourceVar(fs, "GSB hash prefixes")
+	indexPath := fs.String("index", defaultIndex, "eCrimeX prefix -> decompositions index (-from gsb)")
+	ecrimePath := fs.String("p", "./okstatus3.txt", "eCrimeX URL list (-from ecrime)")
+	from := fs.String("from", "gsb", "match direction: gsb (GSB prefixes in eCrimeX) or ecrime (eCrimeX URLs in GSB)")
+	outPath := fs.String("o", "", "output path (default ./smartscreentest.txt, or ecrimematchegsb.json with -from ecrime)")
+	indexEnc := prefixEncodingVar(fs, "enc", "encoding of the index keys (-from gsb) or of the output keys (-from ecrime): hex, bin, be or le")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	switch *from {
+	case "gsb":
+		if *outPath == "" {
+			*outPath = "./smartscreentest.txt"
+		}
+		return gsbmatchecrime(*gsb, *indexPath, *indexEnc, *outPath)
+	case "ecrime":
+		if *outPath == "" {
+			*outPath = "ecrimematchegsb.json"
+		}
+		return ecrimematchegsb(*gsb, *ecrimePath, *outPath, *indexEnc)
+	}
+	return fmt.Errorf("unknown match direction %q", *from)
+}
+
+// Module 7 & 8: Read shallalist and see any hits in GSB hash prefixes, or list
+// the Alexa sites that are tracked by GSB hash prefixes
+func runTrack(args []string) error {
+	fs := newFlagSet("track")
+	gsb := prefixSourceVar(fs, "GSB hash prefixes")
+	listPath := fs.String("p", "./alldomains.txt", "shallalist domains, one per line")
+	indexPath := fs.String("index", defaultIndex, "eCrimeX prefix -> decompositions index")
+	alexaPath := fs.String("alexa", "", "Alexa prefix -> decompositions index (from 'normalize -alexa'); switches to Alexa mode")
+	suspiciousPath := fs.String("o", "./suspicious.txt", "output path of the suspicious sites (or the tracked sites in Alexa mode)")
+	verifyPath := fs.String("verify", "./verify.txt", "output path of the sites verified by the eCrimeX index")
+	indexEnc := prefixEncodingVar(fs, "enc", "encoding of the eCrimeX (or Alexa) index keys: hex, bin, be or le")
+	rejectsPath := fs.String("rejects", "", "output path of the rejected shallalist URLs and of their counts (JSON) (default none)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *alexaPath != "" {
+		return alexaTrack(*alexaPath, *indexEnc, *gsb, *suspiciousPath)
+	}
+	return shallalisttrack(*listPath, *gsb, *indexPath, *indexEnc, *suspiciousPath, *verifyPath, *rejectsPath)
+}
+
+// Module 9 & 12: Normalize URL history from Chrome and compute hash prefixes,
+// or analyze the uniqueness of its hash prefixes
+func runHistory(args []string) error {
+	fs := newFlagSet("history")
+	historyPath := fs.String("p", defaultHistory, "browsing history export (JSON)")
+	gsb := prefixSourceVar(fs, "GSB hash prefixes")
+	outPath := fs.String("o", "historyhits.txt", "output path of the history URLs that hit GSB prefixes")
+	uniqueness := fs.Bool("unique", false, "analyze the uniqueness of the history hash prefixes instead")
+	rejectsPath := fs.String("rejects", "", "output path of the rejected history URLs and of their counts (JSON) (default none)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *uniqueness {
+		return uniqueHistoryHashPrefixes(*historyPath, *rejectsPath)
+	}
+	return browsingHistoryNorm(*historyPath, *gsb, *outPath, *rejectsPath)
+}
+
+// Module 3, 10 & 11: Test collisions for manually input URLs, browsing history
+// against suspicious GSB prefix hashes, or against the eCrimeX ground truth
+func runCollide(args []string) error {
+	fs := newFlagSet("collide")
+	mode := fs.String("mode", "interactive", "interactive (manually input URLs), gsb or groundtruth")
+	historyPath := fs.String("p", defaultHistory, "browsing history export (JSON)")
+	gsb := prefixSourceVar(fs, "GSB hash prefixes")
+	indexPath := fs.String("index", defaultIndex, "eCrimeX prefix -> decompositions index")
+	decomposedPath := fs.String("decomposed", "", "index these decompositions instead of reading -index (interactive mode)")
+	bits := fs.String("bits", "32", bitLengthsUsage+" (interactive mode)")
+	outPath := fs.String("o", "groundtruth.txt", "output path of the ground truth matches")
+	indexEnc := prefixEncodingVar(fs, "enc", indexEncUsage)
+	rejectsPath := fs.String("rejects", "", "output path of the rejected history URLs and of their counts (JSON) (default none; gsb and groundtruth modes)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	switch *mode {
+	case "interactive":
+		bitlengths, err := parseBitLengths(*bits)
+		if err != nil {
+			return err
+		}
+		indexes, err := collisionIndexes(*decomposedPath, *indexPath, *indexEnc, bitlengths)
+		if err != nil {
+			return err
+		}
+		testCollisionByURL(indexes, bitlengths)
+		return nil
+	case "gsb":
+		return collisionTest(*historyPath, *gsb, *rejectsPath)
+	case "groundtruth":
+		return collisionTest2(*historyPath, *indexPath, *indexEnc, *outPath, *rejectsPath)
+	}
+	return fmt.Errorf("unknown collide mode %q", *mode)
+}
+
+// collisionIndexes returns the index of each bit length, built from the
+// decompositions at decomposedPath or, if it is empty, re-keyed from the index
+// at indexPath. The latter only works for lengths up to those of its prefixes.
+func collisionIndexes(decomposedPath, indexPath string, indexEnc focal.PrefixEncoding, bitlengths []int) (map[int]map[focal.HashPrefix][]string, error) {
+	indexes := make(map[int]map[focal.HashPrefix][]string)
+	if decomposedPath != "" {
+		decomposed, err := readURLFromFile(decomposedPath, ^uint(0))
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range bitlengths {
+			indexes[b] = buildShortHashIndex(decomposed, b)
+		}
+		return indexes, nil
+	}
+
+	index, err := readIndex(indexPath, indexEnc)
+	if err != nil {
+		return nil, err
+	}
+	for k := range index {
+		for _, b := range bitlengths {
+			if 8*len(k) < b {
+				return nil, fmt.Errorf("%s has %d-bit prefixes; use -decomposed for %d-bit prefixes", indexPath, 8*len(k), b)
+			}
+		}
+	}
+	for _, b := range bitlengths {
+		indexes[b] = reindexShortHashIndex(index, b)
+	}
+	return indexes, nil
+}
+
+// Module 2: Load the decompositions of eCrimeX's data and analyze the prefix
+// index, at one or several prefix bit lengths
+func runAnalyze(args []string) error {
+	fs := newFlagSet("analyze")
+	filePath := fs.String("p", defaultDecomposed, "input decompositions, one per line")
+	bits := fs.String("bits", "32", bitLengthsUsage)
+	var exclude levelFilter
+	fs.Var(&exclude, "exclude", excludeUsage)
+	rankPath := fs.String("rank", "", "ranked domains, one per line or an Alexa top-1m CSV (rank,site), to weight the decompositions by popularity (default none)")
+	jsonPath := fs.String("json", "", "output path of the metrics (JSON) (default none)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	bitlengths, err := parseBitLengths(*bits)
+	if err != nil {
+		return err
+	}
+
+	ecrimedecomposed, err := readURLFromFile(*filePath, ^uint(0))
+	if err != nil {
+		return err
+	}
+	ecrimedecomposed = exclude.filter(ecrimedecomposed)
+
+	var weight func(string) float64
+	if *rankPath != "" {
+		ranks, err := readRanks(*rankPath)
+		if err != nil {
+			return err
+		}
+		ranked := 0
+		for _, p := range ecrimedecomposed {
+			if _, ok := patternRank(p, ranks); ok {
+				ranked++
+			}
+		}
+		fmt.Printf("    %d of %d decompositions are on a ranked domain!\n\n", ranked, len(ecrimedecomposed))
+		weight = popularityWeight(ranks)
+	}
+
+	stats := []indexStats{}
+	for _, bitlength := range bitlengths {
+		shortHashIndex := buildShortHashIndex(ecrimedecomposed, bitlength)
+		stats = append(stats, analyzeShortHashIndex(shortHashIndex, bitlength, weight))
+	}
+	printIndexStats(stats)
+	if *jsonPath == "" {
+		return nil
+	}
+	return writeJSON(stats, *jsonPath)
+}
+
+// Module 13: delta encoded max
+func runDelta(args []string) error {
+	fs := newFlagSet("delta")
+	gsb := prefixSourceVar(fs, "sorted GSB hash prefixes")
+	max := fs.Uint64("max", 65534, "largest acceptable delta")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	return deltaCheck(*gsb, *max)
+}
+
+// Module 14: Rice-Golomb delta encoding of a prefix list, such as the "s"
+// array of a FOCAL blacklist or the GSB hash prefixes
+func runRice(args []string) error {
+	fs := newFlagSet("rice")
+	gsb := prefixSourceVar(fs, "GSB hash prefixes")
+	outPath := fs.String("o", "prefixes.rice.json", "output path of the Rice encoding (JSON)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	return riceCompress(*gsb, *outPath)
+}
+
+// Module 15: Decode the A-labels of the hosts of a blacklist and flag the
+// mixed-script, confusable and brand-lookalike hosts (UTS #39)
+func runHomograph(args []string) error {
+	fs := newFlagSet("homograph")
+	inPath := fs.String("p", "../release-json/phishtank.withoutmeta.json", "blacklist: a release-json file (.json) or one URL per line")
+	brandsPath := fs.String("brands", "./top-1m.csv", "brand domains, one per line or an Alexa top-1m CSV (rank,site); empty for none")
+	outPath := fs.String("o", "./homographs.json", "output path of the JSON report")
+	all := fs.Bool("all", false, "report every IDN host, not only the flagged ones")
+	rejectsPath := fs.String("rejects", "", "output path of the URLs that do not parse and of their counts (JSON) (default none)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	return homographReport(*inPath, *brandsPath, *outPath, *all, *rejectsPath)
+}
+
+// Module 16: Re-identify the URL behind a set of co-occurring hash prefixes,
+// ranking the decompositions of the index by the prefixes they explain
+func runReidentify(args []string) error {
+	fs := newFlagSet("reidentify")
+	prefixesPath := fs.String("q", "./hashprefix-cooccur-sample.txt", "co-occurring hash prefixes, one per line")
+	var prefixesEnc prefixEncodingFlag
+	fs.Var(&prefixesEnc, "qenc", "encoding of the co-occurring prefixes: hex, bin, be or le (default hex, le for a .json blacklist)")
+	indexPath := fs.String("index", defaultIndex, "prefix -> decompositions index")
+	decomposedPath := fs.String("decomposed", "", "index these decompositions instead of reading -index")
+	indexEnc := prefixEncodingVar(fs, "enc", indexEncUsage)
+	bitlength := fs.Int("bits", 32, "hash prefix bit length of the index, from 8 to 256")
+	top := fs.Int("top", 20, "number of candidates to print")
+	outPath := fs.String("o", "", "output path of the ranking (JSON) (default none)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *bitlength < minBitLength || *bitlength > maxBitLength {
+		return fmt.Errorf("invalid bit length %d (want %d to %d)", *bitlength, minBitLength, maxBitLength)
+	}
+
+	observed, err := readPrefixes(prefixSource{path: *prefixesPath, enc: prefixesEnc})
+	if err != nil {
+		return err
+	}
+	indexes, err := collisionIndexes(*decomposedPath, *indexPath, *indexEnc, []int{*bitlength})
+	if err != nil {
+		return err
+	}
+	return reidentify(observed, indexes[*bitlength], *bitlength, *top, *indexEnc, *outPath)
+}
+
+// Module 17: Plan the prefixes a provider would plant into its published
+// prefixes to track target URLs and domains, and how visible they would be
+func runInject(args []string) error {
+	fs := newFlagSet("inject")
+	targetsPath := fs.String("t", "./targets.txt", "target URLs or domains, one per line")
+	gsb := prefixSourceVar(fs, "published hash prefixes")
+	corpusPath := fs.String("corpus", "", "URLs the clients visit, one per line, to tell the targets from (default the targets only)")
+	blacklistPath := fs.String("blacklist", "", "blacklisted patterns, the pre-images of -gsb: a release-json file (.json) or one per line (default unknown)")
+	enc := prefixEncodingVar(fs, "enc", "encoding of the reported prefixes: hex, bin, be or le")
+	outPath := fs.String("o", "", "output path of the injection plan (JSON) (default none)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	return inject(*targetsPath, *gsb, *corpusPath, *blacklistPath, *enc, *outPath)
+}
+
+// Module 18: Audit a published prefix list against benign corpora for the
+// prefixes without malicious pre-image and the domains matching several of them
+func runAudit(args []string) error {
+	fs := newFlagSet("audit")
+	gsb := prefixSourceVar(fs, "published hash prefixes")
+	var corpora, blacklists pathList
+	fs.Var(&corpora, "corpus", "benign corpus, repeatable: one URL or domain per line, an Alexa top-1m CSV (.csv) or a browsing history export (.json) (default ./alldomains.txt)")
+	indexPath := fs.String("index", defaultIndex, "malicious prefix -> decompositions index, such as the eCrimeX one; empty for none")
+	indexEnc := prefixEncodingVar(fs, "enc", indexEncUsage)
+	fs.Var(&blacklists, "blacklist", "malicious URLs, repeatable: a release-json file (.json) or one per line")
+	top := fs.Int("top", 20, "number of suspicious prefixes and domains to print")
+	outPath := fs.String("o", "./audit.json", "output path of the audit report (JSON)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if len(corpora) == 0 {
+		corpora = pathList{"./alldomains.txt"}
+	}
+	return auditPrefixes(*gsb, corpora, *indexPath, *indexEnc, blacklists, *top, *outPath)
+}
+
+// Module 19: Search the pre-images of the orphan prefixes, the published
+// prefixes of no known decomposition, among generated candidates
+func runPreimage(args []string) error {
+	fs := newFlagSet("preimage")
+	gsb := prefixSourceVar(fs, "published hash prefixes")
+	indexPath := fs.String("index", defaultIndex, "known prefix -> decompositions index, such as the eCrimeX one; empty for none")
+	indexEnc := prefixEncodingVar(fs, "enc", indexEncUsage)
+	var feeds pathList
+	fs.Var(&feeds, "feed", "feed URLs, repeatable: a release-json file (.json) or one per line; known pre-images and source of the hosts, suffixes and path templates (default ../release-json/phishtank.withoutmeta.json)")
+	wordsPath := fs.String("words", "", "wordlist of the domains generator, one word per line (default no domains generator)")
+	suffixesPath := fs.String("suffixes", "", "public suffixes of the domains generator, one per line (default those of the feed hosts)")
+	labelsPath := fs.String("labels", "", "subdomain labels of the subdomains generator, one per line (default common labels)")
+	gens := fs.String("gen", "domains,subdomains,paths", "generators to run, in order")
+	workers := fs.Int("workers", runtime.NumCPU(), "number of hashing goroutines")
+	checkpointPath := fs.String("checkpoint", "./preimage.checkpoint.json", "checkpoint to resume from and to save to")
+	every := fs.Duration("every", time.Minute, "checkpoint interval")
+	outPath := fs.String("o", "./preimage.json", "output path of the orphan -> pre-images index (JSON)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if len(feeds) == 0 {
+		feeds = pathList{"../release-json/phishtank.withoutmeta.json"}
+	}
+	return preimageSearch(*gsb, *indexPath, *indexEnc, feeds, *wordsPath, *suffixesPath, *labelsPath, strings.Split(*gens, ","), *workers, *checkpointPath, *every, *outPath)
+}
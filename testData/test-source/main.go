@@ -2,58 +2,106 @@ package main
 
 import (
 	"bufio"
-	"database/sql"
 	"encoding/json"
 	"flag"
 	"fmt"
-	"io"
 	"io/ioutil"
 	"log"
+	"net"
 	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
-
-	_ "github.com/mattn/go-sqlite3"
+	"text/tabwriter"
+	"time"
+
+	"../../lib/audit"
+	"../../lib/focal"
+	"../../lib/gsbdb"
+	"../../lib/homograph"
+	"../../lib/lines"
+	"../../lib/oprf"
+	"../../lib/pipeline"
+	"../../lib/preimage"
+	"../../lib/publicsuffix"
+	"../../lib/reident"
 )
 
 // This function is used for reading original URLs from a text file.
 func readURLFromFile(filePath string, numOfURLs uint) ([]string, error) {
+	oriURLs, _, err := readNumberedURLs(filePath, numOfURLs)
+	return oriURLs, err
+}
+
+// readNumberedURLs reads the URLs of the first numOfURLs lines of an input
+// (see lines.Open), and the line number of each. It skips the empty lines and
+// reports the malformed ones, and those that are not valid UTF-8.
+func readNumberedURLs(filePath string, numOfURLs uint) ([]string, []int, error) {
 
 	fmt.Printf(">>> Reading URL list %s ...\n\n", filePath)
 
 	oriURLs := []string{}
+	lineNums := []int{}
 
-	fi, err := os.Open(filePath)
+	fi, err := lines.Open(filePath)
 
 	if err != nil {
 
 		fmt.Printf("Error: %s\n", err)
-		return nil, err
+		return nil, nil, err
 	}
 	defer fi.Close()
 
-	br := bufio.NewReader(fi)
+	r := lines.NewReader(fi)
+	var malformed, warned []error
+	for uint(r.Line()) < numOfURLs && r.Scan() {
 
-	var i uint
-	for i = 0; ; i++ {
+		if r.Text() == "" {
 
-		a, _, c := br.ReadLine()
-		if c == io.EOF || i == numOfURLs {
-
-			break
+			continue
 		}
-
-		if string(a) == "" {
-
+		if err := r.Malformed(); err != nil {
+			malformed = append(malformed, err)
 			continue
 		}
+		if err := r.Warning(); err != nil {
+			warned = append(warned, err)
+		}
 
-		oriURLs = append(oriURLs, string(a))
+		oriURLs = append(oriURLs, r.Text())
+		lineNums = append(lineNums, r.Line())
+	}
+	if err := r.Err(); err != nil {
+		return nil, nil, fmt.Errorf("%s: %v", filePath, err)
 	}
 
 	fmt.Printf("    %d URLs are loaded!\n\n", len(oriURLs))
+	printMalformed(filePath, "malformed lines are skipped", malformed)
+	printMalformed(filePath, "lines are not valid UTF-8, their bytes are kept", warned)
+
+	return oriURLs, lineNums, nil
+}
+
+// maxMalformed is the number of malformed lines printed in full.
+const maxMalformed = 10
 
-	return oriURLs, nil
+// printMalformed prints the malformed lines of an input, or those with a
+// warning, after their number and what becomes of them.
+func printMalformed(path, what string, malformed []error) {
+	if len(malformed) == 0 {
+		return
+	}
+	fmt.Printf("    %d %s!\n", len(malformed), what)
+	for i, err := range malformed {
+		if i == maxMalformed {
+			fmt.Printf("    ...\n")
+			break
+		}
+		fmt.Printf("    %s: %v\n", path, err)
+	}
+	fmt.Println()
 }
 
 func writeLines(lines []string, path string) error {
@@ -71,8 +119,9 @@ func writeLines(lines []string, path string) error {
 }
 
 // This function is used for pre-processing the list of "malicious" URLs, i.e.,
-// obtaining URL patterns (decompositions).
-func getAllUniquePatterns(oriURLs []string) []string {
+// obtaining URL patterns (decompositions). lines are the line numbers of the
+// URLs (nil for their positions); the URLs without patterns go to rejects.
+func getAllUniquePatterns(oriURLs []string, lines []int, rejects *rejectReport) []string {
 
 	fmt.Printf(">>> Computing unique URL patterns (decompositions) ...\n\n")
 
@@ -84,10 +133,10 @@ func getAllUniquePatterns(oriURLs []string) []string {
 
 		curOriURL := oriURLs[i]
 
-		patterns, err := generatePatterns(curOriURL)
+		patterns, err := focal.GeneratePatterns(curOriURL)
 
-		// simply skip a url that cannot obtain valid patterns via GSB api
 		if err != nil {
+			rejects.reject(lineOf(lines, i), curOriURL, err)
 			continue
 		}
 
@@ -105,35 +154,24 @@ func getAllUniquePatterns(oriURLs []string) []string {
 	return uniquePatterns
 }
 
-// This function is used for computing SHA-256 hashs, extracting 32-bit hash
-// prefixs (short hash), and finally inserting them into an Inverted Index
-// (key: hash prefix, value: decomposited URLs that share the hash prefix).
-func buildShortHashIndex(uniquePatterns []string, bitlength int) map[string][]string {
+// This function is used for computing SHA-256 hashs, extracting bitlength-bit
+// hash prefixs (short hash, 8 to 256 bits), and finally inserting them into an
+// Inverted Index (key: hash prefix, value: decomposited URLs that share the
+// hash prefix).
+func buildShortHashIndex(uniquePatterns []string, bitlength int) map[focal.HashPrefix][]string {
 
 	// fmt.Printf(">>> Building inverted index (key: hash prefix, value: decomposited URLs that share the hash prefix) ...\n\n")
 
-	shortHashIndex := make(map[string][]string)
+	shortHashIndex := make(map[focal.HashPrefix][]string)
 
 	// numOfUniquePatterns := len(uniquePatterns)
 
 	for _, up := range uniquePatterns {
 
-		hash := hashFromPattern(up)
-
-		hex := fmt.Sprintf("%x", ([]byte(hash)[0:4]))
-		binary, _ := strconv.ParseUint(hex, 16, 32)
-		sh := fmt.Sprintf("%032b", binary)[0:bitlength]
+		sh := focal.HashFromPattern(up).Truncate(bitlength)
 		//fmt.Printf("256-bit hash:  %x\n32-bit prefix: %s, value: %s\n", hash, sh, up)
 
-		urls, ok := shortHashIndex[sh]
-		if ok {
-
-			urls = append(urls, up)
-			shortHashIndex[sh] = urls
-		} else {
-
-			shortHashIndex[sh] = []string{up}
-		}
+		shortHashIndex[sh] = append(shortHashIndex[sh], up)
 
 		// if ctr != 0 && ctr%(numOfUniquePatterns/10) == 0 {
 
@@ -145,7 +183,20 @@ func buildShortHashIndex(uniquePatterns []string, bitlength int) map[string][]st
 	return shortHashIndex
 }
 
-func testCollisionByURL(index map[string][]string) {
+// reindexShortHashIndex re-keys an index on shorter prefixes of bitlength bits,
+// merging the decompositions whose prefixes become equal.
+func reindexShortHashIndex(index map[focal.HashPrefix][]string, bitlength int) map[focal.HashPrefix][]string {
+	shortHashIndex := make(map[focal.HashPrefix][]string)
+	for k, urls := range index {
+		sh := k.Truncate(bitlength)
+		shortHashIndex[sh] = append(shortHashIndex[sh], urls...)
+	}
+	return shortHashIndex
+}
+
+// testCollisionByURL re-identifies the decompositions of manually input URLs
+// in the index of each bit length of bitlengths.
+func testCollisionByURL(indexes map[int]map[focal.HashPrefix][]string, bitlengths []int) {
 
 	var qURL string
 
@@ -162,68 +213,150 @@ func testCollisionByURL(index map[string][]string) {
 			break
 		}
 
-		fmt.Println("\nRe-identified URLs:")
-
-		hashes, err := generateHashes(qURL)
+		hashes, err := focal.GenerateHashes(qURL)
 		if err != nil {
 
 			log.Fatal("Come with fatal,exit with 1 \n")
 		}
 
-		hasCollision := false
+		for _, bitlength := range bitlengths {
 
-		for k := range hashes {
+			fmt.Printf("\nRe-identified URLs (%d-bit prefixes):\n", bitlength)
 
-			sh := fmt.Sprintf("%x", ([]byte(k))[0:4])
+			index := indexes[bitlength]
+			hasCollision := false
 
-			// output the matched URLs (decompositions)
-			urls, ok := index[sh]
-			if ok {
+			for k := range hashes {
+
+				sh := k.Truncate(bitlength)
 
-				hasCollision = true
-				for _, url := range urls {
-					fmt.Println("   ", url)
+				// output the matched URLs (decompositions)
+				urls, ok := index[sh]
+				if ok {
+
+					hasCollision = true
+					for _, url := range urls {
+						fmt.Println("   ", url)
+					}
 				}
 			}
-		}
 
-		if !hasCollision {
-			fmt.Print("    No collision found!\n")
+			if !hasCollision {
+				fmt.Print("    No collision found!\n")
+			}
 		}
 	}
 }
 
-func analyzeShortHashIndex(index map[string][]string) {
+// indexStats summarizes the re-identification ambiguity of a prefix index.
+type indexStats struct {
+	*reident.AnonymityStats
+	Shared  int `json:"shared"`  // prefixes shared by more than one decomposition
+	Largest int `json:"largest"` // decompositions behind the most shared prefix
+}
+
+// analyzeShortHashIndex measures the anonymity of the decompositions of an
+// index of bitlength-bit prefixes and prints it. If weight is set, the
+// decompositions are also measured as drawn by popularity (see
+// popularityWeight).
+func analyzeShortHashIndex(index map[focal.HashPrefix][]string, bitlength int, weight func(string) float64) indexStats {
 
-	fmt.Printf(">>> Analyzing prefix index ...\n")
+	fmt.Printf(">>> Analyzing %d-bit prefix index ...\n\n", bitlength)
 
-	numOfMatchesMap := make(map[int]int)
+	stats := indexStats{AnonymityStats: reident.Anonymity(index, bitlength, weight)}
+	var sizes []int
+	for k, n := range stats.SetSizes {
+		sizes = append(sizes, k)
+		if k > 1 {
+			stats.Shared += n
+		}
+		if k > stats.Largest {
+			stats.Largest = k
+		}
+	}
+	sort.Ints(sizes)
 
-	for k := range index {
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "    set size\tprefixes\tdecompositions\t")
+	for _, k := range sizes {
+		fmt.Fprintf(w, "    %d\t%d\t%d\t\n", k, stats.SetSizes[k], k*stats.SetSizes[k])
+	}
+	w.Flush()
+	fmt.Println()
 
-		numOfMatches := len(index[k])
+	fmt.Printf("    %d decompositions behind %d prefixes\n", stats.Patterns, stats.Prefixes)
+	fmt.Printf("    mean set size of a prefix: %.4f\n", stats.MeanSetSize)
+	fmt.Printf("    expected set size of a decomposition: %.4f\n", stats.ExpectedSetSize)
+	var ps []string
+	for _, p := range stats.Percentiles {
+		ps = append(ps, fmt.Sprintf("p%g=%d", p.P, p.SetSize))
+	}
+	fmt.Printf("    k-anonymity percentiles: %s\n", strings.Join(ps, " "))
+	fmt.Printf("    uniquely re-identifiable: %.2f%% of the prefixes, %.2f%% of the decompositions\n", 100*stats.UniquePrefixes, 100*stats.UniquePatterns)
+	fmt.Printf("    entropy of a decomposition given its prefix: %.4f bits (Shannon), %.4f bits (min)\n", stats.ShannonEntropy, stats.MinEntropy)
+	if ws := stats.Weighted; ws != nil {
+		fmt.Printf("    weighted by popularity: expected set size %.4f, entropy %.4f bits (Shannon), %.4f bits (min)\n", ws.ExpectedSetSize, ws.ShannonEntropy, ws.MinEntropy)
+	}
+	fmt.Println()
+	return stats
+}
 
-		ctr, ok := numOfMatchesMap[numOfMatches]
-		if ok {
-			ctr++
-			numOfMatchesMap[numOfMatches] = ctr
-		} else {
+// printIndexStats prints one line per bit length, for charting the ambiguity
+// of re-identification against the prefix length.
+func printIndexStats(stats []indexStats) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "bits\tdecompositions\tprefixes\tshared prefixes\tlargest set\tmean set\texpected set\tunique prefixes\tShannon bits\tmin bits\t")
+	for _, s := range stats {
+		fmt.Fprintf(w, "%d\t%d\t%d\t%d\t%d\t%.4f\t%.4f\t%.2f%%\t%.4f\t%.4f\t\n", s.Bits, s.Patterns, s.Prefixes, s.Shared, s.Largest, s.MeanSetSize, s.ExpectedSetSize, 100*s.UniquePrefixes, s.ShannonEntropy, s.MinEntropy)
+	}
+	w.Flush()
+}
 
-			numOfMatchesMap[numOfMatches] = 1
+// popularityWeight returns the Zipf weight of a decomposition: 1/rank of its
+// host, or of its closest parent domain, in ranks, such as the sites of the
+// Alexa top-1m, and 1/(len(ranks)+1) if none is ranked.
+func popularityWeight(ranks map[string]int) func(string) float64 {
+	return func(pattern string) float64 {
+		if rank, ok := patternRank(pattern, ranks); ok {
+			return 1 / float64(rank)
 		}
+		return 1 / float64(len(ranks)+1)
 	}
+}
 
-	// fmt.Println("\n    Done! key - #matches, value - #prefixs")
-	fmt.Println("   ", numOfMatchesMap)
-	fmt.Println()
-	sum := 0
-	valuesum := 0
-	for k, v := range numOfMatchesMap {
-		sum = sum + k*v
-		valuesum = valuesum + v
+// patternRank returns the rank of the host of pattern, or of its closest
+// parent domain, in ranks.
+func patternRank(pattern string, ranks map[string]int) (int, bool) {
+	host := pattern
+	if i := strings.Index(pattern, "/"); i >= 0 {
+		host = pattern[:i]
+	}
+	for {
+		if rank, ok := ranks[host]; ok {
+			return rank, true
+		}
+		i := strings.Index(host, ".")
+		if i < 0 {
+			return 0, false
+		}
+		host = host[i+1:]
 	}
-	fmt.Println("Expectation is ", sum/valuesum)
-	fmt.Println()
+}
+
+// readRanks reads the ranks of the domains of a rank file, as readBrands.
+func readRanks(path string) (map[string]int, error) {
+	brands, err := readBrands(path)
+	if err != nil {
+		return nil, err
+	}
+	ranks := make(map[string]int, len(brands))
+	for _, b := range brands {
+		domain := strings.ToLower(b.Domain)
+		if rank, ok := ranks[domain]; !ok || b.Rank < rank {
+			ranks[domain] = b.Rank
+		}
+	}
+	return ranks, nil
 }
 
 func unique(strSlice []string) []string {
@@ -249,82 +382,388 @@ func findDups(arr []string) {
 	}
 }
 
-func eCrimeDataNorm() {
-	const UintMax = ^uint(0)
+func eCrimeDataNorm(filePath string, opts pipeline.Options, canonPath, dedupPath, decomposedPath, levelsPath string, exclude levelFilter, indexPath string, indexEnc focal.PrefixEncoding, rejectsPath string) error {
+	fmt.Printf(">>> Canonicalizing and decomposing URL list %s on %d workers ...\n\n", filePath, opts.Workers)
+
+	file, err := lines.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	// The outputs are written as the URLs stream by: the canonicalized URLs
+	// to "canonicalized.txt", the deduped ones to "canondeduped.txt", and the
+	// unique decomposed URL prefix/suffix expressions to "decomposed.txt",
+	// and indexed by hash prefix for "hashprefix.json". Only the unique URLs
+	// and decompositions are kept in memory.
+	var outs []*lineFile
+	create := func(path string) *lineFile {
+		if path == "" || err != nil {
+			return nil
+		}
+		var lf *lineFile
+		lf, err = createLineFile(path)
+		outs = append(outs, lf)
+		return lf
+	}
+	canonOut, dedupOut, decomposedOut, levelsOut := create(canonPath), create(dedupPath), create(decomposedPath), create(levelsPath)
+	defer func() {
+		for _, lf := range outs {
+			lf.Close()
+		}
+	}()
+	if err != nil {
+		return err
+	}
+
+	rejects := newRejectReport(filePath, 0)
+	uniqueURLs := make(map[string]bool)
+	uniquePatterns := make(map[string]bool)
+	levelCounts := make(map[publicsuffix.Level]int)
+	kept := 0
+	shortHashIndex := make(map[focal.HashPrefix][]string)
+	stats, err := pipeline.Run(file, opts, func(rec *pipeline.Record) error {
+		if rec.Err != nil {
+			rejects.reject(rec.Line, rec.URL, rec.Err)
+			return nil
+		}
+		if rec.Warning != nil {
+			rejects.warn(rec.Line, rec.URL, rec.Warning)
+		}
+		canonOut.writeLine(rec.Canonical)
+		if !uniqueURLs[rec.Canonical] {
+			uniqueURLs[rec.Canonical] = true
+			dedupOut.writeLine(rec.Canonical)
+		}
+		for i, p := range rec.Patterns {
+			if uniquePatterns[p] {
+				continue
+			}
+			uniquePatterns[p] = true
+			if levelsOut != nil {
+				level := focal.PatternLevel(p, publicsuffix.Default)
+				levelCounts[level]++
+				levelsOut.writeLine(p + "\t" + level.String())
+			}
+			if !exclude.keeps(p) {
+				continue
+			}
+			kept++
+			decomposedOut.writeLine(p)
+			sh := rec.Hashes[i].Truncate(32) // bit length should be less than or equal to 32
+			shortHashIndex[sh] = append(shortHashIndex[sh], p)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %v", filePath, err)
+	}
+	for _, lf := range outs {
+		if err := lf.Close(); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("    %d URLs are processed in %v (%.0f URLs/s)!\n\n", stats.URLs, stats.Elapsed.Round(time.Millisecond), stats.Rate())
+	fmt.Printf("    %d unique URLs are obtained!\n\n", len(uniqueURLs))
+	fmt.Printf("    %d unique URL patterns are obtained!\n\n", len(uniquePatterns))
+	if levelsOut != nil {
+		printLevelCounts(levelCounts)
+	}
+	if exclude.set {
+		fmt.Printf("    %d of %d decompositions are below the %s level!\n\n", kept, len(uniquePatterns), exclude.level)
+	}
+	rejects.setURLs(stats.URLs)
+	if err := rejects.write(rejectsPath); err != nil {
+		return err
+	}
+	return writeIndex(shortHashIndex, indexPath, indexEnc)
+}
 
-	filePath := flag.String("p", "../eCrimeExchange/phish15-19_4300k.txt", "input file path")
-	numOfURLs := flag.Uint("n", UintMax, "number of URLs")
+// printLevelCounts prints how many decompositions there are at each level of
+// the bundled Public Suffix List.
+func printLevelCounts(counts map[publicsuffix.Level]int) {
+	for level := publicsuffix.Subdomain; level <= publicsuffix.PublicSuffix; level++ {
+		fmt.Printf("    %-12s %d decompositions\n", level, counts[level])
+	}
+	fmt.Println()
+}
 
-	flag.Parse()
+// printProgress prints the progress of a pipeline run.
+func printProgress(stats pipeline.Stats) {
+	fmt.Printf("    ... %d lines, %d URLs (%d rejected) in %v, %.0f URLs/s\n", stats.Lines, stats.URLs, stats.Rejected, stats.Elapsed.Round(time.Second), stats.Rate())
+}
 
-	oriURLs, err := readURLFromFile(*filePath, *numOfURLs)
+// lineFile is an output file written line by line. A nil *lineFile discards
+// the lines.
+type lineFile struct {
+	file *os.File
+	w    *bufio.Writer
+}
 
+func createLineFile(path string) (*lineFile, error) {
+	file, err := os.Create(path)
 	if err != nil {
+		return nil, err
+	}
+	return &lineFile{file: file, w: bufio.NewWriter(file)}, nil
+}
 
-		fmt.Printf("Error: %s\n", err)
-		return
+func (lf *lineFile) writeLine(line string) {
+	if lf != nil {
+		lf.w.WriteString(line)
+		lf.w.WriteByte('\n')
 	}
+}
 
-	// Step 1: Canonicalize URLs and write to "canonicalized.txt"
-	for i := 0; i < len(oriURLs); i++ {
-		// !!!!!! refined source code of urls.go to remove the schemes
-		oriURLs[i], _ = canonicalURL(oriURLs[i])
+// Close flushes and closes lf, and reports the first write error. It can be
+// called again.
+func (lf *lineFile) Close() error {
+	if lf == nil || lf.file == nil {
+		return nil
 	}
+	err := lf.w.Flush()
+	if cerr := lf.file.Close(); err == nil {
+		err = cerr
+	}
+	lf.file = nil
+	return err
+}
 
-	writeLines(oriURLs, "./canonicalized.txt")
-	// findDups(oriURLs)
+func writeJSON(v interface{}, path string) error {
+	jsonString, err := json.MarshalIndent(v, "", "    ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, jsonString, 0644)
+}
 
-	// Step 2: Dedup the canonicalized URLs and write to "canondeduped.txt"
-	uniqueURLs := unique(oriURLs)
-	fmt.Printf("    %d unique URLs are obtained!\n\n", len(uniqueURLs))
+// writeIndex writes a prefix -> decompositions index as a JSON object whose
+// keys are the prefixes in the encoding enc.
+func writeIndex(index map[focal.HashPrefix][]string, path string, enc focal.PrefixEncoding) error {
+	encoded := make(map[string][]string, len(index))
+	for k, v := range index {
+		encoded[enc.Encode(k)] = v
+	}
+	return writeJSON(encoded, path)
+}
 
-	writeLines(uniqueURLs, "./canondeduped.txt")
+// readIndex reads a prefix -> decompositions index written by writeIndex with
+// the encoding enc.
+func readIndex(path string, enc focal.PrefixEncoding) (map[focal.HashPrefix][]string, error) {
+	byteValue, err := lines.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	fmt.Println("Successfully Opened " + path)
+	encoded := make(map[string][]string)
+	if err := json.Unmarshal(byteValue, &encoded); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	index := make(map[focal.HashPrefix][]string, len(encoded))
+	for k, v := range encoded {
+		h, err := enc.Decode(k)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", path, err)
+		}
+		index[h] = v
+	}
+	return index, nil
+}
 
-	// Step 2: Find unique decomposed URL prefix/suffix expressions and its corresponding hash prefixes,
-	// build an index of hashprefix -> Array[decompositions], write to "hashprefix.json"
-	uniquePatterns := getAllUniquePatterns(oriURLs)
-	writeLines(uniquePatterns, "./decomposed.txt")
-	shortHashIndex := buildShortHashIndex(uniquePatterns, 32) // bit length should be less than or equal to 32
-	jsonString, err := json.MarshalIndent(shortHashIndex, "", "    ")
-	_ = ioutil.WriteFile("hashprefix3.json", jsonString, 0644)
+// readSQLite returns the hash prefixes of the lists of a GSB sqlite database
+// selected by filter.
+func readSQLite(dbPath string, filter gsbdb.Filter) ([]focal.HashPrefix, error) {
+	database, err := gsbdb.Open(dbPath)
+	if err != nil {
+		return nil, err
+	}
+	defer database.Close()
+
+	hashprefixes := []focal.HashPrefix{}
+	err = database.Prefixes(filter, func(h focal.HashPrefix) error {
+		hashprefixes = append(hashprefixes, h)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	fmt.Printf("GSB hash prefix sqlite db has %d items.\n", len(hashprefixes))
+	return hashprefixes, nil
 }
 
-func readJsontoMap(str string) map[string][]string {
-	jsonFile, err := os.Open(str)
-	// if we os.Open returns an error then handle it
+// printGSBLists prints the threat lists of a GSB sqlite database, to choose
+// the -threat, -platform and -entry of the other commands.
+func printGSBLists(dbPath string) error {
+	database, err := gsbdb.Open(dbPath)
+	if err != nil {
+		return err
+	}
+	defer database.Close()
+
+	lists, err := database.Lists()
 	if err != nil {
-		fmt.Println(err)
+		return err
 	}
-	fmt.Println("Successfully Opened " + str)
-	// defer the closing of our jsonFile so that we can parse it later on
-	defer jsonFile.Close()
-	byteValue, _ := ioutil.ReadAll(jsonFile)
-	shortHashIndex := make(map[string][]string)
-	if err := json.Unmarshal(byteValue, &shortHashIndex); err != nil {
-		fmt.Println(err)
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "threat\tplatform\tentry\tprefixes")
+	for _, l := range lists {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\n", l.ThreatType, l.PlatformType, l.ThreatEntryType, l.Prefixes)
 	}
-	return shortHashIndex
+	return w.Flush()
+}
+
+// writePrefixes writes hash prefixes line by line in the encoding enc.
+func writePrefixes(prefixes []focal.HashPrefix, path string, enc focal.PrefixEncoding) error {
+	lines := make([]string, len(prefixes))
+	for i, h := range prefixes {
+		lines[i] = enc.Encode(h)
+	}
+	return writeLines(lines, path)
+}
+
+// prefixSource is a list of hash prefixes: one prefix per line in the
+// encoding enc (such as the output of writePrefixes), a published FOCAL
+// blacklist (.json) whose "s" array holds little-endian prefixes, or the lists
+// of a GSB sqlite database (.db) selected by filter.
+type prefixSource struct {
+	path   string
+	enc    prefixEncodingFlag
+	filter gsbdb.Filter
+}
+
+func (src prefixSource) isDB() bool {
+	return strings.HasSuffix(src.path, ".db")
+}
+
+func (src prefixSource) isBlacklist() bool {
+	return strings.HasSuffix(src.path, ".json")
+}
+
+// encoding returns the encoding of the prefixes of src, and the one reports
+// use for them: le for a FOCAL blacklist unless another one is set.
+func (src prefixSource) encoding() focal.PrefixEncoding {
+	if src.isBlacklist() && !src.enc.set {
+		return focal.LittleEndianPrefix
+	}
+	return src.enc.PrefixEncoding
+}
+
+// readPrefixes reads the hash prefixes of src.
+func readPrefixes(src prefixSource) ([]focal.HashPrefix, error) {
+	path, enc := src.path, src.encoding()
+	if src.isDB() {
+		return readSQLite(path, src.filter)
+	}
+
+	var encoded []string
+	if src.isBlacklist() {
+		if enc != focal.LittleEndianPrefix {
+			return nil, fmt.Errorf("%s: FOCAL blacklists hold %s prefixes, not %s", path, focal.LittleEndianPrefix, enc)
+		}
+		byteValue, err := lines.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		var blacklist struct {
+			S []json.Number `json:"s"`
+		}
+		if err := json.Unmarshal(byteValue, &blacklist); err != nil {
+			return nil, fmt.Errorf("%s: %v", path, err)
+		}
+		for _, s := range blacklist.S {
+			encoded = append(encoded, s.String())
+		}
+	} else {
+		lines, err := readURLFromFile(path, ^uint(0))
+		if err != nil {
+			return nil, err
+		}
+		encoded = lines
+	}
+
+	prefixes := make([]focal.HashPrefix, 0, len(encoded))
+	for _, s := range encoded {
+		h, err := enc.Decode(s)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", path, err)
+		}
+		if len(h) < focal.MinHashPrefixLength {
+			return nil, fmt.Errorf("%s: prefix %q is shorter than %d bytes", path, s, focal.MinHashPrefixLength)
+		}
+		prefixes = append(prefixes, h)
+	}
+	return prefixes, nil
+}
+
+// readPrefixSet loads the hash prefixes of src into a set. Duplicate prefixes
+// are dropped, overlapping ones are an error. For a database, only the
+// prefixes overlapping within a threat list are: across lists, the shorter
+// prefix is kept (see gsbdb.DB.HashSet).
+func readPrefixSet(src prefixSource) (*focal.HashSet, error) {
+	if src.isDB() {
+		database, err := gsbdb.Open(src.path)
+		if err != nil {
+			return nil, err
+		}
+		defer database.Close()
+		set, err := database.HashSet(src.filter)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Printf("GSB hash prefix sqlite db has %d unique items.\n", set.Len())
+		return set, nil
+	}
+
+	gsbhashprefixes, err := readPrefixes(src)
+	if err != nil {
+		return nil, err
+	}
+	phs := focal.HashPrefixes(gsbhashprefixes)
+	phs.Sort()
+	deduped := phs[:0]
+	for i, h := range phs {
+		if i == 0 || h != phs[i-1] {
+			deduped = append(deduped, h)
+		}
+	}
+	if err := deduped.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %v", src.path, err)
+	}
+	return focal.NewHashSet(deduped), nil
 }
 
-func readSQLite() []string {
-	database, _ := sql.Open("sqlite3", "./gsb_v4.db")
-	rows, _ := database.Query("SELECT hex(value) FROM hash_prefix WHERE platform_type = 'ANY_PLATFORM'")
+// readHistoryURLs returns the unique URLs of a Chrome/Google Takeout browsing
+// history export.
+func readHistoryURLs(path string) ([]string, error) {
+	byteValue, err := lines.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	fmt.Println("Successfully Opened " + path)
+	var items []Item
+	if err := json.Unmarshal(byteValue, &items); err != nil {
+		return nil, err
+	}
 
-	hashprefixstrings := []string{}
-	var hashprefix string
-	for rows.Next() {
-		rows.Scan(&hashprefix)
-		hashprefixstrings = append(hashprefixstrings, strings.ToLower(hashprefix))
+	historydup := []string{}
+	for _, ele := range items {
+		historydup = append(historydup, ele.Urlhistory)
 	}
-	fmt.Printf("GSB hash prefix sqlite db has %d items.\n", len(hashprefixstrings))
-	return hashprefixstrings
+	return unique(historydup), nil
 }
 
-func gsbmatchecrime() {
-	gsbhashprefixes, _ := readURLFromFile("./GSBhashprefixes.txt", ^uint(0))
-	ecrimemaps := readJsontoMap("hashprefix.json")
+func gsbmatchecrime(gsb prefixSource, indexPath string, indexEnc focal.PrefixEncoding, outPath string) error {
+	gsbhashprefixes, err := readPrefixes(gsb)
+	if err != nil {
+		return err
+	}
+	ecrimemaps, err := readIndex(indexPath, indexEnc)
+	if err != nil {
+		return err
+	}
 	cnt := 0
-	matchedkeys := []string{}
+	matchedkeys := []focal.HashPrefix{}
 	for i := 0; i < len(gsbhashprefixes); i++ {
 		item := gsbhashprefixes[i]
 		_, ok := ecrimemaps[item]
@@ -333,7 +772,7 @@ func gsbmatchecrime() {
 			matchedkeys = append(matchedkeys, item)
 		}
 	}
-	fmt.Printf("%d of 1636843 GSB URLs (1117196 GSB hash prefixes) match the eCrimeX Json file results.\n", cnt)
+	fmt.Printf("%d of %d GSB hash prefixes match the eCrimeX Json file results.\n", cnt, len(gsbhashprefixes))
 
 	// subset := make(map[string][]string)
 	subset := []string{}
@@ -343,75 +782,88 @@ func gsbmatchecrime() {
 	}
 	// jsonString, _ := json.MarshalIndent(subset, "", "    ")
 	// _ = ioutil.WriteFile("gsbmatchecrime.json", jsonString, 0644)
-	writeLines(subset, "./smartscreentest.txt")
+	return writeLines(subset, outPath)
 }
 
-func ecrimematchegsb() {
-	gsbhashprefixes, _ := readURLFromFile("./GSBhashprefixes.txt", ^uint(0))
-
-	// ecrimemaps := readJsontoMap("hashprefix.json")
-	ecrime, _ := readURLFromFile("./okstatus3.txt", ^uint(0))
-	uniquePatterns := getAllUniquePatterns(ecrime)
-	ecrimemaps := buildShortHashIndex(uniquePatterns, 32)
+func ecrimematchegsb(gsb prefixSource, ecrimePath, outPath string, outEnc focal.PrefixEncoding) error {
+	set, err := readPrefixSet(gsb)
+	if err != nil {
+		return err
+	}
 
-	set := make(map[string]bool)
-	for _, v := range gsbhashprefixes {
-		binary, _ := strconv.ParseUint(v, 16, 32)
-		sh := fmt.Sprintf("%032b", binary)[0:32]
-		set[sh] = true
+	// ecrimemaps, _ := readIndex("hashprefix.json", focal.HexPrefix)
+	ecrime, lines, err := readNumberedURLs(ecrimePath, ^uint(0))
+	if err != nil {
+		return err
 	}
+	rejects := newRejectReport(ecrimePath, len(ecrime))
+	uniquePatterns := getAllUniquePatterns(ecrime, lines, rejects)
+	rejects.print()
+	ecrimemaps := buildShortHashIndex(uniquePatterns, 32)
 
 	cnt := 0
-	matchedkeys := []string{}
+	matchedkeys := []focal.HashPrefix{}
 	for key := range ecrimemaps {
-		if set[key] == true {
+		if set.Lookup(key) > 0 {
 			cnt++
 			matchedkeys = append(matchedkeys, key)
 		}
 	}
-	fmt.Printf("%d of 2140183 eCrimeX URLs (5472965 GSB hash prefixes) match the GSB hash prefix results.\n", cnt)
+	fmt.Printf("%d of %d eCrimeX URLs (%d hash prefixes) match the GSB hash prefix results.\n", cnt, len(ecrime), len(ecrimemaps))
 
-	subset := make(map[string][]string)
+	subset := make(map[focal.HashPrefix][]string)
 	for _, key := range matchedkeys {
 		subset[key] = ecrimemaps[key]
 	}
-	jsonString, _ := json.MarshalIndent(subset, "", "    ")
-	_ = ioutil.WriteFile("ecrimematchegsb.json", jsonString, 0644)
+	return writeIndex(subset, outPath, outEnc)
 }
 
-func shallalisttrack() {
-	shallalist, _ := readURLFromFile("./alldomains.txt", ^uint(0))
-	gsbhashprefixes, _ := readURLFromFile("./GSBhashprefixes.txt", ^uint(0))
-	gsbhashprefixesset := make(map[string]bool)
-	for _, v := range gsbhashprefixes {
-		gsbhashprefixesset[v] = true
+func shallalisttrack(listPath string, gsb prefixSource, indexPath string, indexEnc focal.PrefixEncoding, suspiciousPath, verifyPath, rejectsPath string) error {
+	shallalist, lineNums, err := readNumberedURLs(listPath, ^uint(0))
+	if err != nil {
+		return err
+	}
+	gsbhashprefixesset, err := readPrefixSet(gsb)
+	if err != nil {
+		return err
 	}
 
 	// Step 1: Canonicalize URLs
-	for i := 0; i < len(shallalist); i++ {
-		// !!!!!! refined source code of urls.go to remove the schemes
-		shallalist[i], _ = canonicalURL(shallalist[i])
-	}
+	rejects := newRejectReport(listPath, len(shallalist))
+	shallalist, lineNums = canonicalizeURLs(shallalist, lineNums, rejects)
 	fmt.Printf("    %d items from shallalist are obtained!\n\n", len(shallalist))
 
-	uniqueItems := unique(shallalist)
+	// The unique items keep the line of their first occurrence.
+	uniqueItems, uniqueLines := []string{}, []int{}
+	seen := make(map[string]bool)
+	for i, item := range shallalist {
+		if !seen[item] {
+			seen[item] = true
+			uniqueItems = append(uniqueItems, item)
+			uniqueLines = append(uniqueLines, lineNums[i])
+		}
+	}
 	fmt.Printf("    %d unique items are obtained!\n\n", len(uniqueItems))
 
-	eCrimeIndex := readJsontoMap("hashprefix.json")
+	eCrimeIndex, err := readIndex(indexPath, indexEnc)
+	if err != nil {
+		return err
+	}
 	suspiciousList := []string{}
 	verifyList := []string{}
 	for i := 0; i < len(uniqueItems); i++ {
-		// !!!!!! refined source code of urls.go to remove the schemes
 		hitcnt := 0
 		verifycnt := 0
-		hashes, _ := generateHashes(uniqueItems[i])
+		hashes, err := focal.GenerateHashes(uniqueItems[i])
+		if err != nil {
+			rejects.reject(uniqueLines[i], uniqueItems[i], err)
+			continue
+		}
 		for hash := range hashes {
-			sh := fmt.Sprintf("%x", ([]byte(hash))[0:4])
-
-			if gsbhashprefixesset[sh] == true {
+			if gsbhashprefixesset.Lookup(hash) > 0 {
 				hitcnt++
 			}
-			_, ok := eCrimeIndex[sh]
+			_, ok := eCrimeIndex[hash.Short()]
 			if ok {
 				verifycnt++
 			}
@@ -423,38 +875,70 @@ func shallalisttrack() {
 			verifyList = append(verifyList, uniqueItems[i])
 		}
 	}
-	writeLines(suspiciousList, "./suspicious.txt")
-	writeLines(verifyList, "./verify.txt")
+	if err := rejects.write(rejectsPath); err != nil {
+		return err
+	}
+	if err := writeLines(suspiciousList, suspiciousPath); err != nil {
+		return err
+	}
+	return writeLines(verifyList, verifyPath)
 }
 
-func alexaDataNorm() {
-	const UintMax = ^uint(0)
-
-	filePath := flag.String("p", "./top-1m.csv", "input file path")
-	numOfURLs := flag.Uint("n", UintMax, "number of URLs")
-
-	flag.Parse()
-
-	sites, err := readURLFromFile(*filePath, *numOfURLs)
+func alexaDataNorm(filePath string, numOfURLs uint, indexPath string, indexEnc focal.PrefixEncoding, rejectsPath string) error {
+	lines, lineNums, err := readNumberedURLs(filePath, numOfURLs)
 
 	if err != nil {
-		fmt.Printf("Error: %s\n", err)
-		return
+		return err
 	}
 
-	// Step 1: Canonicalize URLs and write to "canonicalized.txt"
-	for i := 0; i < len(sites); i++ {
-		site := strings.Split(sites[i], ",")[1]
-		// !!!!!! refined source code of urls.go to remove the schemes
-		sites[i], _ = canonicalURL(site)
+	// Step 1: Canonicalize the sites of the "rank,site" lines
+	rejects := newRejectReport(filePath, len(lines))
+	var sites []string
+	var siteLines []int
+	for i, line := range lines {
+		fields := strings.Split(line, ",")
+		if len(fields) < 2 || fields[1] == "" {
+			rejects.add(lineNums[i], line, reasonMalformed, fmt.Errorf("want rank,site"))
+			continue
+		}
+		sites = append(sites, fields[1])
+		siteLines = append(siteLines, lineNums[i])
 	}
+	sites, siteLines = canonicalizeURLs(sites, siteLines, rejects)
 
 	// Step 2: Find unique decomposed URL prefix/suffix expressions and its corresponding hash prefixes,
 	// build an index of hashprefix -> Array[decompositions], write to "hashprefix.json"
-	uniquePatterns := getAllUniquePatterns(sites)
+	uniquePatterns := getAllUniquePatterns(sites, siteLines, rejects)
+	if err := rejects.write(rejectsPath); err != nil {
+		return err
+	}
 	shortHashIndex := buildShortHashIndex(uniquePatterns, 32)
-	jsonString, err := json.MarshalIndent(shortHashIndex, "", "    ")
-	_ = ioutil.WriteFile("alex.json", jsonString, 0644)
+	return writeIndex(shortHashIndex, indexPath, indexEnc)
+}
+
+// alexaTrack lists the Alexa decompositions (as indexed by alexaDataNorm)
+// whose hash prefix is in the GSB prefix list.
+func alexaTrack(alexaPath string, alexaEnc focal.PrefixEncoding, gsb prefixSource, outPath string) error {
+	alexa, err := readIndex(alexaPath, alexaEnc)
+	if err != nil {
+		return err
+	}
+	gsbhashprefixesset, err := readPrefixSet(gsb)
+	if err != nil {
+		return err
+	}
+	sitestracked := []string{}
+	for item := range alexa {
+		if gsbhashprefixesset.Lookup(item) > 0 {
+			for cnt, ele := range alexa[item] {
+				sitestracked = append(sitestracked, ele)
+				if cnt >= 1 {
+					fmt.Println("hash prefix: ", gsb.encoding().Encode(item))
+				}
+			}
+		}
+	}
+	return writeLines(sitestracked, outPath)
 }
 
 // Item : json object to Golang struct
@@ -467,46 +951,31 @@ type Item struct {
 	Timeusec       int64  `json:"time_usec"`
 }
 
-func browsingHistoryNorm() {
-	jsonFile, err := os.Open("BrowserHistory.json")
-	// if we os.Open returns an error then handle it
+func browsingHistoryNorm(historyPath string, gsb prefixSource, outPath, rejectsPath string) error {
+	history, err := readHistoryURLs(historyPath)
 	if err != nil {
-		fmt.Println(err)
+		return err
 	}
-	fmt.Println("Successfully Opened ")
-	// defer the closing of our jsonFile so that we can parse it later on
-	defer jsonFile.Close()
-	byteValue, _ := ioutil.ReadAll(jsonFile)
-	var items []Item
-	json.Unmarshal(byteValue, &items)
+	fmt.Printf("Total number of %d browsing history items.\n\n", len(history))
 
-	historydup := []string{}
-	for _, ele := range items {
-		historydup = append(historydup, ele.Urlhistory)
-	}
+	rejects := newRejectReport(historyPath, len(history))
+	history, historyLines := canonicalizeURLs(history, nil, rejects)
 
-	history := unique(historydup)
-	fmt.Printf("Total number of %d browsing history items.\n\n", len(history))
-
-	for i := 0; i < len(history); i++ {
-		// !!!!!! refined source code of urls.go to remove the schemes
-		history[i], _ = canonicalURL(history[i])
-	}
-
-	gsbhashprefixes, _ := readURLFromFile("./GSBhashprefixes.txt", ^uint(0))
-	gsbhashprefixesset := make(map[string]bool)
-	for _, v := range gsbhashprefixes {
-		gsbhashprefixesset[v] = true
+	gsbhashprefixesset, err := readPrefixSet(gsb)
+	if err != nil {
+		return err
 	}
 
 	hits := []string{}
 	for i := 0; i < len(history); i++ {
-		// !!!!!! refined source code of urls.go to remove the schemes
 		hitcnt := 0
-		hashes, _ := generateHashes(history[i])
+		hashes, err := focal.GenerateHashes(history[i])
+		if err != nil {
+			rejects.reject(historyLines[i], history[i], err)
+			continue
+		}
 		for hash := range hashes {
-			sh := fmt.Sprintf("%x", ([]byte(hash))[0:4])
-			if gsbhashprefixesset[sh] == true {
+			if gsbhashprefixesset.Lookup(hash) > 0 {
 				hitcnt++
 			}
 		}
@@ -514,8 +983,11 @@ func browsingHistoryNorm() {
 			hits = append(hits, history[i])
 		}
 	}
+	if err := rejects.write(rejectsPath); err != nil {
+		return err
+	}
 
-	writeLines(hits, "historyhits-Leixu.txt")
+	return writeLines(hits, outPath)
 
 	// uniquePatterns := getAllUniquePatterns(hits)
 	// shortHashIndex := buildShortHashIndex(uniquePatterns)
@@ -523,82 +995,63 @@ func browsingHistoryNorm() {
 	// _ = ioutil.WriteFile("historyindex.json", jsonString, 0644)
 }
 
-func collisionTest() {
-	gsbhashprefixes, _ := readURLFromFile("./GSBhashprefixes.txt", ^uint(0))
-	gsbhashprefixesset := make(map[string]bool)
-	for _, v := range gsbhashprefixes {
-		gsbhashprefixesset[v] = true
+// collisionTest prints the history URLs whose decompositions hit the GSB
+// prefix list, with the prefix in the encoding of the list.
+func collisionTest(historyPath string, gsb prefixSource, rejectsPath string) error {
+	gsbhashprefixesset, err := readPrefixSet(gsb)
+	if err != nil {
+		return err
 	}
 	// potentialcollision, _ := readURLFromFile("./onlinetest3.txt", ^uint(0))
 	// for _, item := range potentialcollision {
-	// 	hashes, _ := generateHashes(item)
+	// 	hashes, _ := focal.GenerateHashes(item)
 	// 	for hash := range hashes {
-	// 		sh := fmt.Sprintf("%x", ([]byte(hash))[0:4])
-	// 		if gsbhashprefixesset[sh] == true {
-	// 			fmt.Println(item + ", " + hashes[hash] + ", " + sh)
+	// 		if n := gsbhashprefixesset.Lookup(hash); n > 0 {
+	// 			fmt.Println(item + ", " + hashes[hash] + ", " + gsb.encoding().Encode(hash[:n]))
 	// 		}
 	// 	}
 	// }
-	jsonFile, err := os.Open("BrowserHistory-Louis.json")
-	// if we os.Open returns an error then handle it
+	history, err := readHistoryURLs(historyPath)
 	if err != nil {
-		fmt.Println(err)
-	}
-	fmt.Println("Successfully Opened ")
-	// defer the closing of our jsonFile so that we can parse it later on
-	defer jsonFile.Close()
-	byteValue, _ := ioutil.ReadAll(jsonFile)
-	var items []Item
-	json.Unmarshal(byteValue, &items)
-
-	historydup := []string{}
-	for _, ele := range items {
-		historydup = append(historydup, ele.Urlhistory)
+		return err
 	}
-
-	history := unique(historydup)
-	for _, item := range history {
-		hashes, _ := generateHashes(item)
+	rejects := newRejectReport(historyPath, len(history))
+	for i, item := range history {
+		hashes, err := focal.GenerateHashes(item)
+		if err != nil {
+			rejects.reject(lineOf(nil, i), item, err)
+			continue
+		}
 		for hash := range hashes {
-			sh := fmt.Sprintf("%x", ([]byte(hash))[0:4])
-			if gsbhashprefixesset[sh] == true {
-				fmt.Println(item + ", " + hashes[hash] + ", " + sh)
+			if n := gsbhashprefixesset.Lookup(hash); n > 0 {
+				fmt.Println(item + ", " + hashes[hash] + ", " + gsb.encoding().Encode(hash[:n]))
 			}
 		}
 	}
+	return rejects.write(rejectsPath)
 }
 
-func collisionTest2() {
-	ecrimeprefixes := readJsontoMap("hashprefix.json")
-	// shallalist, _ := readURLFromFile("./shallalist.txt", ^uint(0))
-
-	jsonFile, err := os.Open("BrowserHistory.json")
-	// if we os.Open returns an error then handle it
+// collisionTest2 writes the history URLs whose decompositions hit the eCrimeX
+// index, with the prefix in the encoding of the index.
+func collisionTest2(historyPath, indexPath string, indexEnc focal.PrefixEncoding, outPath, rejectsPath string) error {
+	ecrimeprefixes, err := readIndex(indexPath, indexEnc)
 	if err != nil {
-		fmt.Println(err)
+		return err
 	}
-	fmt.Println("Successfully Opened ")
-	// defer the closing of our jsonFile so that we can parse it later on
-	defer jsonFile.Close()
-	byteValue, _ := ioutil.ReadAll(jsonFile)
-	var items []Item
-	json.Unmarshal(byteValue, &items)
+	// shallalist, _ := readURLFromFile("./shallalist.txt", ^uint(0))
 
-	historydup := []string{}
-	for _, ele := range items {
-		historydup = append(historydup, ele.Urlhistory)
+	history, err := readHistoryURLs(historyPath)
+	if err != nil {
+		return err
 	}
 
-	history := unique(historydup)
-
 	// cnt := 0
 	// matchShalla := []string{}
 	// for i := 0; i < len(shallalist); i++ {
 	// 	item := shallalist[i]
-	// 	hashes, _ := generateHashes(item)
+	// 	hashes, _ := focal.GenerateHashes(item)
 	// 	for hash := range hashes {
-	// 		sh := fmt.Sprintf("%x", ([]byte(hash))[0:4])
-	// 		_, ok := ecrimeprefixes[sh]
+	// 		_, ok := ecrimeprefixes[hash.Short()]
 	// 		if ok == true {
 	// 			cnt++
 	// 			matchShalla = append(matchShalla, item)
@@ -609,121 +1062,805 @@ func collisionTest2() {
 
 	cnt := 0
 	matchHistory := []string{}
+	rejects := newRejectReport(historyPath, len(history))
 	for i := 0; i < len(history); i++ {
 		item := history[i]
-		hashes, _ := generateHashes(item)
+		hashes, err := focal.GenerateHashes(item)
+		if err != nil {
+			rejects.reject(lineOf(nil, i), item, err)
+			continue
+		}
 		for hash := range hashes {
-			sh := fmt.Sprintf("%x", ([]byte(hash))[0:4])
+			sh := hash.Short()
 			_, ok := ecrimeprefixes[sh]
 			if ok == true {
 				cnt++
-				matchHistory = append(matchHistory, item+", "+hashes[hash]+", "+sh)
+				matchHistory = append(matchHistory, item+", "+hashes[hash]+", "+indexEnc.Encode(sh))
 			}
 		}
 	}
 	fmt.Printf("%d matched.\n", cnt)
-	writeLines(matchHistory, "groundtruth.txt")
+	if err := rejects.write(rejectsPath); err != nil {
+		return err
+	}
+	return writeLines(matchHistory, outPath)
 }
 
-func uniqueHistoryHashPrefixes() {
-	jsonFile, err := os.Open("BrowserHistory.json")
-	// if we os.Open returns an error then handle it
+func uniqueHistoryHashPrefixes(historyPath, rejectsPath string) error {
+	history, err := readHistoryURLs(historyPath)
 	if err != nil {
-		fmt.Println(err)
+		return err
 	}
-	fmt.Println("Successfully Opened ")
-	// defer the closing of our jsonFile so that we can parse it later on
-	defer jsonFile.Close()
-	byteValue, _ := ioutil.ReadAll(jsonFile)
-	var items []Item
-	json.Unmarshal(byteValue, &items)
 
-	historydup := []string{}
-	for _, ele := range items {
-		historydup = append(historydup, ele.Urlhistory)
+	rejects := newRejectReport(historyPath, len(history))
+	uniquePatterns := getAllUniquePatterns(history, nil, rejects)
+	if err := rejects.write(rejectsPath); err != nil {
+		return err
 	}
-	history := unique(historydup)
-
-	uniquePatterns := getAllUniquePatterns(history)
 	shortHashIndex := buildShortHashIndex(uniquePatterns, 32)
-	// jsonString, err := json.MarshalIndent(shortHashIndex, "", "    ")
-	// _ = ioutil.WriteFile("browsehashprefixes.json", jsonString, 0644)
-	analyzeShortHashIndex(shortHashIndex)
+	// writeIndex(shortHashIndex, "browsehashprefixes.json", focal.HexPrefix)
+	analyzeShortHashIndex(shortHashIndex, 32, nil)
+	return nil
 }
 
-func main() {
-	// Module 1: Normalize (dedup) phishing URLs from eCrimeX and write down results
-	// eCrimeDataNorm()
-
-	// Module 2: Load Json file of prefix -> decomposition for eCrimeX's data and analyze the results
-	ecrimedecomposed, _ := readURLFromFile("./decomposed.txt", ^uint(0))
-	shortHashIndex := buildShortHashIndex(ecrimedecomposed, 32)
-	analyzeShortHashIndex(shortHashIndex)
-	// for i := 18; i <= 32; i = i + 2 {
-	// 	fmt.Printf("Hash prefix bit length is %d\n", i)
-	// 	shortHashIndex := buildShortHashIndex(ecrimedecomposed, i)
-	// 	analyzeShortHashIndex(shortHashIndex)
-	// }
+// deltaCheck reports whether the deltas between consecutive (sorted) GSB hash
+// prefixes fit in the given bound. Deltas are taken between the big-endian
+// values of the prefixes, whatever the encoding of the list.
+func deltaCheck(gsb prefixSource, max uint64) error {
+	gsbhashprefixes, err := readPrefixes(gsb)
+	if err != nil {
+		return err
+	}
+	exceeded := 0
+	for i := 0; i < len(gsbhashprefixes)-1; i++ {
+		binary := uint64(gsbhashprefixes[i].Uint32BE())
+		binary2 := uint64(gsbhashprefixes[i+1].Uint32BE())
+		diff := binary2 - binary
+		if diff > max {
+			exceeded++
+		}
+	}
+	fmt.Printf("%d of %d deltas exceed %d.\n", exceeded, len(gsbhashprefixes)-1, max)
+	return nil
+}
 
-	// Module 3: Load Json file of prefix -> decomposition for eCrimeX's data and test collision for mannually input results
-	// testCollisionByURL(readJsontoMap("hashprefix.json"))
+// riceCompress Rice encodes the 4-byte prefixes of a prefix list, writes the
+// encoding as JSON and compares its size with the raw prefixes and with the
+// JSON "s" array buildSecBlackList.js publishes.
+func riceCompress(gsb prefixSource, outPath string) error {
+	prefixes, err := readPrefixes(gsb)
+	if err != nil {
+		return err
+	}
+	short := focal.HashPrefixes{}
+	for _, h := range prefixes {
+		if len(h) == focal.MinHashPrefixLength {
+			short = append(short, h)
+		}
+	}
+	if longer := len(prefixes) - len(short); longer > 0 {
+		fmt.Printf("%d prefixes longer than 4 bytes are not Rice encoded.\n", longer)
+	}
 
-	// Module 4: Read SQLite db of GSB hash prefixes and write down line by line to a text file
-	// writeLines(readSQLite(), "./GSBhashprefixes.txt")
+	rice, err := focal.EncodeRicePrefixes(short)
+	if err != nil {
+		return err
+	}
+	decoded, err := focal.DecodeRicePrefixes(rice)
+	if err != nil {
+		return err
+	}
+	values := make([]uint32, len(decoded))
+	for i, h := range decoded {
+		values[i] = h.Uint32LE()
+	}
+	jsonArray, err := json.Marshal(values)
+	if err != nil {
+		return err
+	}
+	riceJSON, err := json.Marshal(rice)
+	if err != nil {
+		return err
+	}
 
-	// Module 5: Calculate how many of 1117196 GSB hash prefixes match the eCrimeX Json file results
-	// Upper bound & Lower bound (w. & w.o. URLs with same hash prefixes)
-	// Also to analyze all the hash prefixes of GSB that we can ``translate'' to URLs with eCrimeX data (observe patterns, all domains, aka how may ends with '/'?)
-	// gsbmatchecrime()
+	raw := 4 * len(values)
+	fmt.Printf("%d unique 4-byte prefixes, Rice parameter k = %d.\n", len(values), rice.RiceParameter)
+	fmt.Printf("    raw:        %10d bytes\n", raw)
+	fmt.Printf("    JSON array: %10d bytes\n", len(jsonArray))
+	fmt.Printf("    Rice:       %10d bytes (%.1f%% of raw, %.1f%% of JSON)\n",
+		len(rice.EncodedData), 100*float64(len(rice.EncodedData))/float64(raw), 100*float64(len(rice.EncodedData))/float64(len(jsonArray)))
+	fmt.Printf("    Rice JSON:  %10d bytes (%.1f%% of JSON)\n", len(riceJSON), 100*float64(len(riceJSON))/float64(len(jsonArray)))
+	return ioutil.WriteFile(outPath, riceJSON, 0644)
+}
 
-	// Module 6: Calculate how many of 2140183 eCrime URLs match the GSB hash prefixes
-	// Upper bound & Lower bound (w. & w.o. URLs with same hash prefixes)
-	// ecrimematchegsb()
+// readBlacklistURLs reads the URLs of a release-json blacklist (.json), or a
+// list of URLs, one per line.
+func readBlacklistURLs(path string) ([]string, error) {
+	urls, _, err := readNumberedBlacklist(path)
+	return urls, err
+}
 
-	// Module 7: Read shallalist.txt and see any 2 hits in GSB hash prefixes
-	// shallalisttrack()
+// readNumberedBlacklist reads the URLs of a blacklist as readBlacklistURLs
+// does, and the line number of each in a list of URLs (nil for a release-json
+// blacklist, whose URLs are numbered by position).
+func readNumberedBlacklist(path string) ([]string, []int, error) {
+	if strings.ToLower(filepath.Ext(path)) != ".json" {
+		return readNumberedURLs(path, ^uint(0))
+	}
+	data, err := lines.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	entries, err := oprf.ParseEntries(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %v", path, err)
+	}
+	urls := make([]string, len(entries))
+	for i, e := range entries {
+		urls[i] = e.U
+	}
+	return urls, nil, nil
+}
 
-	// Module 8: Normalize websites from Alexa top 1M and compute hash prefixes
-	// alexaDataNorm()
-	// alexa := readJsontoMap("alex.json")
-	// gsbhashprefixes, _ := readURLFromFile("./GSBhashprefixes.txt", ^uint(0))
-	// gsbhashprefixesset := make(map[string]bool)
-	// for _, v := range gsbhashprefixes {
-	// 	gsbhashprefixesset[v] = true
-	// }
-	// sitestracked := []string{}
-	// for item := range alexa {
-	// 	if gsbhashprefixesset[item] == true {
-	// 		for cnt, ele := range alexa[item] {
-	// 			sitestracked = append(sitestracked, ele)
-	// 			if cnt >= 1 {
-	// 				fmt.Println("hash prefix: ", item)
-	// 			}
-	// 		}
-	// 	}
-	// }
-	// writeLines(sitestracked, "sitetracked.txt")
+// readBrands reads brand domains, one per line, ranked by line, or an Alexa
+// top-1m CSV (rank,site).
+func readBrands(path string) ([]homograph.Brand, error) {
+	lines, err := readURLFromFile(path, ^uint(0))
+	if err != nil {
+		return nil, err
+	}
+	brands := make([]homograph.Brand, 0, len(lines))
+	for i, line := range lines {
+		brand := homograph.Brand{Domain: strings.TrimSpace(line), Rank: i + 1}
+		if j := strings.Index(line, ","); j >= 0 {
+			rank, err := strconv.Atoi(strings.TrimSpace(line[:j]))
+			if err != nil {
+				return nil, fmt.Errorf("%s:%d: invalid rank %q", path, i+1, line[:j])
+			}
+			brand = homograph.Brand{Domain: strings.TrimSpace(line[j+1:]), Rank: rank}
+		}
+		brands = append(brands, brand)
+	}
+	return brands, nil
+}
+
+// homographHost is a host of the homograph report, with the blacklisted URLs
+// on it.
+type homographHost struct {
+	*homograph.Host
+	URLs []string `json:"urls"`
+}
+
+// homographStats are the counts of the homograph report. A host may count
+// in several of them.
+type homographStats struct {
+	URLs        int `json:"urls"`
+	Hosts       int `json:"hosts"`
+	Brands      int `json:"brands"`
+	IDN         int `json:"idn"`
+	Invalid     int `json:"invalid"`
+	MixedScript int `json:"mixedScript"`
+	Confusable  int `json:"confusable"`
+	Collisions  int `json:"collisions"`
+	Flagged     int `json:"flagged"`
+}
+
+// homographReport analyzes the hosts of the blacklist at inPath and writes
+// the flagged ones (or all IDN hosts if all is set), with their URLs, to
+// outPath. IP address hosts are skipped, the URLs that do not parse go to
+// the rejects report at rejectsPath.
+func homographReport(inPath, brandsPath, outPath string, all bool, rejectsPath string) error {
+	urls, urlLines, err := readNumberedBlacklist(inPath)
+	if err != nil {
+		return err
+	}
+	var brands *homograph.Brands
+	if brandsPath != "" {
+		list, err := readBrands(brandsPath)
+		if err != nil {
+			return err
+		}
+		if brands, err = homograph.NewBrands(list); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf(">>> Analyzing the hosts of %d URLs ...\n\n", len(urls))
+	stats := homographStats{URLs: len(urls)}
+	if brands != nil {
+		stats.Brands = brands.Len()
+	}
+	byHost := make(map[string]*homographHost)
+	var hosts []*homographHost
+	rejects := newRejectReport(inPath, len(urls))
+	for i, u := range urls {
+		parsed, err := focal.ParseURL(u)
+		if err != nil {
+			rejects.reject(lineOf(urlLines, i), u, err)
+			continue
+		}
+		if strings.HasPrefix(parsed.Host, "[") || net.ParseIP(parsed.Host) != nil {
+			continue
+		}
+		if h := byHost[parsed.Host]; h != nil {
+			h.URLs = append(h.URLs, u)
+			continue
+		}
+		h := &homographHost{Host: homograph.Analyze(parsed.Host), URLs: []string{u}}
+		if brands != nil {
+			brands.Collisions(h.Host)
+		}
+		byHost[parsed.Host] = h
+		hosts = append(hosts, h)
+	}
 
-	// Module 9: Normalize URL history from Chrome and compute hash prefixes
-	// browsingHistoryNorm()
+	report := struct {
+		Stats homographStats   `json:"stats"`
+		Hosts []*homographHost `json:"hosts"`
+	}{Hosts: []*homographHost{}}
+	for _, h := range hosts {
+		stats.Hosts++
+		if h.IDN() {
+			stats.IDN++
+		}
+		if h.Error != "" {
+			stats.Invalid++
+		}
+		if len(h.MixedScript) > 0 {
+			stats.MixedScript++
+		}
+		if len(h.Confusable) > 0 {
+			stats.Confusable++
+		}
+		if len(h.Collisions) > 0 {
+			stats.Collisions++
+		}
+		if h.Flagged() {
+			stats.Flagged++
+		}
+		if h.Flagged() || all && h.IDN() {
+			report.Hosts = append(report.Hosts, h)
+		}
+	}
+	report.Stats = stats
+	if err := rejects.write(rejectsPath); err != nil {
+		return err
+	}
 
-	// Module 10: Collision test using suspicious GSB prefix hashes
-	// collisionTest()
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "    hosts\t%d\t(%d URLs)\n", stats.Hosts, stats.URLs)
+	fmt.Fprintf(w, "    IDN hosts\t%d\t(%d invalid)\n", stats.IDN, stats.Invalid)
+	fmt.Fprintf(w, "    mixed-script\t%d\n", stats.MixedScript)
+	fmt.Fprintf(w, "    confusable\t%d\n", stats.Confusable)
+	fmt.Fprintf(w, "    brand lookalikes\t%d\t(%d brand skeletons)\n", stats.Collisions, stats.Brands)
+	fmt.Fprintf(w, "    flagged\t%d\n", stats.Flagged)
+	w.Flush()
+	fmt.Println()
+	return writeJSON(report, outPath)
+}
 
-	// Module 11: Collision test using prefix hashes ground truth ecrime
-	// collisionTest2()
+// reidentReport is the JSON report of a re-identification, with the
+// prefixes in the encoding of the index.
+type reidentReport struct {
+	Observed   []string           `json:"observed"`
+	Unmatched  []string           `json:"unmatched"`
+	Ambiguity  int                `json:"ambiguity"`
+	Bits       float64            `json:"bits"`
+	Consistent int                `json:"consistent"`
+	Candidates []reidentCandidate `json:"candidates"`
+}
 
-	// Module 12: Browsing History prefix hash uniqueness
-	// uniqueHistoryHashPrefixes()
+type reidentCandidate struct {
+	URL            string   `json:"url"`
+	Explained      []string `json:"explained"`
+	Contradicted   []string `json:"contradicted"`
+	Decompositions int      `json:"decompositions"`
+}
 
-	// Module 13: delta encoded max
-	// gsbhashprefixes, _ := readURLFromFile("./GSBhashprefixes.txt", ^uint(0))
-	// for i := 0; i < len(gsbhashprefixes)-1; i++ {
-	// 	binary, _ := strconv.ParseUint(gsbhashprefixes[i], 16, 32)
-	// 	binary2, _ := strconv.ParseUint(gsbhashprefixes[i+1], 16, 32)
-	// 	diff := binary2 - binary
-	// 	if diff > 65534 {
-	// 		fmt.Printf("stopped\n")
-	// 	}
-	// }
+func encodePrefixes(prefixes []focal.HashPrefix, enc focal.PrefixEncoding) []string {
+	encoded := make([]string, len(prefixes))
+	for i, h := range prefixes {
+		encoded[i] = enc.Encode(h)
+	}
+	return encoded
+}
+
+// reidentify ranks the decompositions of index by how many of the prefixes
+// of observed, sent together, they explain, prints the top ones and the
+// residual ambiguity, and writes the ranking to outPath as JSON, if set.
+func reidentify(observed []focal.HashPrefix, index map[focal.HashPrefix][]string, bitlength, top int, enc focal.PrefixEncoding, outPath string) error {
+	for _, h := range observed {
+		if 8*len(h) < bitlength {
+			return fmt.Errorf("prefix %s is shorter than %d bits", enc.Encode(h), bitlength)
+		}
+	}
+
+	fmt.Printf(">>> Re-identifying %d co-occurring %d-bit prefixes ...\n\n", len(observed), bitlength)
+
+	r := reident.New(index, bitlength).Reidentify(observed)
+	if len(r.Unmatched) > 0 {
+		fmt.Printf("    %d of %d prefixes match no decomposition: %s\n\n", len(r.Unmatched), len(r.Observed), strings.Join(encodePrefixes(r.Unmatched, enc), " "))
+	}
+	for i, c := range r.Candidates {
+		if i == top {
+			fmt.Printf("    ... %d more candidates\n", len(r.Candidates)-top)
+			break
+		}
+		fmt.Printf("    %3d. %s explains %d of %d prefixes, %d of its %d decompositions contradict it\n", i+1, c.URL, len(c.Explained), len(r.Observed), len(c.Contradicted), c.Decompositions)
+	}
+	fmt.Printf("\n    %d candidates, %d ranked first (residual ambiguity %.2f bits), %d consistent with every prefix!\n\n", len(r.Candidates), r.Ambiguity, r.Bits(), r.Consistent)
+
+	if outPath == "" {
+		return nil
+	}
+	report := reidentReport{
+		Observed:   encodePrefixes(r.Observed, enc),
+		Unmatched:  encodePrefixes(r.Unmatched, enc),
+		Ambiguity:  r.Ambiguity,
+		Bits:       r.Bits(),
+		Consistent: r.Consistent,
+		Candidates: make([]reidentCandidate, len(r.Candidates)),
+	}
+	for i, c := range r.Candidates {
+		report.Candidates[i] = reidentCandidate{URL: c.URL, Explained: encodePrefixes(c.Explained, enc), Contradicted: c.Contradicted, Decompositions: c.Decompositions}
+		if c.Contradicted == nil {
+			report.Candidates[i].Contradicted = []string{}
+		}
+	}
+	return writeJSON(report, outPath)
+}
+
+// injectReport is the JSON report of a tracking-prefix injection, with the
+// prefixes in the encoding enc of inject.
+type injectReport struct {
+	Published      int               `json:"published"`
+	Planted        []injectPlanted   `json:"planted"`
+	Growth         float64           `json:"growth"`
+	PreImages      bool              `json:"preImages"`
+	Orphans        int               `json:"orphans"`
+	PlantedOrphans []string          `json:"plantedOrphans"`
+	Targets        []injectionReport `json:"targets"`
+}
+
+type injectPlanted struct {
+	Pattern string `json:"pattern"`
+	Prefix  string `json:"prefix"`
+}
+
+type injectionReport struct {
+	Target     string          `json:"target"`
+	Level      string          `json:"level"`
+	Pattern    string          `json:"pattern"`
+	Published  []string        `json:"published"`
+	Planted    []injectPlanted `json:"planted"`
+	Unique     bool            `json:"unique"`
+	Confusable []string        `json:"confusable"`
+}
+
+func encodePlanted(planted []reident.Planted, enc focal.PrefixEncoding) []injectPlanted {
+	encoded := make([]injectPlanted, len(planted))
+	for i, p := range planted {
+		encoded[i] = injectPlanted{Pattern: p.Pattern, Prefix: enc.Encode(p.Prefix)}
+	}
+	return encoded
+}
+
+// inject plans the fewest prefixes to plant into the published prefixes for
+// their provider to single out the visits to each target, at the URL and at
+// the domain level, among the visits to the corpus URLs and the targets.
+// The blacklisted patterns, if known, tell which planted prefixes an auditor
+// would find without a pre-image.
+func inject(targetsPath string, gsb prefixSource, corpusPath, blacklistPath string, enc focal.PrefixEncoding, outPath string) error {
+	targets, err := readURLFromFile(targetsPath, ^uint(0))
+	if err != nil {
+		return err
+	}
+	published, err := readPrefixSet(gsb)
+	if err != nil {
+		return err
+	}
+	corpus := targets
+	if corpusPath != "" {
+		urls, err := readURLFromFile(corpusPath, ^uint(0))
+		if err != nil {
+			return err
+		}
+		corpus = append(urls, targets...)
+	}
+	var blacklist []string
+	if blacklistPath != "" {
+		if blacklist, err = readBlacklistURLs(blacklistPath); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf(">>> Planning the injection of %d targets into %d published prefixes, among %d URLs ...\n\n", len(targets), published.Len(), len(corpus))
+
+	in, rejected := reident.NewInjector(published, corpus)
+	if len(rejected) > 0 {
+		fmt.Printf("    %d URLs have no decomposition and are left out!\n\n", len(rejected))
+	}
+	var injections []*reident.Injection
+	report := injectReport{Targets: []injectionReport{}}
+	unique := make(map[reident.Level]int)
+	for _, target := range targets {
+		for _, plan := range []func(string) (*reident.Injection, error){in.URL, in.Domain} {
+			inj, err := plan(target)
+			if err != nil {
+				// Rejected with the corpus already.
+				continue
+			}
+			injections = append(injections, inj)
+			if inj.Unique() {
+				unique[inj.Level]++
+			}
+
+			status := "unique"
+			if !inj.Unique() {
+				status = fmt.Sprintf("%d confusable URLs, e.g. %s", len(inj.Confusable), inj.Confusable[0])
+			}
+			fmt.Printf("    %-6s %s: %d published, %d planted, %s\n", inj.Level, inj.Pattern, len(inj.Published), len(inj.Planted), status)
+			for _, p := range inj.Planted {
+				fmt.Printf("           + %s %s\n", enc.Encode(p.Prefix), p.Pattern)
+			}
+
+			r := injectionReport{
+				Target:     inj.Target,
+				Level:      inj.Level.String(),
+				Pattern:    inj.Pattern,
+				Published:  encodePrefixes(inj.Published, enc),
+				Planted:    encodePlanted(inj.Planted, enc),
+				Unique:     inj.Unique(),
+				Confusable: inj.Confusable,
+			}
+			if r.Confusable == nil {
+				r.Confusable = []string{}
+			}
+			report.Targets = append(report.Targets, r)
+		}
+	}
+
+	vis := reident.Visible(published, injections, blacklist)
+	fmt.Printf("\n    %d of %d targets singled out at the URL level, %d at the domain level.\n", unique[reident.URLLevel], len(targets), unique[reident.DomainLevel])
+	fmt.Printf("    %s!\n\n", vis)
+	if !vis.PreImages {
+		fmt.Printf("    Without the blacklist (-blacklist), the planted prefixes cannot be told from the blacklisted ones.\n\n")
+	}
+
+	if outPath == "" {
+		return nil
+	}
+	report.Published = vis.Published
+	report.Planted = encodePlanted(vis.Planted, enc)
+	report.Growth = vis.Growth
+	report.PreImages = vis.PreImages
+	report.Orphans = vis.Orphans
+	report.PlantedOrphans = encodePrefixes(vis.PlantedOrphans, enc)
+	return writeJSON(report, outPath)
+}
+
+// readCorpus reads the URLs of a benign corpus: a browsing history export
+// (.json), an Alexa top-1m CSV (.csv) or one URL or domain per line.
+func readCorpus(path string) ([]string, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return readHistoryURLs(path)
+	case ".csv":
+		sites, err := readBrands(path)
+		if err != nil {
+			return nil, err
+		}
+		urls := make([]string, len(sites))
+		for i, site := range sites {
+			urls[i] = site.Domain
+		}
+		return urls, nil
+	}
+	return readURLFromFile(path, ^uint(0))
+}
+
+// auditReport is the JSON report of an audit, with the prefixes in the
+// encoding of the audited list.
+type auditReport struct {
+	Published  int            `json:"published"`
+	Corpora    []audit.Corpus `json:"corpora"`
+	Matched    int            `json:"matched"`
+	Explained  int            `json:"explained"`
+	Suspicious []auditMatch   `json:"suspicious"`
+	Domains    []auditDomain  `json:"domains"`
+}
+
+type auditMatch struct {
+	Prefix   string   `json:"prefix"`
+	Patterns []string `json:"patterns"`
+	Corpora  []string `json:"corpora"`
+}
+
+type auditDomain struct {
+	Domain      string   `json:"domain"`
+	Prefixes    []string `json:"prefixes"`
+	Unexplained []string `json:"unexplained"`
+}
+
+// auditPrefixes reports the published prefixes matching the decompositions
+// of the benign corpora without a malicious pre-image from the index or the
+// blacklists, and the domains matching several published prefixes. It
+// supersedes the hits of shallalisttrack, alexaTrack and browsingHistoryNorm
+// for any prefix list and any number of corpora.
+func auditPrefixes(gsb prefixSource, corpora []string, indexPath string, indexEnc focal.PrefixEncoding, blacklists []string, top int, outPath string) error {
+	published, err := readPrefixSet(gsb)
+	if err != nil {
+		return err
+	}
+	a := audit.New(published)
+	if indexPath != "" {
+		index, err := readIndex(indexPath, indexEnc)
+		if err != nil {
+			return err
+		}
+		for h := range index {
+			a.AddMaliciousPrefix(h)
+		}
+	}
+	for _, path := range blacklists {
+		urls, err := readBlacklistURLs(path)
+		if err != nil {
+			return err
+		}
+		if n := a.AddMalicious(urls); n > 0 {
+			fmt.Printf("    %d URLs of %s have no decomposition and are left out!\n\n", n, path)
+		}
+	}
+
+	fmt.Printf(">>> Auditing %d published prefixes against %d benign corpora ...\n\n", published.Len(), len(corpora))
+
+	for _, path := range corpora {
+		urls, err := readCorpus(path)
+		if err != nil {
+			return err
+		}
+		c := a.Scan(path, urls)
+		fmt.Printf("    %s: %d URLs, %d rejected, %d matching published prefixes\n", c.Name, c.URLs, c.Rejected, c.Hits)
+	}
+
+	r := a.Report()
+	fmt.Printf("\n    %d prefixes match benign decompositions, %d of them with a malicious pre-image: %d suspicious!\n\n", r.Matched, r.Explained, len(r.Suspicious))
+	for i, m := range r.Suspicious {
+		if i == top {
+			fmt.Printf("    ... %d more suspicious prefixes\n", len(r.Suspicious)-top)
+			break
+		}
+		fmt.Printf("    %s %s", gsb.encoding().Encode(m.Prefix), m.Patterns[0])
+		if len(m.Patterns) > 1 {
+			fmt.Printf(" (and %d more)", len(m.Patterns)-1)
+		}
+		fmt.Printf(" in %s\n", strings.Join(m.Corpora, ", "))
+	}
+	if len(r.Domains) > 0 {
+		fmt.Printf("\n    %d domains match several prefixes:\n\n", len(r.Domains))
+	}
+	for i, d := range r.Domains {
+		if i == top {
+			fmt.Printf("    ... %d more domains\n", len(r.Domains)-top)
+			break
+		}
+		fmt.Printf("    %s matches %d prefixes, %d without malicious pre-image: %s\n", d.Domain, len(d.Prefixes), len(d.Unexplained), strings.Join(encodePrefixes(d.Unexplained, gsb.encoding()), " "))
+	}
+	fmt.Println()
+
+	report := auditReport{
+		Published:  r.Published,
+		Corpora:    r.Corpora,
+		Matched:    r.Matched,
+		Explained:  r.Explained,
+		Suspicious: make([]auditMatch, len(r.Suspicious)),
+		Domains:    make([]auditDomain, len(r.Domains)),
+	}
+	for i, m := range r.Suspicious {
+		report.Suspicious[i] = auditMatch{Prefix: gsb.encoding().Encode(m.Prefix), Patterns: m.Patterns, Corpora: m.Corpora}
+	}
+	for i, d := range r.Domains {
+		report.Domains[i] = auditDomain{Domain: d.Domain, Prefixes: encodePrefixes(d.Prefixes, gsb.encoding()), Unexplained: encodePrefixes(d.Unexplained, gsb.encoding())}
+	}
+	return writeJSON(report, outPath)
+}
+
+// orphanPrefixes returns the published prefixes whose first 32 bits are
+// those of none of the known decompositions, sorted.
+func orphanPrefixes(published *focal.HashSet, known map[focal.HashPrefix]bool) focal.HashPrefixes {
+	var orphans focal.HashPrefixes
+	for _, h := range published.Export() {
+		if !known[h.Short()] {
+			orphans = append(orphans, h)
+		}
+	}
+	return orphans
+}
+
+// preimageSearch hashes the candidates of the generators gens on workers
+// goroutines, looking for the pre-images of the orphan prefixes. The
+// decompositions of the index and of the feeds are the known pre-images; the
+// feeds also give the hosts, suffixes and path templates of the generators.
+// The search saves its checkpoint every interval and on interrupt, and
+// resumes from it; the hits so far go to outPath as an orphan -> pre-images
+// index.
+func preimageSearch(gsb prefixSource, indexPath string, indexEnc focal.PrefixEncoding, feedPaths []string, wordsPath, suffixesPath, labelsPath string, gens []string, workers int, checkpointPath string, interval time.Duration, outPath string) error {
+	published, err := readPrefixSet(gsb)
+	if err != nil {
+		return err
+	}
+	known := make(map[focal.HashPrefix]bool)
+	if indexPath != "" {
+		index, err := readIndex(indexPath, indexEnc)
+		if err != nil {
+			return err
+		}
+		for h := range index {
+			known[h.Short()] = true
+		}
+	}
+	var feedURLs []string
+	for _, path := range feedPaths {
+		urls, err := readBlacklistURLs(path)
+		if err != nil {
+			return err
+		}
+		feedURLs = append(feedURLs, urls...)
+	}
+	for _, u := range feedURLs {
+		hashes, _ := focal.GenerateHashes(u)
+		for h := range hashes {
+			known[h.Short()] = true
+		}
+	}
+	orphans := orphanPrefixes(published, known)
+	fmt.Printf("    %d of %d published prefixes have no known pre-image!\n\n", len(orphans), published.Len())
+	if len(orphans) == 0 {
+		return nil
+	}
+
+	feed := preimage.FromFeed(feedURLs)
+	suffixes, labels := feed.Suffixes, preimage.Labels
+	if suffixesPath != "" {
+		if suffixes, err = readURLFromFile(suffixesPath, ^uint(0)); err != nil {
+			return err
+		}
+	}
+	if labelsPath != "" {
+		if labels, err = readURLFromFile(labelsPath, ^uint(0)); err != nil {
+			return err
+		}
+	}
+	var generators []preimage.Generator
+	for _, name := range gens {
+		switch strings.TrimSpace(name) {
+		case "domains":
+			if wordsPath == "" {
+				fmt.Printf("    No wordlist (-words): the domains generator is left out!\n\n")
+				continue
+			}
+			words, err := readURLFromFile(wordsPath, ^uint(0))
+			if err != nil {
+				return err
+			}
+			generators = append(generators, preimage.Domains(words, suffixes))
+		case "subdomains":
+			generators = append(generators, preimage.Subdomains(labels, feed.Hosts))
+		case "paths":
+			generators = append(generators, preimage.Paths(feed.Hosts, feed.Paths))
+		default:
+			return fmt.Errorf("unknown generator %q (want domains, subdomains or paths)", name)
+		}
+	}
+
+	cp, err := preimage.Resume(checkpointPath, orphans, generators)
+	if err != nil {
+		return err
+	}
+	fmt.Printf(">>> Searching the pre-images of %d orphans among %d candidates on %d goroutines ...\n\n", len(orphans), cp.Len(), workers)
+	for _, p := range cp.Generators {
+		fmt.Printf("    %-10s %d of %d candidates hashed\n", p.Name, p.Done, p.Len)
+	}
+	fmt.Println()
+
+	// An interrupt stops the search, which saves its checkpoint first; the
+	// goroutine waiting for it ends with the search.
+	stop, done := make(chan struct{}), make(chan struct{})
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+	defer close(done)
+	go func() {
+		select {
+		case <-interrupt:
+			close(stop)
+		case <-done:
+		}
+	}()
+
+	start, hashed := time.Now(), cp.Hashed()
+	opts := preimage.Options{Workers: workers, SaveInterval: interval, Stop: stop}
+	opts.Save = func(cp *preimage.Checkpoint) error {
+		rate := float64(cp.Hashed()-hashed) / time.Since(start).Seconds()
+		fmt.Printf("    %d of %d candidates hashed (%.2f%%), %d hits, %.0f candidates/s\n", cp.Hashed(), cp.Len(), 100*float64(cp.Hashed())/float64(cp.Len()), len(cp.Hits), rate)
+		return cp.Write(checkpointPath)
+	}
+	err = preimage.Search(orphans, generators, cp, opts)
+	if err == preimage.ErrStopped {
+		fmt.Printf("\n    Interrupted: run again to resume from %s!\n", checkpointPath)
+	} else if err != nil {
+		return err
+	}
+
+	hits := make(map[focal.HashPrefix][]string)
+	for _, h := range cp.Hits {
+		prefix, err := focal.HexPrefix.Decode(h.Prefix)
+		if err != nil {
+			return fmt.Errorf("%s: %v", checkpointPath, err)
+		}
+		hits[prefix] = append(hits[prefix], h.Pattern)
+	}
+	fmt.Printf("\n    %d hits on %d of %d orphans!\n\n", len(cp.Hits), len(hits), len(orphans))
+	return writeIndex(hits, outPath, gsb.encoding())
+}
+
+// command is a subcommand of the analysis tool. Each subcommand parses its own
+// flags from args.
+type command struct {
+	name    string
+	summary string
+	run     func(args []string) error
+}
+
+var commands = []command{
+	{"normalize", "canonicalize, dedup and decompose a URL feed (eCrimeX or Alexa top-1m)", runNormalize},
+	{"index", "build the prefix -> decompositions index, or export the GSB prefix database", runIndex},
+	{"match", "match GSB hash prefixes against eCrimeX decompositions", runMatch},
+	{"track", "find benign sites (shallalist or Alexa) that hit GSB prefixes", runTrack},
+	{"history", "find browsing-history URLs that hit GSB prefixes", runHistory},
+	{"collide", "test collisions against the GSB prefixes or the eCrimeX index", runCollide},
+	{"analyze", "analyze the prefix index of a decomposition list", runAnalyze},
+	{"delta", "check the deltas between sorted GSB hash prefixes", runDelta},
+	{"rice", "Rice-Golomb compress a prefix list and compare its size with raw and JSON", runRice},
+	{"homograph", "flag mixed-script, confusable and brand-lookalike hosts of a blacklist", runHomograph},
+	{"reidentify", "rank the URLs behind a set of co-occurring hash prefixes", runReidentify},
+	{"inject", "plan the prefixes to plant for tracking target URLs and domains", runInject},
+	{"audit", "flag published prefixes of benign URLs without a malicious pre-image", runAudit},
+	{"preimage", "search the pre-images of the orphan prefixes among generated candidates", runPreimage},
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: %s <command> [flags]\n\nCommands:\n", os.Args[0])
+	for _, c := range commands {
+		fmt.Fprintf(os.Stderr, "    %-10s %s\n", c.name, c.summary)
+	}
+	fmt.Fprintf(os.Stderr, "\nRun '%s <command> -h' for the flags of a command.\n", os.Args[0])
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	name := os.Args[1]
+	for _, c := range commands {
+		if c.name != name {
+			continue
+		}
+		if err := c.run(os.Args[2:]); err != nil {
+			if err == flag.ErrHelp {
+				os.Exit(2)
+			}
+			fmt.Printf("Error: %s\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if name != "-h" && name != "-help" && name != "help" {
+		fmt.Fprintf(os.Stderr, "Unknown command %q\n\n", name)
+	}
+	usage()
+	os.Exit(2)
 }
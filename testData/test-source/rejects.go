@@ -0,0 +1,137 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"../../lib/focal"
+	"../../lib/lines"
+)
+
+// reasonMalformed is the reason of the input lines that hold no URL: lines
+// that are not text (see lines.MalformedError) and Alexa lines without a site.
+const reasonMalformed = "malformed-line"
+
+// reasonInvalidUTF8 is the reason of the accepted URLs that are not valid
+// UTF-8, such as Latin-1 URLs, whose bytes the canonicalizer escapes.
+const reasonInvalidUTF8 = "invalid-utf8"
+
+// rejectedURL is an input URL that cannot be canonicalized nor decomposed,
+// or an accepted one with a warning.
+type rejectedURL struct {
+	Line   int    `json:"line"`
+	URL    string `json:"url"`
+	Reason string `json:"reason"`
+	Error  string `json:"error"`
+}
+
+// rejectReport collects the rejected URLs of an input, so that the coverage
+// of a feed can be measured rather than URLs silently dropped.
+type rejectReport struct {
+	Input    string         `json:"input"`
+	URLs     int            `json:"urls"`
+	Accepted int            `json:"accepted"`
+	Rejected int            `json:"rejected"`
+	Reasons  map[string]int `json:"reasons"`
+	Rejects  []rejectedURL  `json:"rejects"`
+	Warnings []rejectedURL  `json:"warnings"`
+}
+
+func newRejectReport(input string, urls int) *rejectReport {
+	return &rejectReport{Input: input, URLs: urls, Accepted: urls, Reasons: make(map[string]int), Rejects: []rejectedURL{}, Warnings: []rejectedURL{}}
+}
+
+// reject records the URL of line as rejected for err, an error of the focal
+// package or a *lines.MalformedError.
+func (r *rejectReport) reject(line int, url string, err error) {
+	var me *lines.MalformedError
+	if errors.As(err, &me) {
+		r.add(line, url, reasonMalformed, errors.New(me.Reason))
+		return
+	}
+	r.add(line, url, focal.RejectReason(err).String(), err)
+}
+
+// warn records the accepted URL of line with the warning err, a
+// *lines.MalformedError.
+func (r *rejectReport) warn(line int, url string, err error) {
+	var me *lines.MalformedError
+	if errors.As(err, &me) {
+		err = errors.New(me.Reason)
+	}
+	r.Warnings = append(r.Warnings, rejectedURL{Line: line, URL: url, Reason: reasonInvalidUTF8, Error: err.Error()})
+}
+
+func (r *rejectReport) add(line int, url, reason string, err error) {
+	r.Rejects = append(r.Rejects, rejectedURL{Line: line, URL: url, Reason: reason, Error: err.Error()})
+	r.Reasons[reason]++
+	r.Rejected++
+	r.Accepted--
+}
+
+// setURLs sets the number of URLs of the input, once it is known.
+func (r *rejectReport) setURLs(urls int) {
+	r.URLs, r.Accepted = urls, urls-r.Rejected
+}
+
+// lineOf returns the line number of the i-th URL of an input: lines[i], or
+// i+1 for inputs that are not read line by line.
+func lineOf(lines []int, i int) int {
+	if i < len(lines) {
+		return lines[i]
+	}
+	return i + 1
+}
+
+// print prints how many URLs are rejected, by reason.
+func (r *rejectReport) print() {
+	covered := 100.0
+	if r.URLs > 0 {
+		covered = 100 * float64(r.Accepted) / float64(r.URLs)
+	}
+	fmt.Printf("    %d of %d URLs are rejected, %.2f%% of %s is covered!\n", r.Rejected, r.URLs, covered, r.Input)
+	var reasons []string
+	for reason := range r.Reasons {
+		reasons = append(reasons, reason)
+	}
+	sort.Slice(reasons, func(i, j int) bool {
+		if r.Reasons[reasons[i]] != r.Reasons[reasons[j]] {
+			return r.Reasons[reasons[i]] > r.Reasons[reasons[j]]
+		}
+		return reasons[i] < reasons[j]
+	})
+	for _, reason := range reasons {
+		fmt.Printf("    %-16s %d\n", reason, r.Reasons[reason])
+	}
+	if len(r.Warnings) > 0 {
+		fmt.Printf("    %d accepted URLs are not valid UTF-8!\n", len(r.Warnings))
+	}
+	fmt.Println()
+}
+
+// write prints the report and writes it to path as JSON, if path is set.
+func (r *rejectReport) write(path string) error {
+	r.print()
+	if path == "" {
+		return nil
+	}
+	return writeJSON(r, path)
+}
+
+// canonicalizeURLs returns the canonical URLs of urls, and their line
+// numbers, and reports the ones that are rejected.
+func canonicalizeURLs(urls []string, lines []int, rejects *rejectReport) ([]string, []int) {
+	var canonURLs []string
+	var canonLines []int
+	for i, u := range urls {
+		canon, err := focal.CanonicalURL(u)
+		if err != nil {
+			rejects.reject(lineOf(lines, i), u, err)
+			continue
+		}
+		canonURLs = append(canonURLs, canon)
+		canonLines = append(canonLines, lineOf(lines, i))
+	}
+	return canonURLs, canonLines
+}